@@ -10,13 +10,35 @@ import (
 	"time"
 
 	"quasarflow-api/internal/config"
+	domainPricing "quasarflow-api/internal/domain/pricing"
 	"quasarflow-api/internal/infrastructure/crypto"
 	"quasarflow-api/internal/infrastructure/database"
+	"quasarflow-api/internal/infrastructure/eventbus"
+	"quasarflow-api/internal/infrastructure/pricefeed"
 	"quasarflow-api/internal/infrastructure/stellar"
+	infraWebhook "quasarflow-api/internal/infrastructure/webhook"
 	httpHandler "quasarflow-api/internal/interface/http"
 	"quasarflow-api/internal/interface/http/handler"
 	"quasarflow-api/internal/interface/http/middleware"
+	"quasarflow-api/internal/usecase/addressbook"
+	"quasarflow-api/internal/usecase/approval"
+	"quasarflow-api/internal/usecase/asset"
+	"quasarflow-api/internal/usecase/balance"
+	"quasarflow-api/internal/usecase/claimable"
+	eventUC "quasarflow-api/internal/usecase/event"
+	"quasarflow-api/internal/usecase/federation"
+	"quasarflow-api/internal/usecase/fee"
+	"quasarflow-api/internal/usecase/liquiditypool"
+	"quasarflow-api/internal/usecase/offer"
+	"quasarflow-api/internal/usecase/pricing"
+	"quasarflow-api/internal/usecase/reconciliation"
+	"quasarflow-api/internal/usecase/registry"
+	"quasarflow-api/internal/usecase/spending"
+	"quasarflow-api/internal/usecase/sponsorship"
+	"quasarflow-api/internal/usecase/submission"
 	"quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/internal/usecase/webhook"
+	"quasarflow-api/internal/worker"
 	"quasarflow-api/pkg/logger"
 
 	_ "github.com/lib/pq"
@@ -38,47 +60,241 @@ func main() {
 
 	// Setup repositories
 	walletRepo := database.NewPostgresWalletRepository(db)
+	submissionRepo := database.NewPostgresSubmissionRepository(db)
+	federationRepo := database.NewPostgresFederationRepository(db)
+	assetRepo := database.NewPostgresAssetRepository(db)
+	registryRepo := database.NewPostgresRegistryRepository(db)
+	sponsorshipRepo := database.NewPostgresSponsorshipRepository(db)
+	streamCursorRepo := database.NewPostgresStreamCursorRepository(db)
+	ledgerRepo := database.NewPostgresLedgerRepository(db)
+	snapshotRepo := database.NewPostgresSnapshotRepository(db)
+	reconciliationRepo := database.NewPostgresReconciliationRepository(db)
+	// Payments are attached to their transaction well within the longest validity window
+	spendingRepo := database.NewPostgresSpendingRepository(db, parseDuration(cfg.TxTimeoutMax))
+	addressBookRepo := database.NewPostgresAddressBookRepository(db)
+	approvalRepo := database.NewPostgresApprovalRepository(db)
+	webhookEndpointRepo := database.NewPostgresWebhookEndpointRepository(db)
+	webhookDeliveryRepo := database.NewPostgresWebhookDeliveryRepository(db)
+	eventRepo := database.NewPostgresEventRepository(db)
+
+	// Domain events are delivered in-process to their subscribers
+	eventBus := eventbus.NewBus(log)
 
 	// Setup Stellar client
 	stellarClient := stellar.NewClient(cfg.StellarHorizonURL)
 
+	// Setup fee estimator
+	feeEstimator := stellar.NewFeeEstimator(stellarClient.GetHorizonClient(), stellar.FeeEstimatorConfig{
+		Percentile: cfg.FeePercentile,
+		MaxBaseFee: int64(cfg.FeeMaxBaseFee),
+		CacheTTL:   parseDuration(cfg.FeeStatsCacheTTL),
+	}, log)
+
+	// Setup federation (SEP-2); names on our own domain are answered from the database
+	stellarTomlClient := stellar.NewStellarTomlClient(parseDuration(cfg.StellarTomlTimeout))
+	federationResolver := federation.NewAddressResolver(
+		federationRepo,
+		walletRepo,
+		stellar.NewFederationResolver(stellarTomlClient, parseDuration(cfg.FederationCacheTTL), log),
+		cfg.FederationDomain,
+	)
+
+	// Setup asset registry (SEP-1 metadata from issuers' stellar.toml)
+	assetMetadataResolver := stellar.NewAssetMetadataResolver(stellarClient.GetHorizonClient(), stellarTomlClient, parseDuration(cfg.AssetRegistryCacheTTL), log)
+	getAssetInfoUC := registry.NewGetAssetInfoUseCase(assetMetadataResolver, registryRepo, log)
+	manageVerifiedAssetsUC := registry.NewManageVerifiedAssetsUseCase(registryRepo, log)
+
+	// Setup pricing; the external feed, when configured, is consulted before the DEX
+	var priceSources []domainPricing.Source
+	if cfg.PriceFeedURL != "" {
+		priceSources = append(priceSources, pricefeed.NewHTTPFeed(cfg.PriceFeedURL, cfg.PriceFeedAPIKey, parseDuration(cfg.PriceFeedTimeout)))
+	}
+	priceSources = append(priceSources, stellar.NewDEXPriceSource(stellarClient.GetHorizonClient(), cfg.PricingUSDAsset, parseDuration(cfg.PricingMaxTradeAge), log))
+	getPriceUC := pricing.NewGetPriceUseCase(priceSources, parseDuration(cfg.PricingCacheTTL), log)
+	valuePortfolioUC := pricing.NewValuePortfolioUseCase(getPriceUC, log)
+
 	// Setup crypto
 	encryptor, err := crypto.NewAESEncryptor(cfg.EncryptionKey)
 	if err != nil {
 		log.Fatal("failed to create encryptor", logger.Error(err))
 	}
 
+	// Setup transaction builder shared by all use cases that submit to the ledger
+	txBuilder := wallet.NewTransactionBuilder(stellarClient.GetHorizonClient(), encryptor, feeEstimator, submissionRepo, eventBus, wallet.TimeoutConfig{
+		Default: parseDuration(cfg.TxTimeoutDefault),
+		Max:     parseDuration(cfg.TxTimeoutMax),
+	}, log)
+
 	// Setup use cases
-	createWalletUC := wallet.NewCreateWalletUseCase(walletRepo, encryptor, log)
+	createWalletUC := wallet.NewCreateWalletUseCase(walletRepo, encryptor, eventBus, log)
 	getWalletUC := wallet.NewGetWalletUseCase(walletRepo)
-	getBalanceUC := wallet.NewGetBalanceUseCase(walletRepo, stellarClient)
+	getBalanceUC := wallet.NewGetBalanceUseCase(walletRepo, stellarClient, getAssetInfoUC, valuePortfolioUC, cfg.PricingDefaultCurrency, log)
 	listWalletsUC := wallet.NewListWalletsUseCase(walletRepo)
 	// Get Friendbot URL from environment variable
 	friendbotURL := cfg.FriendbotURL
 
 	fundWalletUC := wallet.NewFundWalletUseCase(walletRepo, friendbotURL, log)
-	sendPaymentUC := wallet.NewSendPaymentUseCase(walletRepo, stellarClient.GetHorizonClient(), encryptor, log)
-	getTransactionHistUC := wallet.NewGetTransactionHistoryUseCase(walletRepo, stellarClient.GetHorizonClient(), log)
+	holdPaymentUC := approval.NewHoldPaymentUseCase(approvalRepo, eventBus, log)
+	outflowGuard := wallet.NewOutflowGuard(addressBookRepo, spendingRepo, approvalRepo, log)
+	sendPaymentUC := wallet.NewSendPaymentUseCase(walletRepo, stellarClient.GetHorizonClient(), txBuilder, federationResolver, spendingRepo, addressBookRepo, holdPaymentUC, log)
+	addTrustlineUC := wallet.NewAddTrustlineUseCase(walletRepo, stellarClient.GetHorizonClient(), txBuilder, log)
+	removeTrustlineUC := wallet.NewRemoveTrustlineUseCase(walletRepo, stellarClient.GetHorizonClient(), txBuilder, log)
+	listTrustlinesUC := wallet.NewListTrustlinesUseCase(walletRepo, stellarClient)
+	getTransactionHistUC := wallet.NewGetTransactionHistoryUseCase(walletRepo, ledgerRepo, log)
+	getAccountHistoryUC := wallet.NewGetAccountHistoryUseCase(stellarClient.GetHorizonClient(), log)
+	snapshotBalancesUC := balance.NewSnapshotUseCase(walletRepo, snapshotRepo, stellarClient.GetHorizonClient(), cfg.StellarNetwork, log)
+	getBalanceAtUC := balance.NewGetBalanceAtUseCase(walletRepo, ledgerRepo, snapshotRepo, log)
+	getBalanceSeriesUC := balance.NewGetBalanceSeriesUseCase(walletRepo, ledgerRepo, snapshotRepo, log)
+	statementSigner, err := balance.ParseSigner(cfg.StatementSigningSeed)
+	if err != nil {
+		log.Fatal("failed to load statement signer", logger.Error(err))
+	}
+	if statementSigner == nil {
+		log.Warn("STATEMENT_SIGNING_SEED is not set, exported statements are not signed")
+	}
+	exportStatementUC := balance.NewExportStatementUseCase(walletRepo, ledgerRepo, snapshotRepo, statementSigner, log)
+	verifyStatementUC := balance.NewVerifyStatementUseCase(exportStatementUC.Signer())
+	closeWalletUC := wallet.NewCloseWalletUseCase(walletRepo, stellarClient.GetHorizonClient(), txBuilder, outflowGuard, log)
+
+	getFeeEstimateUC := fee.NewGetFeeEstimateUseCase(feeEstimator, log)
+	getSubmissionUC := submission.NewGetSubmissionUseCase(submissionRepo)
+	listSubmissionsUC := submission.NewListSubmissionsUseCase(submissionRepo)
+	expireSubmissionsUC := submission.NewExpireSubmissionsUseCase(submissionRepo, stellarClient.GetHorizonClient(), eventBus, log)
+
+	resolveAddressUC := federation.NewResolveAddressUseCase(federationResolver, log)
+	federationLookupUC := federation.NewLookupUseCase(federationRepo, walletRepo, cfg.FederationDomain)
+	assignNameUC := federation.NewAssignNameUseCase(federationRepo, walletRepo, cfg.FederationDomain, log)
+
+	issueAssetUC := asset.NewIssueAssetUseCase(assetRepo, walletRepo, txBuilder, log)
+	mintAssetUC := asset.NewMintAssetUseCase(assetRepo, walletRepo, txBuilder, log)
+	setAssetFlagsUC := asset.NewSetAssetFlagsUseCase(assetRepo, walletRepo, txBuilder, log)
+	authorizeTrustlineUC := asset.NewAuthorizeTrustlineUseCase(assetRepo, walletRepo, txBuilder, log)
+	clawbackUC := asset.NewClawbackUseCase(assetRepo, walletRepo, txBuilder, log)
+	lockIssuerUC := asset.NewLockIssuerUseCase(assetRepo, walletRepo, txBuilder, log)
+	getAssetUC := asset.NewGetAssetUseCase(assetRepo)
+	listAssetsUC := asset.NewListAssetsUseCase(assetRepo)
+
+	placeOfferUC := offer.NewPlaceOfferUseCase(walletRepo, txBuilder, outflowGuard, log)
+	updateOfferUC := offer.NewUpdateOfferUseCase(walletRepo, stellarClient.GetHorizonClient(), txBuilder, outflowGuard, log)
+	cancelOfferUC := offer.NewCancelOfferUseCase(walletRepo, stellarClient.GetHorizonClient(), txBuilder, log)
+	listOffersUC := offer.NewListOffersUseCase(walletRepo, stellarClient.GetHorizonClient(), log)
+	getOfferUC := offer.NewGetOfferUseCase(walletRepo, submissionRepo, stellarClient.GetHorizonClient(), log)
+	listTradesUC := offer.NewListTradesUseCase(walletRepo, stellarClient.GetHorizonClient(), log)
+
+	getPoolUC := liquiditypool.NewGetPoolUseCase(stellarClient)
+	depositLiquidityUC := liquiditypool.NewDepositUseCase(walletRepo, stellarClient.GetHorizonClient(), txBuilder, log)
+	withdrawLiquidityUC := liquiditypool.NewWithdrawUseCase(walletRepo, stellarClient.GetHorizonClient(), txBuilder, log)
+
+	createClaimableBalanceUC := claimable.NewCreateClaimableBalanceUseCase(walletRepo, txBuilder, outflowGuard, log)
+	listClaimableBalancesUC := claimable.NewListClaimableBalancesUseCase(walletRepo, stellarClient.GetHorizonClient(), log)
+	claimUC := claimable.NewClaimUseCase(walletRepo, stellarClient.GetHorizonClient(), txBuilder, log)
+
+	sponsorUC := sponsorship.NewSponsorUseCase(walletRepo, sponsorshipRepo, stellarClient.GetHorizonClient(), txBuilder, outflowGuard, log)
+	revokeSponsorshipUC := sponsorship.NewRevokeUseCase(walletRepo, sponsorshipRepo, txBuilder, log)
+	transferSponsorshipUC := sponsorship.NewTransferUseCase(walletRepo, sponsorshipRepo, txBuilder, log)
+	getSponsorshipUC := sponsorship.NewGetSponsorshipUseCase(sponsorshipRepo)
+	listSponsorshipsUC := sponsorship.NewListSponsorshipsUseCase(sponsorshipRepo)
+
+	createWebhookUC := webhook.NewCreateEndpointUseCase(webhookEndpointRepo, walletRepo, encryptor, log)
+	listWebhooksUC := webhook.NewListEndpointsUseCase(webhookEndpointRepo)
+	getWebhookUC := webhook.NewGetEndpointUseCase(webhookEndpointRepo)
+	updateWebhookUC := webhook.NewUpdateEndpointUseCase(webhookEndpointRepo, walletRepo, encryptor, log)
+	deleteWebhookUC := webhook.NewDeleteEndpointUseCase(webhookEndpointRepo, log)
+	listWebhookDeliveriesUC := webhook.NewListDeliveriesUseCase(webhookEndpointRepo, webhookDeliveryRepo)
+	getWebhookDeliveryUC := webhook.NewGetDeliveryUseCase(webhookEndpointRepo, webhookDeliveryRepo)
+	redeliverWebhookUC := webhook.NewRedeliverUseCase(webhookEndpointRepo, webhookDeliveryRepo, log)
+	enqueueWebhooksUC := webhook.NewEnqueueUseCase(webhookEndpointRepo, webhookDeliveryRepo, log)
+	webhookTimeout := parseDuration(cfg.WebhookTimeout)
+	deliverWebhooksUC := webhook.NewDeliverUseCase(webhookEndpointRepo, webhookDeliveryRepo, infraWebhook.NewHTTPSender(webhookTimeout, "quasarflow-webhooks/1.0"), encryptor, webhook.DeliveryConfig{
+		MaxAttempts:  cfg.WebhookMaxAttempts,
+		RetryBackoff: parseDuration(cfg.WebhookRetryBackoff),
+		MaxBackoff:   parseDuration(cfg.WebhookMaxRetryBackoff),
+		Timeout:      webhookTimeout,
+	}, log)
+
+	// Every published event is written to the webhook outbox
+	eventBus.Subscribe("webhooks", enqueueWebhooksUC.Execute)
+
+	// Every published event is stored for resuming streams and pushed to open ones
+	streamHub := eventbus.NewHub(cfg.StreamBufferSize, log)
+	recordEventsUC := eventUC.NewRecordUseCase(eventRepo, streamHub, log)
+	pruneEventsUC := eventUC.NewPruneUseCase(eventRepo, parseDuration(cfg.EventRetention), log)
+	streamWalletUC := eventUC.NewStreamUseCase(walletRepo, eventRepo, streamHub, getBalanceUC, eventUC.StreamConfig{
+		HeartbeatInterval: parseDuration(cfg.StreamHeartbeatInterval),
+	}, log)
+	eventBus.Subscribe("streams", recordEventsUC.Execute)
+
+	// Reconciliation events go through the bus, so webhooks subscribed to them are alerted
+	reconcileUC := reconciliation.NewReconcileUseCase(walletRepo, ledgerRepo, submissionRepo, reconciliationRepo, stellarClient.GetHorizonClient(), eventBus, reconciliation.Config{
+		Network: cfg.StellarNetwork,
+		Window:  parseDuration(cfg.ReconciliationWindow),
+	}, log)
+	listReconciliationReportsUC := reconciliation.NewListReportsUseCase(reconciliationRepo)
+	getReconciliationReportUC := reconciliation.NewGetReportUseCase(reconciliationRepo)
+
+	// Spending limits, enforced by sendPaymentUC before signing
+	setSpendingPolicyUC := spending.NewSetPolicyUseCase(spendingRepo, walletRepo, log)
+	listSpendingPoliciesUC := spending.NewListPoliciesUseCase(spendingRepo)
+	deleteSpendingPolicyUC := spending.NewDeletePolicyUseCase(spendingRepo, log)
+	getSpendingLimitsUC := spending.NewGetLimitsUseCase(spendingRepo, walletRepo)
+
+	// Address books, checked by sendPaymentUC in allow-list only mode
+	addressBookCooldown := parseDuration(cfg.AddressBookCooldown)
+	addAddressBookEntryUC := addressbook.NewAddEntryUseCase(addressBookRepo, walletRepo, federationResolver, addressBookCooldown, log)
+	listAddressBookEntriesUC := addressbook.NewListEntriesUseCase(addressBookRepo)
+	deleteAddressBookEntryUC := addressbook.NewDeleteEntryUseCase(addressBookRepo, log)
+	getAddressBookModeUC := addressbook.NewGetModeUseCase(addressBookRepo)
+	setAddressBookModeUC := addressbook.NewSetModeUseCase(addressBookRepo, walletRepo, addressBookCooldown, log)
+
+	// Payment approvals; payments above a policy threshold are held by holdPaymentUC
+	listApprovalRequestsUC := approval.NewListRequestsUseCase(approvalRepo)
+	getApprovalRequestUC := approval.NewGetRequestUseCase(approvalRepo)
+	decideApprovalUC := approval.NewDecideUseCase(approvalRepo, sendPaymentUC, eventBus, log)
+	expireApprovalsUC := approval.NewExpireRequestsUseCase(approvalRepo, submissionRepo, eventBus, log)
+	setApprovalPolicyUC := approval.NewSetPolicyUseCase(approvalRepo, walletRepo, log)
+	listApprovalPoliciesUC := approval.NewListPoliciesUseCase(approvalRepo)
+	deleteApprovalPolicyUC := approval.NewDeletePolicyUseCase(approvalRepo, log)
 
 	// Setup ownership verification use case
 	verifyOwnershipUC := wallet.NewVerifyOwnershipUseCase(*stellarClient, log, cfg.APIBaseURL)
 
 	// Setup authentication middleware
 	authConfig := middleware.AuthConfig{
-		SecretKey:     cfg.JWTSecret,
-		TokenDuration: parseDuration(cfg.JWTExpiration),
-		Issuer:        cfg.JWTIssuer,
+		SecretKey:           cfg.JWTSecret,
+		TokenDuration:       parseDuration(cfg.JWTExpiration),
+		StreamTokenDuration: parseDuration(cfg.StreamTokenTTL),
+		Issuer:              cfg.JWTIssuer,
 	}
 	authMiddleware := middleware.NewAuthMiddleware(authConfig, log)
+	users := loadUsers(cfg, log)
 
 	// Setup handlers
-	walletHandler := handler.NewWalletHandler(createWalletUC, getWalletUC, getBalanceUC, listWalletsUC, fundWalletUC, sendPaymentUC, getTransactionHistUC, log)
-	accountHandler := handler.NewAccountHandler(verifyOwnershipUC, getBalanceUC, getTransactionHistUC, log)
+	walletHandler := handler.NewWalletHandler(createWalletUC, getWalletUC, getBalanceUC, listWalletsUC, fundWalletUC, sendPaymentUC, getTransactionHistUC, closeWalletUC, log)
+	balanceHistoryHandler := handler.NewBalanceHistoryHandler(getBalanceAtUC, getBalanceSeriesUC, log)
+	statementHandler := handler.NewStatementHandler(exportStatementUC, verifyStatementUC, log)
+	accountHandler := handler.NewAccountHandler(verifyOwnershipUC, getBalanceUC, getAccountHistoryUC, log)
 	healthHandler := handler.NewHealthHandler(db)
-	authHandler := handler.NewAuthHandler(authMiddleware, log)
+	authHandler := handler.NewAuthHandler(authMiddleware, users, log)
+	feeHandler := handler.NewFeeHandler(getFeeEstimateUC, log)
+	submissionHandler := handler.NewSubmissionHandler(getSubmissionUC, listSubmissionsUC, log)
+	federationHandler := handler.NewFederationHandler(resolveAddressUC, federationLookupUC, assignNameUC, log)
+	trustlineHandler := handler.NewTrustlineHandler(addTrustlineUC, removeTrustlineUC, listTrustlinesUC, log)
+	registryHandler := handler.NewRegistryHandler(getAssetInfoUC, manageVerifiedAssetsUC, log)
+	assetHandler := handler.NewAssetHandler(issueAssetUC, mintAssetUC, setAssetFlagsUC, authorizeTrustlineUC, clawbackUC, lockIssuerUC, getAssetUC, listAssetsUC, log)
+	offerHandler := handler.NewOfferHandler(placeOfferUC, updateOfferUC, cancelOfferUC, listOffersUC, getOfferUC, listTradesUC, log)
+	liquidityPoolHandler := handler.NewLiquidityPoolHandler(getPoolUC, depositLiquidityUC, withdrawLiquidityUC, log)
+	priceHandler := handler.NewPriceHandler(getPriceUC, log)
+	claimableBalanceHandler := handler.NewClaimableBalanceHandler(createClaimableBalanceUC, listClaimableBalancesUC, claimUC, log)
+	sponsorshipHandler := handler.NewSponsorshipHandler(sponsorUC, revokeSponsorshipUC, transferSponsorshipUC, getSponsorshipUC, listSponsorshipsUC, log)
+	webhookHandler := handler.NewWebhookHandler(createWebhookUC, listWebhooksUC, getWebhookUC, updateWebhookUC, deleteWebhookUC, listWebhookDeliveriesUC, getWebhookDeliveryUC, redeliverWebhookUC, log)
+	streamHandler := handler.NewStreamHandler(streamWalletUC, cfg.AllowedOrigins, log)
+	reconciliationHandler := handler.NewReconciliationHandler(reconcileUC, listReconciliationReportsUC, getReconciliationReportUC, log)
+	spendingHandler := handler.NewSpendingHandler(setSpendingPolicyUC, listSpendingPoliciesUC, deleteSpendingPolicyUC, getSpendingLimitsUC, log)
+	addressBookHandler := handler.NewAddressBookHandler(addAddressBookEntryUC, listAddressBookEntriesUC, deleteAddressBookEntryUC, getAddressBookModeUC, setAddressBookModeUC, log)
+	approvalHandler := handler.NewApprovalHandler(listApprovalRequestsUC, getApprovalRequestUC, decideApprovalUC, setApprovalPolicyUC, listApprovalPoliciesUC, deleteApprovalPolicyUC, log)
 
 	// Setup router
-	router := httpHandler.SetupRouter(walletHandler, accountHandler, healthHandler, authHandler, cfg, log)
+	router := httpHandler.SetupRouter(walletHandler, accountHandler, healthHandler, authHandler, feeHandler, submissionHandler, federationHandler, trustlineHandler, assetHandler, registryHandler, offerHandler, liquidityPoolHandler, priceHandler, claimableBalanceHandler, sponsorshipHandler, webhookHandler, streamHandler, balanceHistoryHandler, statementHandler, reconciliationHandler, spendingHandler, addressBookHandler, approvalHandler, cfg, log)
 
 	// Setup HTTP server
 	srv := &http.Server{
@@ -89,6 +305,68 @@ func main() {
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// Start background workers
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	expiryWorker := worker.NewPeriodic("submission-expiry", parseDuration(cfg.SubmissionExpiryInterval), func(ctx context.Context) error {
+		_, err := expireSubmissionsUC.Execute(ctx)
+		return err
+	}, log)
+	go expiryWorker.Run(workerCtx)
+
+	webhookWorker := worker.NewPeriodic("webhook-delivery", parseDuration(cfg.WebhookDeliveryInterval), func(ctx context.Context) error {
+		_, err := deliverWebhooksUC.Execute(ctx)
+		return err
+	}, log)
+	go webhookWorker.Run(workerCtx)
+
+	eventPruneWorker := worker.NewPeriodic("event-prune", parseDuration(cfg.EventPruneInterval), func(ctx context.Context) error {
+		_, err := pruneEventsUC.Execute(ctx)
+		return err
+	}, log)
+	go eventPruneWorker.Run(workerCtx)
+
+	balanceSnapshotWorker := worker.NewPeriodic("balance-snapshot", parseDuration(cfg.BalanceSnapshotInterval), func(ctx context.Context) error {
+		_, err := snapshotBalancesUC.Execute(ctx)
+		return err
+	}, log)
+	go balanceSnapshotWorker.Run(workerCtx)
+
+	reconciliationWorker := worker.NewPeriodic("reconciliation", parseDuration(cfg.ReconciliationInterval), func(ctx context.Context) error {
+		_, err := reconcileUC.Execute(ctx)
+		return err
+	}, log)
+	go reconciliationWorker.Run(workerCtx)
+
+	approvalExpiryWorker := worker.NewPeriodic("approval-expiry", parseDuration(cfg.ApprovalExpiryInterval), func(ctx context.Context) error {
+		_, err := expireApprovalsUC.Execute(ctx)
+		return err
+	}, log)
+	go approvalExpiryWorker.Run(workerCtx)
+
+	if cfg.PaymentStreamEnabled {
+		paymentWatcher := stellar.NewPaymentWatcher(stellarClient.GetHorizonClient(), walletRepo, streamCursorRepo, eventBus, stellar.PaymentWatcherConfig{
+			Network:             cfg.StellarNetwork,
+			RefreshInterval:     parseDuration(cfg.PaymentStreamRefreshInterval),
+			ReconnectBackoff:    parseDuration(cfg.PaymentStreamReconnectBackoff),
+			MaxReconnectBackoff: parseDuration(cfg.PaymentStreamMaxBackoff),
+			SkipHistory:         cfg.PaymentStreamSkipHistory,
+		}, log)
+		go paymentWatcher.Run(workerCtx)
+	}
+
+	if cfg.LedgerIndexerEnabled {
+		ledgerIndexer := stellar.NewLedgerIndexer(stellarClient.GetHorizonClient(), walletRepo, ledgerRepo, stellar.LedgerIndexerConfig{
+			Network:             cfg.StellarNetwork,
+			RefreshInterval:     parseDuration(cfg.LedgerIndexerRefreshInterval),
+			ReconnectBackoff:    parseDuration(cfg.LedgerIndexerReconnectBackoff),
+			MaxReconnectBackoff: parseDuration(cfg.LedgerIndexerMaxBackoff),
+			BackfillPageSize:    cfg.LedgerIndexerBackfillPageSize,
+		}, log)
+		go ledgerIndexer.Run(workerCtx)
+	}
+
 	// Start server in goroutine
 	go func() {
 		log.Info("starting server", logger.String("address", cfg.ServerAddress))
@@ -103,6 +381,7 @@ func main() {
 	<-quit
 
 	log.Info("shutting down server...")
+	stopWorkers()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -123,3 +402,30 @@ func parseDuration(durationStr string) time.Duration {
 	}
 	return duration
 }
+
+// loadUsers returns the users configured with AUTH_USERS. Without it, development falls back
+// to the demo users; production refuses to start.
+func loadUsers(cfg *config.Config, log logger.Logger) *middleware.Users {
+	if len(cfg.AuthUsers) > 0 {
+		users, err := middleware.ParseUsers(cfg.AuthUsers)
+		if err != nil {
+			log.Fatal("failed to load AUTH_USERS", logger.Error(err))
+		}
+		log.Info("users loaded", logger.Int("users", users.Len()))
+		return users
+	}
+
+	if !cfg.AuthDemoUsers {
+		log.Fatal("AUTH_USERS must be set, or AUTH_DEMO_USERS=true to use the demo users")
+	}
+	if cfg.Environment == "production" {
+		log.Fatal("AUTH_DEMO_USERS cannot be used in production")
+	}
+
+	log.Warn("AUTH_DEMO_USERS is set, using the demo users admin and user")
+	users, err := middleware.DemoUsers()
+	if err != nil {
+		log.Fatal("failed to create demo users", logger.Error(err))
+	}
+	return users
+}
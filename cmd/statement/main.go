@@ -0,0 +1,232 @@
+// Command statement exports signed wallet statements and verifies exported files.
+//
+//	statement export -wallet <id> -from 2025-03-01 -to 2025-03-31 -format csv -out march.csv
+//	statement verify -file march.csv
+//
+// export writes the statement and its checksum, <out>.sig, as JSON. verify reads the
+// signature from <file>.sig unless it is given as a flag, and checks it against the
+// issuer key: STATEMENT_SIGNER_PUBLIC_KEY, or the public key of STATEMENT_SIGNING_SEED.
+// The signer named in <file>.sig is not trusted; another key is only used when it is
+// given with -signer.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"quasarflow-api/internal/config"
+	"quasarflow-api/internal/infrastructure/database"
+	"quasarflow-api/internal/usecase/balance"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+)
+
+const dateLayout = "2006-01-02"
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = export(ctx, os.Args[2:])
+	case "verify":
+		err = verify(ctx, os.Args[2:])
+	default:
+		usage()
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "statement:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: statement export -wallet <id> -from <date> -to <date> [-format csv|jsonl|ofx] [-asset <asset>] [-out <file>]")
+	fmt.Fprintln(os.Stderr, "       statement verify -file <file> [-signature <base64>] [-signer <public key>]")
+	os.Exit(2)
+}
+
+// export writes a wallet's statement, reading the database and signing seed configured
+// for the API
+func export(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	walletID := fs.String("wallet", "", "wallet ID")
+	from := fs.String("from", "", "first day of the statement, YYYY-MM-DD")
+	to := fs.String("to", "", "last day of the statement, YYYY-MM-DD, included")
+	format := fs.String("format", balance.FormatCSV, "csv, jsonl or ofx")
+	asset := fs.String("asset", "", "only this asset: native, CODE:ISSUER or liquidity_pool:<id>")
+	out := fs.String("out", "", "output file (default: named after the wallet and period)")
+	_ = fs.Parse(args)
+
+	id, err := uuid.Parse(*walletID)
+	if err != nil {
+		return fmt.Errorf("invalid -wallet: %w", err)
+	}
+	start, err := time.Parse(dateLayout, *from)
+	if err != nil {
+		return fmt.Errorf("invalid -from: %w", err)
+	}
+	end, err := time.Parse(dateLayout, *to)
+	if err != nil {
+		return fmt.Errorf("invalid -to: %w", err)
+	}
+
+	cfg := config.Load()
+	log := logger.New(cfg.LogLevel)
+
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	signer, err := balance.ParseSigner(cfg.StatementSigningSeed)
+	if err != nil {
+		return err
+	}
+	if signer == nil {
+		log.Warn("STATEMENT_SIGNING_SEED is not set, the statement is not signed")
+	}
+
+	exportStatementUC := balance.NewExportStatementUseCase(
+		database.NewPostgresWalletRepository(db),
+		database.NewPostgresLedgerRepository(db),
+		database.NewPostgresSnapshotRepository(db),
+		signer,
+		log,
+	)
+
+	statement, err := exportStatementUC.Prepare(ctx, balance.ExportStatementInput{
+		WalletID: id,
+		From:     start,
+		To:       end.AddDate(0, 0, 1),
+		Format:   *format,
+		Asset:    *asset,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := *out
+	if path == "" {
+		path = statement.Filename()
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	checksum, err := statement.WriteTo(ctx, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+
+	sig, err := json.MarshalIndent(checksum, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path+".sig", append(sig, '\n'), 0o644); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\nsha256: %s\n", path, checksum.SHA256)
+	return nil
+}
+
+// verify checks a file against its signature by the issuer key. It only reads the
+// signer keys from the environment, so it runs without the API's configuration.
+func verify(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	path := fs.String("file", "", "exported statement")
+	signature := fs.String("signature", "", "base64 signature (default: from <file>.sig)")
+	signer := fs.String("signer", "", "verify against this public key instead of the issuer key")
+	_ = fs.Parse(args)
+
+	if *path == "" {
+		return fmt.Errorf("-file is required")
+	}
+
+	expected := *signer
+	if expected == "" {
+		issuer, err := issuerKey()
+		if err != nil {
+			return err
+		}
+		expected = issuer
+	}
+
+	// The signer recorded in <file>.sig is only reported; whoever altered the file could
+	// have re-signed it and named their own key
+	var recorded string
+	if *signature == "" {
+		data, err := os.ReadFile(*path + ".sig")
+		if err != nil {
+			return fmt.Errorf("no -signature given and %w", err)
+		}
+		var checksum balance.StatementChecksum
+		if err := json.Unmarshal(data, &checksum); err != nil {
+			return fmt.Errorf("invalid %s.sig: %w", *path, err)
+		}
+		*signature = checksum.Signature
+		recorded = checksum.Signer
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	output, err := balance.NewVerifyStatementUseCase(expected).Execute(ctx, file, *signature, "")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("sha256: %s\nsigner: %s\n", output.SHA256, output.Signer)
+	if !output.Valid {
+		if recorded != "" && recorded != expected {
+			return fmt.Errorf("signature does not match: %s.sig names signer %s", *path, recorded)
+		}
+		return fmt.Errorf("signature does not match")
+	}
+	fmt.Println("signature valid")
+	return nil
+}
+
+// issuerKey returns the public key statements are expected to be signed with:
+// STATEMENT_SIGNER_PUBLIC_KEY, or the public key of STATEMENT_SIGNING_SEED
+func issuerKey() (string, error) {
+	if key := strings.TrimSpace(os.Getenv("STATEMENT_SIGNER_PUBLIC_KEY")); key != "" {
+		return key, nil
+	}
+
+	kp, err := balance.ParseSigner(os.Getenv("STATEMENT_SIGNING_SEED"))
+	if err != nil {
+		return "", err
+	}
+	if kp == nil {
+		return "", fmt.Errorf("no issuer key: set STATEMENT_SIGNER_PUBLIC_KEY or STATEMENT_SIGNING_SEED, or pass -signer")
+	}
+	return kp.Address(), nil
+}
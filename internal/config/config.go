@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/joho/godotenv"
@@ -23,11 +24,85 @@ type Config struct {
 	StellarNetwork    string
 	FriendbotURL      string
 
+	// Fee configuration
+	FeePercentile    int
+	FeeMaxBaseFee    int
+	FeeStatsCacheTTL string
+
+	// Transaction time bounds configuration
+	TxTimeoutDefault         string
+	TxTimeoutMax             string
+	SubmissionExpiryInterval string
+
+	// Federation configuration (SEP-2)
+	FederationDomain   string
+	FederationCacheTTL string
+	StellarTomlTimeout string
+
+	// Asset registry configuration (SEP-1)
+	AssetRegistryCacheTTL string
+
+	// Pricing configuration
+	PricingDefaultCurrency string
+	PricingUSDAsset        string
+	PricingCacheTTL        string
+	PricingMaxTradeAge     string
+	PriceFeedURL           string
+	PriceFeedAPIKey        string
+	PriceFeedTimeout       string
+
+	// Payment stream configuration
+	PaymentStreamEnabled          bool
+	PaymentStreamRefreshInterval  string
+	PaymentStreamReconnectBackoff string
+	PaymentStreamMaxBackoff       string
+	PaymentStreamSkipHistory      bool
+
+	// Ledger indexer configuration
+	LedgerIndexerEnabled          bool
+	LedgerIndexerRefreshInterval  string
+	LedgerIndexerReconnectBackoff string
+	LedgerIndexerMaxBackoff       string
+	LedgerIndexerBackfillPageSize int
+
+	// Balance snapshot configuration
+	BalanceSnapshotInterval string
+
+	// Statement export configuration
+	StatementSigningSeed string
+
+	// Reconciliation configuration
+	ReconciliationInterval string
+	ReconciliationWindow   string
+
+	// Address book configuration
+	AddressBookCooldown string
+
+	// Payment approval configuration
+	ApproverRole           string
+	ApprovalExpiryInterval string
+
+	// Webhook configuration
+	WebhookDeliveryInterval string
+	WebhookTimeout          string
+	WebhookMaxAttempts      int
+	WebhookRetryBackoff     string
+	WebhookMaxRetryBackoff  string
+
+	// Wallet stream configuration
+	StreamHeartbeatInterval string
+	StreamBufferSize        int
+	StreamTokenTTL          string
+	EventRetention          string
+	EventPruneInterval      string
+
 	// Security configuration
 	EncryptionKey  string
 	JWTSecret      string
 	JWTExpiration  string
 	JWTIssuer      string
+	AuthUsers      []string
+	AuthDemoUsers  bool
 	AllowedOrigins []string
 
 	// Frontend and API URLs
@@ -63,11 +138,85 @@ func Load() *Config {
 		StellarNetwork:    getEnv("STELLAR_NETWORK", "testnet"),
 		FriendbotURL:      getEnv("FRIENDBOT_URL", "https://horizon-testnet.stellar.org/friendbot"),
 
+		// Fees
+		FeePercentile:    getEnvInt("FEE_PERCENTILE", 70),
+		FeeMaxBaseFee:    getEnvInt("FEE_MAX_BASE_FEE", 10000),
+		FeeStatsCacheTTL: getEnv("FEE_STATS_CACHE_TTL", "5s"),
+
+		// Transaction time bounds
+		TxTimeoutDefault:         getEnv("TX_TIMEOUT_DEFAULT", "30s"),
+		TxTimeoutMax:             getEnv("TX_TIMEOUT_MAX", "5m"),
+		SubmissionExpiryInterval: getEnv("SUBMISSION_EXPIRY_INTERVAL", "30s"),
+
+		// Federation
+		FederationDomain:   getEnv("FEDERATION_DOMAIN", ""),
+		FederationCacheTTL: getEnv("FEDERATION_CACHE_TTL", "10m"),
+		StellarTomlTimeout: getEnv("STELLAR_TOML_TIMEOUT", "10s"),
+
+		// Asset registry
+		AssetRegistryCacheTTL: getEnv("ASSET_REGISTRY_CACHE_TTL", "1h"),
+
+		// Pricing
+		PricingDefaultCurrency: getEnv("PRICING_DEFAULT_CURRENCY", "USD"),
+		PricingUSDAsset:        getEnv("PRICING_USD_ASSET", "USDC:GA5ZSEJYB37JRC5AVCIA5MOP4RHTM335X2KGX3IHOJAPP5RE34K4KZVN"),
+		PricingCacheTTL:        getEnv("PRICING_CACHE_TTL", "1m"),
+		PricingMaxTradeAge:     getEnv("PRICING_MAX_TRADE_AGE", "24h"),
+		PriceFeedURL:           getEnv("PRICE_FEED_URL", ""),
+		PriceFeedAPIKey:        getEnv("PRICE_FEED_API_KEY", ""),
+		PriceFeedTimeout:       getEnv("PRICE_FEED_TIMEOUT", "5s"),
+
+		// Payment stream
+		PaymentStreamEnabled:          getEnvBool("PAYMENT_STREAM_ENABLED", true),
+		PaymentStreamRefreshInterval:  getEnv("PAYMENT_STREAM_REFRESH_INTERVAL", "30s"),
+		PaymentStreamReconnectBackoff: getEnv("PAYMENT_STREAM_RECONNECT_BACKOFF", "1s"),
+		PaymentStreamMaxBackoff:       getEnv("PAYMENT_STREAM_MAX_BACKOFF", "1m"),
+		PaymentStreamSkipHistory:      getEnvBool("PAYMENT_STREAM_SKIP_HISTORY", false),
+
+		// Ledger indexer
+		LedgerIndexerEnabled:          getEnvBool("LEDGER_INDEXER_ENABLED", true),
+		LedgerIndexerRefreshInterval:  getEnv("LEDGER_INDEXER_REFRESH_INTERVAL", "30s"),
+		LedgerIndexerReconnectBackoff: getEnv("LEDGER_INDEXER_RECONNECT_BACKOFF", "1s"),
+		LedgerIndexerMaxBackoff:       getEnv("LEDGER_INDEXER_MAX_BACKOFF", "1m"),
+		LedgerIndexerBackfillPageSize: getEnvInt("LEDGER_INDEXER_BACKFILL_PAGE_SIZE", 200),
+
+		// Balance snapshots
+		BalanceSnapshotInterval: getEnv("BALANCE_SNAPSHOT_INTERVAL", "24h"),
+
+		// Statements
+		StatementSigningSeed: getEnv("STATEMENT_SIGNING_SEED", ""),
+
+		// Reconciliation
+		ReconciliationInterval: getEnv("RECONCILIATION_INTERVAL", "24h"),
+		ReconciliationWindow:   getEnv("RECONCILIATION_WINDOW", "168h"),
+
+		// Address book
+		AddressBookCooldown: getEnv("ADDRESS_BOOK_COOLDOWN", "24h"),
+
+		// Payment approvals
+		ApproverRole:           getEnv("APPROVER_ROLE", "approver"),
+		ApprovalExpiryInterval: getEnv("APPROVAL_EXPIRY_INTERVAL", "1m"),
+
+		// Webhooks
+		WebhookDeliveryInterval: getEnv("WEBHOOK_DELIVERY_INTERVAL", "5s"),
+		WebhookTimeout:          getEnv("WEBHOOK_TIMEOUT", "10s"),
+		WebhookMaxAttempts:      getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		WebhookRetryBackoff:     getEnv("WEBHOOK_RETRY_BACKOFF", "30s"),
+		WebhookMaxRetryBackoff:  getEnv("WEBHOOK_MAX_RETRY_BACKOFF", "6h"),
+
+		// Wallet streams
+		StreamHeartbeatInterval: getEnv("STREAM_HEARTBEAT_INTERVAL", "10s"),
+		StreamBufferSize:        getEnvInt("STREAM_BUFFER_SIZE", 64),
+		StreamTokenTTL:          getEnv("STREAM_TOKEN_TTL", "1m"),
+		EventRetention:          getEnv("EVENT_RETENTION", "168h"),
+		EventPruneInterval:      getEnv("EVENT_PRUNE_INTERVAL", "1h"),
+
 		// Security
 		EncryptionKey:  getEnvRequired("ENCRYPTION_KEY"),
 		JWTSecret:      getEnv("JWT_SECRET", "default-jwt-secret-change-in-production"),
 		JWTExpiration:  getEnv("JWT_EXPIRATION", "24h"),
 		JWTIssuer:      getEnv("JWT_ISSUER", "quasarflow-api"),
+		AuthUsers:      getEnvSlice("AUTH_USERS", nil),
+		AuthDemoUsers:  getEnvBool("AUTH_DEMO_USERS", false),
 		AllowedOrigins: getEnvSlice("ALLOWED_ORIGINS", []string{"http://localhost:3000", "http://localhost:8080"}),
 
 		// Frontend and API URLs
@@ -125,6 +274,16 @@ func getEnvFloat64(key string, defaultValue float64) float64 {
 	return defaultValue
 }
 
+// getEnvBool retrieves a boolean environment variable or returns a default value
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
 // getEnvSlice retrieves a slice environment variable or returns a default value
 func getEnvSlice(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
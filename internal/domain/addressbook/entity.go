@@ -0,0 +1,110 @@
+package addressbook
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scopes an address book belongs to
+const (
+	ScopeWallet = "wallet" // Counterparties of one wallet
+	ScopeUser   = "user"   // Counterparties of one user, for payments from any wallet
+)
+
+// Entry is a named counterparty. New entries can only be paid in allow-list only mode
+// once their cool-down has passed.
+type Entry struct {
+	ID                uuid.UUID
+	Scope             string
+	SubjectID         string // Wallet ID or user ID
+	Name              string
+	Address           string // G... account ID
+	Memo              string // Optional; when set, payments must carry this memo to match
+	MemoType          string // Type of Memo: text, id, hash or return
+	FederationAddress string // Optional name*domain the address was resolved from
+	CreatedBy         string // User who added the entry
+	CreatedAt         time.Time
+	UsableAt          time.Time // End of the cool-down
+}
+
+// NewEntry creates an entry usable once coolDown has passed
+func NewEntry(scope, subjectID, name, address, memo, memoType, federationAddress, createdBy string, coolDown time.Duration) (*Entry, error) {
+	if scope != ScopeWallet && scope != ScopeUser {
+		return nil, fmt.Errorf("scope must be wallet or user")
+	}
+	if subjectID == "" {
+		return nil, fmt.Errorf("subject id is required")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, fmt.Errorf("name is required and must be at most 100 characters")
+	}
+	if !strings.HasPrefix(address, "G") || len(address) != 56 {
+		return nil, fmt.Errorf("address must be a Stellar account ID")
+	}
+	if memo == "" {
+		memoType = ""
+	}
+
+	now := time.Now()
+	return &Entry{
+		ID:                uuid.New(),
+		Scope:             scope,
+		SubjectID:         subjectID,
+		Name:              name,
+		Address:           address,
+		Memo:              memo,
+		MemoType:          memoType,
+		FederationAddress: federationAddress,
+		CreatedBy:         createdBy,
+		CreatedAt:         now,
+		UsableAt:          now.Add(coolDown),
+	}, nil
+}
+
+// Usable reports whether the entry's cool-down has passed at now
+func (e *Entry) Usable(now time.Time) bool {
+	return !now.Before(e.UsableAt)
+}
+
+// Matches reports whether a payment to address with the memo goes to this counterparty.
+// Entries without a memo match any memo.
+func (e *Entry) Matches(address, memo, memoType string) bool {
+	if e.Address != address {
+		return false
+	}
+	return e.Memo == "" || (e.Memo == memo && e.MemoType == memoType)
+}
+
+// Mode is whether payments covered by an address book are restricted to its entries
+type Mode struct {
+	Scope         string
+	SubjectID     string
+	AllowlistOnly bool
+	RelaxesAt     *time.Time // Set when allow-list only was turned off; it stays enforced until then
+	UpdatedAt     time.Time
+}
+
+// Enforced reports whether payments are restricted to the address book at now
+func (m *Mode) Enforced(now time.Time) bool {
+	return m.AllowlistOnly || (m.RelaxesAt != nil && now.Before(*m.RelaxesAt))
+}
+
+// Set turns allow-list only mode on at once, or off once coolDown has passed so that
+// compromised credentials cannot lift the restriction and pay out straight away
+func (m *Mode) Set(allowlistOnly bool, coolDown time.Duration) {
+	now := time.Now()
+	switch {
+	case allowlistOnly:
+		m.RelaxesAt = nil
+	case m.Enforced(now) && m.RelaxesAt == nil:
+		relaxesAt := now.Add(coolDown)
+		m.RelaxesAt = &relaxesAt
+	}
+	m.AllowlistOnly = allowlistOnly
+	m.UpdatedAt = now
+}
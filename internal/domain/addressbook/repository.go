@@ -0,0 +1,21 @@
+package addressbook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, entry *Entry) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Entry, error)
+	// List returns the entries of an address book by name
+	List(ctx context.Context, scope, subjectID string) ([]*Entry, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// FindForPayment returns the entries for address in the wallet's and the user's address books
+	FindForPayment(ctx context.Context, walletID uuid.UUID, userID, address string) ([]*Entry, error)
+
+	// GetMode returns the mode of an address book; allow-list only is off for books without one
+	GetMode(ctx context.Context, scope, subjectID string) (*Mode, error)
+	SaveMode(ctx context.Context, mode *Mode) error
+}
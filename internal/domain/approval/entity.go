@@ -0,0 +1,202 @@
+package approval
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Policy holds a wallet's payments of an asset above a threshold for approval
+type Policy struct {
+	ID        uuid.UUID
+	WalletID  uuid.UUID
+	Asset     string          // "native" or "CODE:ISSUER"
+	Threshold decimal.Decimal // Payments of more than this need approval
+	Quorum    int             // Approvals needed
+	TTL       time.Duration   // How long requests stay open
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewPolicy creates a policy after validating it
+func NewPolicy(walletID uuid.UUID, asset string, threshold decimal.Decimal, quorum int, ttl time.Duration) (*Policy, error) {
+	if asset == "" {
+		return nil, fmt.Errorf("asset is required")
+	}
+	if threshold.IsNegative() {
+		return nil, fmt.Errorf("threshold cannot be negative")
+	}
+	if quorum < 1 {
+		return nil, fmt.Errorf("quorum must be at least 1")
+	}
+	if ttl <= 0 {
+		return nil, fmt.Errorf("ttl must be positive")
+	}
+
+	now := time.Now()
+	return &Policy{
+		ID:        uuid.New(),
+		WalletID:  walletID,
+		Asset:     asset,
+		Threshold: threshold,
+		Quorum:    quorum,
+		TTL:       ttl,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Requires reports whether a payment of amount needs approval under the policy
+func (p *Policy) Requires(amount decimal.Decimal) bool {
+	return amount.GreaterThan(p.Threshold)
+}
+
+// Status is where a request is in its workflow
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved" // Quorum reached, being executed
+	StatusRejected Status = "rejected"
+	StatusExpired  Status = "expired"
+	StatusExecuted Status = "executed" // Submitted and included in a ledger
+	StatusFailed   Status = "failed"   // Approved, but the payment could not be made
+)
+
+// Audit trail actions
+const (
+	ActionRequested = "requested"
+	ActionApproved  = "approved"
+	ActionRejected  = "rejected"
+	ActionExpired   = "expired"
+	ActionExecuted  = "executed"
+	ActionFailed    = "failed"
+)
+
+// SystemActor records actions taken by the service rather than a user
+const SystemActor = "system"
+
+// AuditEntry is one step of a request's history
+type AuditEntry struct {
+	Action  string
+	Actor   string
+	Comment string
+	At      time.Time
+}
+
+// Request is a payment held until enough approvers approve it
+type Request struct {
+	ID              uuid.UUID
+	WalletID        uuid.UUID
+	PolicyID        uuid.UUID
+	Asset           string
+	Amount          decimal.Decimal
+	Destination     string
+	Memo            string
+	Payment         []byte // JSON of the payment, executed as is once approved
+	RequestedBy     string
+	Quorum          int
+	Approvals       int
+	Status          Status
+	TransactionHash string
+	SubmissionID    string
+	Error           string
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Version         int // Incremented on every update, guards concurrent decisions
+	Trail           []AuditEntry
+}
+
+// NewRequest creates a pending request for a payment held by the policy
+func NewRequest(policy *Policy, amount decimal.Decimal, destination, memo string, payment []byte, requestedBy string) *Request {
+	now := time.Now()
+	return &Request{
+		ID:          uuid.New(),
+		WalletID:    policy.WalletID,
+		PolicyID:    policy.ID,
+		Asset:       policy.Asset,
+		Amount:      amount,
+		Destination: destination,
+		Memo:        memo,
+		Payment:     payment,
+		RequestedBy: requestedBy,
+		Quorum:      policy.Quorum,
+		Status:      StatusPending,
+		ExpiresAt:   now.Add(policy.TTL),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Trail:       []AuditEntry{{Action: ActionRequested, Actor: requestedBy, At: now}},
+	}
+}
+
+// IsPending reports whether the request still awaits decisions
+func (r *Request) IsPending() bool {
+	return r.Status == StatusPending
+}
+
+// IsExpired reports whether a pending request's time ran out at now
+func (r *Request) IsExpired(now time.Time) bool {
+	return r.IsPending() && !now.Before(r.ExpiresAt)
+}
+
+// HasDecided reports whether actor already approved or rejected the request
+func (r *Request) HasDecided(actor string) bool {
+	for _, e := range r.Trail {
+		if e.Actor == actor && (e.Action == ActionApproved || e.Action == ActionRejected) {
+			return true
+		}
+	}
+	return false
+}
+
+// Approve records an approval; the request is approved once the quorum is reached
+func (r *Request) Approve(actor, comment string) {
+	r.Approvals++
+	r.record(ActionApproved, actor, comment)
+	if r.Approvals >= r.Quorum {
+		r.Status = StatusApproved
+	}
+}
+
+// Reject rejects the request; a single rejection ends it
+func (r *Request) Reject(actor, comment string) {
+	r.Status = StatusRejected
+	r.record(ActionRejected, actor, comment)
+}
+
+// Expire ends a request whose time ran out
+func (r *Request) Expire() {
+	r.Status = StatusExpired
+	r.record(ActionExpired, SystemActor, "")
+}
+
+// Signed records the hash of the payment transaction ahead of its submission, so that an
+// execution cut short can be settled from the submission
+func (r *Request) Signed(transactionHash string) {
+	r.TransactionHash = transactionHash
+	r.UpdatedAt = time.Now()
+}
+
+// Executed records the payment made for an approved request
+func (r *Request) Executed(transactionHash, submissionID string) {
+	r.Status = StatusExecuted
+	r.TransactionHash = transactionHash
+	r.SubmissionID = submissionID
+	r.record(ActionExecuted, SystemActor, transactionHash)
+}
+
+// Failed records why the payment of an approved request could not be made
+func (r *Request) Failed(err error) {
+	r.Status = StatusFailed
+	r.Error = err.Error()
+	r.record(ActionFailed, SystemActor, r.Error)
+}
+
+func (r *Request) record(action, actor, comment string) {
+	now := time.Now()
+	r.Trail = append(r.Trail, AuditEntry{Action: action, Actor: actor, Comment: comment, At: now})
+	r.UpdatedAt = now
+}
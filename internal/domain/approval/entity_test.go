@@ -0,0 +1,151 @@
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func newTestRequest(t *testing.T, quorum int) *Request {
+	t.Helper()
+	policy, err := NewPolicy(uuid.New(), "native", decimal.NewFromInt(1000), quorum, time.Hour)
+	if err != nil {
+		t.Fatalf("NewPolicy() error = %v", err)
+	}
+	return NewRequest(policy, decimal.NewFromInt(5000), "GDEST", "", nil, "requester")
+}
+
+func TestRequestDecisions(t *testing.T) {
+	type decision struct {
+		actor   string
+		approve bool
+	}
+
+	tests := []struct {
+		name          string
+		quorum        int
+		decisions     []decision
+		wantStatus    Status
+		wantApprovals int
+	}{
+		{
+			name:       "no decisions",
+			quorum:     2,
+			wantStatus: StatusPending,
+		},
+		{
+			name:          "single approval reaches a quorum of one",
+			quorum:        1,
+			decisions:     []decision{{"alice", true}},
+			wantStatus:    StatusApproved,
+			wantApprovals: 1,
+		},
+		{
+			name:          "below the quorum",
+			quorum:        2,
+			decisions:     []decision{{"alice", true}},
+			wantStatus:    StatusPending,
+			wantApprovals: 1,
+		},
+		{
+			name:          "quorum reached",
+			quorum:        2,
+			decisions:     []decision{{"alice", true}, {"bob", true}},
+			wantStatus:    StatusApproved,
+			wantApprovals: 2,
+		},
+		{
+			name:       "single rejection ends the request",
+			quorum:     2,
+			decisions:  []decision{{"alice", false}},
+			wantStatus: StatusRejected,
+		},
+		{
+			name:          "rejection after an approval",
+			quorum:        3,
+			decisions:     []decision{{"alice", true}, {"bob", false}},
+			wantStatus:    StatusRejected,
+			wantApprovals: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRequest(t, tt.quorum)
+			for _, d := range tt.decisions {
+				if d.approve {
+					r.Approve(d.actor, "")
+				} else {
+					r.Reject(d.actor, "")
+				}
+			}
+
+			if r.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", r.Status, tt.wantStatus)
+			}
+			if r.Approvals != tt.wantApprovals {
+				t.Errorf("approvals = %d, want %d", r.Approvals, tt.wantApprovals)
+			}
+			if got := len(r.Trail); got != len(tt.decisions)+1 {
+				t.Errorf("trail has %d entries, want %d", got, len(tt.decisions)+1)
+			}
+			for _, d := range tt.decisions {
+				if !r.HasDecided(d.actor) {
+					t.Errorf("HasDecided(%s) = false after deciding", d.actor)
+				}
+			}
+			if r.HasDecided("requester") {
+				t.Errorf("HasDecided(requester) = true; requesting is not a decision")
+			}
+		})
+	}
+}
+
+func TestRequestIsExpired(t *testing.T) {
+	r := newTestRequest(t, 1)
+
+	tests := []struct {
+		name   string
+		status Status
+		now    time.Time
+		want   bool
+	}{
+		{name: "pending before expiry", status: StatusPending, now: r.ExpiresAt.Add(-time.Second)},
+		{name: "pending at expiry", status: StatusPending, now: r.ExpiresAt, want: true},
+		{name: "pending after expiry", status: StatusPending, now: r.ExpiresAt.Add(time.Second), want: true},
+		{name: "decided requests do not expire", status: StatusApproved, now: r.ExpiresAt.Add(time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := *r
+			req.Status = tt.status
+			if got := req.IsExpired(tt.now); got != tt.want {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyRequires(t *testing.T) {
+	policy := &Policy{Threshold: decimal.NewFromInt(1000)}
+
+	tests := []struct {
+		amount string
+		want   bool
+	}{
+		{amount: "999.9999999"},
+		{amount: "1000"},
+		{amount: "1000.0000001", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			if got := policy.Requires(decimal.RequireFromString(tt.amount)); got != tt.want {
+				t.Errorf("Requires(%s) = %v, want %v", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package approval
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Filter narrows a request listing; zero values match everything
+type Filter struct {
+	WalletID uuid.UUID
+	Status   Status
+}
+
+type Repository interface {
+	// SavePolicy creates the policy, or replaces the one for the same wallet and asset,
+	// whose ID it takes
+	SavePolicy(ctx context.Context, policy *Policy) error
+	DeletePolicy(ctx context.Context, id uuid.UUID) error
+	// ListPolicies returns the policies of a wallet, or of every wallet for uuid.Nil
+	ListPolicies(ctx context.Context, walletID uuid.UUID) ([]*Policy, error)
+	// FindPolicy returns the wallet's policy for an asset, nil when there is none
+	FindPolicy(ctx context.Context, walletID uuid.UUID, asset string) (*Policy, error)
+
+	Create(ctx context.Context, request *Request) error
+	// FindByID returns a request with its audit trail
+	FindByID(ctx context.Context, id uuid.UUID) (*Request, error)
+	List(ctx context.Context, filter Filter, limit, offset int) ([]*Request, error)
+	Count(ctx context.Context, filter Filter) (int64, error)
+	// ListExpired returns pending requests whose time ran out at now
+	ListExpired(ctx context.Context, now time.Time) ([]*Request, error)
+	// ListUnsettled returns approved requests last updated before the given time, whose
+	// execution was cut short before its outcome was recorded
+	ListUnsettled(ctx context.Context, before time.Time) ([]*Request, error)
+	// Update saves a request's status and new audit entries and reports true, unless the
+	// request changed since it was read, in which case nothing is saved
+	Update(ctx context.Context, request *Request) (bool, error)
+}
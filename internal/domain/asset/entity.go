@@ -0,0 +1,72 @@
+package asset
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var codePattern = regexp.MustCompile(`^[a-zA-Z0-9]{1,12}$`)
+
+// Asset is a token issued from a managed issuer wallet
+type Asset struct {
+	ID                   uuid.UUID
+	Code                 string
+	IssuerWalletID       uuid.UUID
+	IssuerPublicKey      string
+	DistributionWalletID uuid.UUID
+	AuthRequired         bool // AUTH_REQUIRED: trustlines must be authorized by the issuer
+	AuthRevocable        bool // AUTH_REVOCABLE: the issuer may revoke authorization
+	ClawbackEnabled      bool // AUTH_CLAWBACK_ENABLED: new trustlines can be clawed back
+	Locked               bool // Issuer master key weight set to zero; no further issuance
+	Minted               decimal.Decimal
+	ClawedBack           decimal.Decimal
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// NewAsset creates an asset issued by issuerWalletID and held initially by distributionWalletID
+func NewAsset(code string, issuerWalletID uuid.UUID, issuerPublicKey string, distributionWalletID uuid.UUID) (*Asset, error) {
+	if !codePattern.MatchString(code) || strings.EqualFold(code, "XLM") {
+		return nil, fmt.Errorf("asset code must be 1-12 alphanumeric characters and cannot be XLM")
+	}
+
+	if issuerWalletID == distributionWalletID {
+		return nil, fmt.Errorf("issuer and distribution wallets must be different")
+	}
+
+	now := time.Now()
+	return &Asset{
+		ID:                   uuid.New(),
+		Code:                 code,
+		IssuerWalletID:       issuerWalletID,
+		IssuerPublicKey:      issuerPublicKey,
+		DistributionWalletID: distributionWalletID,
+		Minted:               decimal.Zero,
+		ClawedBack:           decimal.Zero,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}, nil
+}
+
+// Supply returns the amount in circulation: minted minus clawed back
+func (a *Asset) Supply() decimal.Decimal {
+	return a.Minted.Sub(a.ClawedBack)
+}
+
+// SetFlags records the issuer account flags
+func (a *Asset) SetFlags(authRequired, authRevocable, clawbackEnabled bool) error {
+	if clawbackEnabled && !authRevocable {
+		return fmt.Errorf("clawback requires auth_revocable")
+	}
+
+	a.AuthRequired = authRequired
+	a.AuthRevocable = authRevocable
+	a.ClawbackEnabled = clawbackEnabled
+	a.UpdatedAt = time.Now()
+	return nil
+}
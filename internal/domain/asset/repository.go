@@ -0,0 +1,25 @@
+package asset
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type Repository interface {
+	Create(ctx context.Context, asset *Asset) error
+	// UpdateFlags saves the issuer flags only, so it cannot undo a concurrent lock or supply change
+	UpdateFlags(ctx context.Context, asset *Asset) error
+	// MarkLocked records that the issuer is locked
+	MarkLocked(ctx context.Context, asset *Asset) error
+	// AddMinted and AddClawedBack change the supply atomically and reload it into asset
+	AddMinted(ctx context.Context, asset *Asset, amount decimal.Decimal) error
+	AddClawedBack(ctx context.Context, asset *Asset, amount decimal.Decimal) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Asset, error)
+	FindByCodeAndIssuer(ctx context.Context, code, issuerPublicKey string) (*Asset, error)
+	List(ctx context.Context, limit, offset int) ([]*Asset, error)
+	Count(ctx context.Context) (int64, error)
+	// ListByIssuerWallet returns every asset issued from the given wallet
+	ListByIssuerWallet(ctx context.Context, issuerWalletID uuid.UUID) ([]*Asset, error)
+}
@@ -0,0 +1,82 @@
+package claimable
+
+import (
+	"fmt"
+	"time"
+)
+
+// Predicate types accepted by the API. The ledger only knows unconditional, and, or,
+// not and the two "before" forms; the "after" forms are built as not(before).
+const (
+	PredicateUnconditional      = "unconditional"
+	PredicateAnd                = "and"
+	PredicateOr                 = "or"
+	PredicateNot                = "not"
+	PredicateBeforeAbsoluteTime = "before_absolute_time"
+	PredicateAfterAbsoluteTime  = "after_absolute_time"
+	PredicateBeforeRelativeTime = "before_relative_time"
+	PredicateAfterRelativeTime  = "after_relative_time"
+)
+
+// MaxPredicateDepth is the deepest predicate tree the ledger accepts
+const MaxPredicateDepth = 4
+
+// MaxClaimants is the most claimants a claimable balance can have
+const MaxClaimants = 10
+
+// Predicate is a condition under which a claimant may claim a balance
+type Predicate struct {
+	Type       string
+	Time       time.Time   // Absolute time predicates
+	Seconds    int64       // Relative time predicates, counted from the ledger that creates the balance
+	Predicates []Predicate // Operands of and, or (two) and not (one)
+}
+
+// Validate checks the predicate tree against the ledger's rules
+func (p *Predicate) Validate() error {
+	return p.validate(1)
+}
+
+func (p *Predicate) validate(depth int) error {
+	if depth > MaxPredicateDepth {
+		return fmt.Errorf("predicates cannot be nested more than %d levels deep", MaxPredicateDepth)
+	}
+
+	operands := 0
+	switch p.Type {
+	case PredicateUnconditional:
+	case PredicateAnd, PredicateOr:
+		operands = 2
+	case PredicateNot:
+		operands = 1
+	case PredicateBeforeAbsoluteTime, PredicateAfterAbsoluteTime:
+		if p.Time.IsZero() || p.Time.Unix() <= 0 {
+			return fmt.Errorf("%s requires a time", p.Type)
+		}
+	case PredicateBeforeRelativeTime, PredicateAfterRelativeTime:
+		if p.Seconds <= 0 {
+			return fmt.Errorf("%s requires a positive number of seconds", p.Type)
+		}
+	default:
+		return fmt.Errorf("unknown predicate type %q", p.Type)
+	}
+
+	if len(p.Predicates) != operands {
+		return fmt.Errorf("%s takes %d predicates, got %d", p.Type, operands, len(p.Predicates))
+	}
+
+	// after_* predicates are encoded as not(before_*) and use one more level
+	if p.Type == PredicateAfterAbsoluteTime || p.Type == PredicateAfterRelativeTime {
+		if depth+1 > MaxPredicateDepth {
+			return fmt.Errorf("predicates cannot be nested more than %d levels deep", MaxPredicateDepth)
+		}
+	}
+
+	for i := range p.Predicates {
+		if err := p.Predicates[i].validate(depth + 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Type identifies what happened to a managed wallet
+type Type string
+
+const (
+	TypeWalletCreated             Type = "wallet.created"
+	TypePaymentReceived           Type = "payment.received"
+	TypePaymentSent               Type = "payment.sent"
+	TypeTransactionFailed         Type = "transaction.failed"
+	TypeTrustlineChanged          Type = "trustline.changed"
+	TypeReconciliationDiscrepancy Type = "reconciliation.discrepancy"
+	TypeApprovalRequested         Type = "approval.requested"
+	TypeApprovalCompleted         Type = "approval.completed"
+)
+
+// Types lists every event type, in documentation order
+var Types = []Type{
+	TypeWalletCreated,
+	TypePaymentReceived,
+	TypePaymentSent,
+	TypeTransactionFailed,
+	TypeTrustlineChanged,
+	TypeReconciliationDiscrepancy,
+	TypeApprovalRequested,
+	TypeApprovalCompleted,
+}
+
+// IsValidType reports whether t is a known event type
+func IsValidType(t Type) bool {
+	for _, known := range Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a domain event about a managed wallet. Exactly one of the detail
+// fields is set, matching the event type.
+type Event struct {
+	ID        uuid.UUID // Derived from wallet, type and source record so redeliveries can be deduplicated
+	Type      Type
+	WalletID  uuid.UUID
+	PublicKey string // Wallet account, empty when the producer does not know it
+
+	Wallet         *Wallet         // wallet.created
+	Payment        *Payment        // payment.received and payment.sent
+	Transaction    *Transaction    // transaction.failed
+	Trustline      *Trustline      // trustline.changed
+	Reconciliation *Reconciliation // reconciliation.discrepancy
+	Approval       *Approval       // approval.requested and approval.completed
+
+	OccurredAt time.Time
+	CreatedAt  time.Time
+}
+
+// Wallet describes a newly created wallet
+type Wallet struct {
+	Network string
+}
+
+// Payment is a ledger operation that moved funds into or out of the wallet
+type Payment struct {
+	OperationID     string
+	OperationType   string // payment, path_payment_strict_receive, path_payment_strict_send, create_account or account_merge
+	TransactionHash string
+	Ledger          int32
+	PagingToken     string
+	From            string
+	To              string
+	Amount          decimal.Decimal // Amount credited to the destination
+	AssetType       string
+	AssetCode       string
+	AssetIssuer     string
+	// Set for path payments, where the source spends a different asset than the destination receives
+	SourceAmount      *decimal.Decimal
+	SourceAssetType   string
+	SourceAssetCode   string
+	SourceAssetIssuer string
+	MemoType          string
+	Memo              string
+}
+
+// Transaction is a submission that the network rejected or that expired unsubmitted
+type Transaction struct {
+	SubmissionID uuid.UUID
+	Kind         string
+	Hash         string
+	Status       string // failed or expired
+	ResultCode   string
+	ErrorMessage string
+}
+
+// Trustline actions
+const (
+	TrustlineLimitSet = "limit_set" // Created, or its limit changed
+	TrustlineRemoved  = "removed"
+)
+
+// Trustline is a change to one of the wallet's trustlines
+type Trustline struct {
+	Action          string
+	AssetCode       string
+	AssetIssuer     string
+	Limit           string
+	TransactionHash string
+	Ledger          int32
+}
+
+// Reconciliation summarizes a reconciliation run that found the wallet's records
+// differing from the ledger
+type Reconciliation struct {
+	ReportID   uuid.UUID
+	Missing    int
+	Unexpected int
+	Mismatched int
+}
+
+// Approval is a payment held for approval, when it is requested and when its workflow ends
+type Approval struct {
+	RequestID       uuid.UUID
+	Status          string // pending, or the final status: executed, rejected, expired or failed
+	Asset           string
+	Amount          decimal.Decimal
+	Destination     string
+	RequestedBy     string
+	Approvals       int
+	Quorum          int
+	ExpiresAt       time.Time
+	TransactionHash string
+	Error           string
+}
+
+// New creates an event whose ID is stable for the same wallet, type and key,
+// where key identifies the record the event was derived from
+func New(eventType Type, walletID uuid.UUID, key string) *Event {
+	name := fmt.Sprintf("%s:%s:%s", walletID, eventType, key)
+	now := time.Now()
+
+	return &Event{
+		ID:         uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)),
+		Type:       eventType,
+		WalletID:   walletID,
+		OccurredAt: now,
+		CreatedAt:  now,
+	}
+}
+
+// Handler reacts to a published event
+type Handler func(ctx context.Context, e *Event) error
+
+// Publisher delivers events to their subscribers
+type Publisher interface {
+	Publish(ctx context.Context, e *Event) error
+}
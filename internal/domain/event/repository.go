@@ -0,0 +1,39 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CursorRepository stores how far each wallet's ledger stream has been processed
+type CursorRepository interface {
+	// GetCursor returns the paging token of the last processed operation, empty if the wallet was never streamed
+	GetCursor(ctx context.Context, walletID uuid.UUID) (string, error)
+	SaveCursor(ctx context.Context, walletID uuid.UUID, cursor string) error
+}
+
+// ErrEventNotFound is returned when a stream resumes after an event that is unknown,
+// belongs to another wallet or is no longer retained
+var ErrEventNotFound = errors.New("event not found")
+
+// Repository stores published events so stream clients can resume where they left off
+type Repository interface {
+	// Save stores an event and reports whether it was new; saving an event twice is not an error
+	Save(ctx context.Context, e *Event) (bool, error)
+	// ListAfter returns the wallet's events stored after the event with the given ID, oldest first.
+	// It returns ErrEventNotFound when that event is not a retained event of the wallet.
+	ListAfter(ctx context.Context, walletID, afterID uuid.UUID, limit int) ([]*Event, error)
+	// DeleteBefore removes events created before the given time and returns how many were removed
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Feed fans live events out to in-process listeners of a single wallet
+type Feed interface {
+	Broadcast(e *Event)
+	// Listen returns the wallet's live events and a function that stops listening.
+	// The channel is closed if the listener falls too far behind.
+	Listen(walletID uuid.UUID) (<-chan *Event, func())
+}
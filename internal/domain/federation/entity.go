@@ -0,0 +1,72 @@
+package federation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// namePattern restricts federation names assigned to managed wallets
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,63}$`)
+
+// Record is the result of resolving a SEP-2 federation address
+type Record struct {
+	StellarAddress string // name*domain
+	AccountID      string // G... address
+	MemoType       string // Optional: text, id or hash
+	Memo           string
+}
+
+// Resolver resolves federation addresses (name*domain) to account IDs
+type Resolver interface {
+	Resolve(ctx context.Context, address string) (*Record, error)
+}
+
+// Name maps a federation name on our own domain to a managed wallet
+type Name struct {
+	Name      string
+	WalletID  uuid.UUID
+	CreatedAt time.Time
+}
+
+func NewName(name string, walletID uuid.UUID) (*Name, error) {
+	name = strings.ToLower(name)
+	if !namePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid federation name: must be 1-64 characters of a-z, 0-9, '.', '_' or '-'")
+	}
+
+	if walletID == uuid.Nil {
+		return nil, fmt.Errorf("wallet id is required")
+	}
+
+	return &Name{
+		Name:      name,
+		WalletID:  walletID,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// IsAddress reports whether s looks like a federation address (name*domain)
+func IsAddress(s string) bool {
+	_, _, err := SplitAddress(s)
+	return err == nil
+}
+
+// SplitAddress splits a federation address into its name and domain parts
+func SplitAddress(address string) (name, domain string, err error) {
+	idx := strings.LastIndex(address, "*")
+	if idx <= 0 || idx == len(address)-1 {
+		return "", "", fmt.Errorf("invalid federation address: expected name*domain")
+	}
+
+	name, domain = address[:idx], address[idx+1:]
+	if strings.ContainsAny(domain, "*/ ") || (!strings.Contains(domain, ".") && domain != "localhost") {
+		return "", "", fmt.Errorf("invalid federation address domain: %s", domain)
+	}
+
+	return name, strings.ToLower(domain), nil
+}
@@ -0,0 +1,72 @@
+package federation
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSplitAddress(t *testing.T) {
+	tests := []struct {
+		address    string
+		wantName   string
+		wantDomain string
+		wantErr    bool
+	}{
+		{"alice*example.com", "alice", "example.com", false},
+		{"alice@mail.com*Example.COM", "alice@mail.com", "example.com", false},
+		{"a*b*example.com", "a*b", "example.com", false},
+		{"bob*localhost", "bob", "localhost", false},
+		{"alice", "", "", true},
+		{"*example.com", "", "", true},
+		{"alice*", "", "", true},
+		{"alice*intranet", "", "", true},
+		{"alice*example.com/path", "", "", true},
+		{"alice*exa mple.com", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			name, domain, err := SplitAddress(tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SplitAddress(%q) error = %v, wantErr %v", tt.address, err, tt.wantErr)
+			}
+			if name != tt.wantName || domain != tt.wantDomain {
+				t.Errorf("SplitAddress(%q) = %q, %q, want %q, %q", tt.address, name, domain, tt.wantName, tt.wantDomain)
+			}
+			if IsAddress(tt.address) == tt.wantErr {
+				t.Errorf("IsAddress(%q) = %v, want %v", tt.address, !tt.wantErr, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewName(t *testing.T) {
+	walletID := uuid.New()
+
+	tests := []struct {
+		name     string
+		walletID uuid.UUID
+		want     string
+		wantErr  bool
+	}{
+		{"Alice", walletID, "alice", false},
+		{"treasury.ops-1_a", walletID, "treasury.ops-1_a", false},
+		{"", walletID, "", true},
+		{".alice", walletID, "", true},
+		{"alice*example.com", walletID, "", true},
+		{"alice", uuid.Nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := NewName(tt.name, tt.walletID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewName(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if err == nil && n.Name != tt.want {
+				t.Errorf("NewName(%q).Name = %q, want %q", tt.name, n.Name, tt.want)
+			}
+		})
+	}
+}
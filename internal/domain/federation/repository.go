@@ -0,0 +1,13 @@
+package federation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, name *Name) error
+	FindByName(ctx context.Context, name string) (*Name, error)
+	FindByWalletID(ctx context.Context, walletID uuid.UUID) (*Name, error)
+}
@@ -0,0 +1,101 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Transaction is an indexed ledger transaction with at least one operation involving a managed wallet
+type Transaction struct {
+	ID             string // Horizon paging token
+	Hash           string
+	Ledger         int32
+	SourceAccount  string
+	Successful     bool
+	MaxFee         int64
+	FeeCharged     int64
+	OperationCount int32
+	MemoType       string
+	Memo           string
+	ClosedAt       time.Time
+}
+
+// Operation is an indexed operation involving a managed wallet, either as its source
+// or as a participant
+type Operation struct {
+	ID              string // Horizon operation ID
+	Position        int64  // Numeric operation ID, which orders operations across the ledger
+	WalletID        uuid.UUID
+	TransactionHash string
+	Type            string
+	SourceAccount   string
+	Successful      bool
+	Counterparties  []string // Accounts other than the wallet that appear in the operation or its effects
+	Assets          []string // Assets that appear in the operation or its effects, "native" or "CODE:ISSUER"
+	Details         []byte   // Horizon's operation record as JSON
+	Effects         []Effect // Effects of the operation on the wallet's account
+	ClosedAt        time.Time
+}
+
+// Effect is a change an operation made to the wallet's account
+type Effect struct {
+	ID      string // Horizon effect ID
+	Type    string
+	Details []byte // Horizon's effect record as JSON
+}
+
+// IndexState tracks how far a wallet's operations have been indexed
+type IndexState struct {
+	WalletID     uuid.UUID
+	Cursor       string     // Paging token of the last indexed operation
+	BackfilledAt *time.Time // Set once the wallet's history before the stream was indexed
+	UpdatedAt    time.Time
+}
+
+// Batch is a set of operations indexed together with the cursor they advance to
+type Batch struct {
+	WalletID     uuid.UUID
+	Transactions []*Transaction
+	Operations   []*Operation
+	Cursor       string
+}
+
+// Order of listed operations
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Filter selects a page of a wallet's indexed operations
+type Filter struct {
+	WalletID     uuid.UUID
+	From         *time.Time // Closed at or after
+	To           *time.Time // Closed before
+	Asset        string     // "native" or "CODE:ISSUER"
+	Counterparty string
+	Types        []string
+	After        int64 // Position to continue after, 0 to start at the first or last operation
+	Order        string
+	Limit        int
+}
+
+// BalanceSnapshot is a wallet's balances as Horizon reported them at one time. Past
+// balances are reconstructed from the latest snapshot before them and the indexed
+// operations that followed.
+type BalanceSnapshot struct {
+	WalletID uuid.UUID
+	Balances map[string]decimal.Decimal // By asset: "native", "CODE:ISSUER" or "liquidity_pool:<pool id>"
+	TakenAt  time.Time                  // Close time of Ledger
+	Ledger   uint32                     // Ledger the balances are as of, 0 for snapshots stamped with the read time
+}
+
+// ReplayAfter returns the position of the last operation the snapshot's balances include,
+// or 0 when it has no ledger. Operation IDs start with their ledger in the upper 32 bits.
+func (s *BalanceSnapshot) ReplayAfter() int64 {
+	if s.Ledger == 0 {
+		return 0
+	}
+	return (int64(s.Ledger)+1)<<32 - 1
+}
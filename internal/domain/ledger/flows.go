@@ -0,0 +1,133 @@
+package ledger
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Flow is the amount of one asset an operation moved into and out of an account
+type Flow struct {
+	Asset string // "native", "CODE:ISSUER" or "liquidity_pool:<pool id>" for pool shares
+	In    decimal.Decimal
+	Out   decimal.Decimal
+}
+
+// Net is the change the operation made to the account's balance of the asset
+func (f Flow) Net() decimal.Decimal {
+	return f.In.Sub(f.Out)
+}
+
+// effectAmounts holds the effect fields that carry amounts
+type effectAmounts struct {
+	AssetRef
+	Amount          string `json:"amount"`
+	StartingBalance string `json:"starting_balance"`
+
+	BoughtAmount      string `json:"bought_amount"`
+	BoughtAssetType   string `json:"bought_asset_type"`
+	BoughtAssetCode   string `json:"bought_asset_code"`
+	BoughtAssetIssuer string `json:"bought_asset_issuer"`
+	SoldAmount        string `json:"sold_amount"`
+	SoldAssetType     string `json:"sold_asset_type"`
+	SoldAssetCode     string `json:"sold_asset_code"`
+	SoldAssetIssuer   string `json:"sold_asset_issuer"`
+
+	LiquidityPool struct {
+		ID string `json:"id"`
+	} `json:"liquidity_pool"`
+	ReservesDeposited []AssetAmount `json:"reserves_deposited"`
+	ReservesReceived  []AssetAmount `json:"reserves_received"`
+	SharesReceived    string        `json:"shares_received"`
+	SharesRedeemed    string        `json:"shares_redeemed"`
+}
+
+// Flows sums an operation's effects on an account into amounts in and out per asset,
+// sorted by asset. Balance changes are read from credit and debit effects; trade
+// effects only count when there are none, as for offers, since path payments report
+// the trades they cross next to the debit and credit they make.
+func Flows(effects []Effect) []Flow {
+	hasTransfers := false
+	for _, e := range effects {
+		if e.Type == "account_credited" || e.Type == "account_debited" {
+			hasTransfers = true
+			break
+		}
+	}
+
+	flows := make(map[string]*Flow)
+	add := func(asset, amount string, in bool) {
+		value, err := decimal.NewFromString(amount)
+		if asset == "" || err != nil || value.IsZero() {
+			return
+		}
+		f, ok := flows[asset]
+		if !ok {
+			f = &Flow{Asset: asset}
+			flows[asset] = f
+		}
+		if in {
+			f.In = f.In.Add(value)
+		} else {
+			f.Out = f.Out.Add(value)
+		}
+	}
+
+	for _, e := range effects {
+		var a effectAmounts
+		if err := json.Unmarshal(e.Details, &a); err != nil {
+			continue
+		}
+
+		switch e.Type {
+		case "account_created":
+			add("native", a.StartingBalance, true)
+		case "account_credited":
+			add(canonicalAsset(a.AssetType, a.AssetCode, a.AssetIssuer), a.Amount, true)
+		case "account_debited":
+			add(canonicalAsset(a.AssetType, a.AssetCode, a.AssetIssuer), a.Amount, false)
+		case "trade":
+			if hasTransfers {
+				continue
+			}
+			add(canonicalAsset(a.BoughtAssetType, a.BoughtAssetCode, a.BoughtAssetIssuer), a.BoughtAmount, true)
+			add(canonicalAsset(a.SoldAssetType, a.SoldAssetCode, a.SoldAssetIssuer), a.SoldAmount, false)
+		case "liquidity_pool_deposited":
+			for _, r := range a.ReservesDeposited {
+				add(r.Asset, r.Amount, false)
+			}
+			add(poolShareAsset(a.LiquidityPool.ID), a.SharesReceived, true)
+		case "liquidity_pool_withdrew":
+			for _, r := range a.ReservesReceived {
+				add(r.Asset, r.Amount, true)
+			}
+			add(poolShareAsset(a.LiquidityPool.ID), a.SharesRedeemed, false)
+		}
+	}
+
+	result := make([]Flow, 0, len(flows))
+	for _, f := range flows {
+		result = append(result, *f)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Asset < result[j].Asset })
+
+	return result
+}
+
+func canonicalAsset(assetType, code, issuer string) string {
+	if assetType == "native" {
+		return "native"
+	}
+	if code == "" || issuer == "" {
+		return ""
+	}
+	return code + ":" + issuer
+}
+
+func poolShareAsset(poolID string) string {
+	if poolID == "" {
+		return ""
+	}
+	return "liquidity_pool:" + poolID
+}
@@ -0,0 +1,299 @@
+package ledger
+
+import (
+	"encoding/json"
+)
+
+// Operation types, as Horizon names them
+const (
+	TypeCreateAccount                 = "create_account"
+	TypePayment                       = "payment"
+	TypePathPaymentStrictReceive      = "path_payment_strict_receive"
+	TypeManageSellOffer               = "manage_sell_offer"
+	TypeCreatePassiveSellOffer        = "create_passive_sell_offer"
+	TypeSetOptions                    = "set_options"
+	TypeChangeTrust                   = "change_trust"
+	TypeAllowTrust                    = "allow_trust"
+	TypeAccountMerge                  = "account_merge"
+	TypeInflation                     = "inflation"
+	TypeManageData                    = "manage_data"
+	TypeBumpSequence                  = "bump_sequence"
+	TypeManageBuyOffer                = "manage_buy_offer"
+	TypePathPaymentStrictSend         = "path_payment_strict_send"
+	TypeCreateClaimableBalance        = "create_claimable_balance"
+	TypeClaimClaimableBalance         = "claim_claimable_balance"
+	TypeBeginSponsoringFutureReserves = "begin_sponsoring_future_reserves"
+	TypeEndSponsoringFutureReserves   = "end_sponsoring_future_reserves"
+	TypeRevokeSponsorship             = "revoke_sponsorship"
+	TypeClawback                      = "clawback"
+	TypeClawbackClaimableBalance      = "clawback_claimable_balance"
+	TypeSetTrustLineFlags             = "set_trust_line_flags"
+	TypeLiquidityPoolDeposit          = "liquidity_pool_deposit"
+	TypeLiquidityPoolWithdraw         = "liquidity_pool_withdraw"
+	TypeInvokeHostFunction            = "invoke_host_function"
+	TypeExtendFootprintTTL            = "extend_footprint_ttl"
+	TypeRestoreFootprint              = "restore_footprint"
+)
+
+// The detail types below mirror Horizon's operation records; their JSON names are
+// Horizon's, so they decode stored records and encode API responses alike.
+
+// AssetRef identifies an asset by its Horizon type, code and issuer
+type AssetRef struct {
+	AssetType   string `json:"asset_type"`
+	AssetCode   string `json:"asset_code,omitempty"`
+	AssetIssuer string `json:"asset_issuer,omitempty"`
+}
+
+// AssetAmount is an amount of an asset given in canonical form, "native" or "CODE:ISSUER"
+type AssetAmount struct {
+	Asset  string `json:"asset"`
+	Amount string `json:"amount"`
+}
+
+// Price is a rational offer or pool price
+type Price struct {
+	N int64 `json:"n"`
+	D int64 `json:"d"`
+}
+
+type CreateAccountDetails struct {
+	Funder          string `json:"funder"`
+	Account         string `json:"account"`
+	StartingBalance string `json:"starting_balance"`
+}
+
+type PaymentDetails struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+	AssetRef
+}
+
+type PathPaymentDetails struct {
+	From              string     `json:"from"`
+	To                string     `json:"to"`
+	Amount            string     `json:"amount"`
+	SourceAmount      string     `json:"source_amount"`
+	SourceMax         string     `json:"source_max,omitempty"`      // Strict receive
+	DestinationMin    string     `json:"destination_min,omitempty"` // Strict send
+	SourceAssetType   string     `json:"source_asset_type"`
+	SourceAssetCode   string     `json:"source_asset_code,omitempty"`
+	SourceAssetIssuer string     `json:"source_asset_issuer,omitempty"`
+	Path              []AssetRef `json:"path"`
+	AssetRef
+}
+
+type OfferDetails struct {
+	OfferID            string `json:"offer_id"`
+	Amount             string `json:"amount"`
+	Price              string `json:"price"`
+	PriceR             Price  `json:"price_r"`
+	BuyingAssetType    string `json:"buying_asset_type"`
+	BuyingAssetCode    string `json:"buying_asset_code,omitempty"`
+	BuyingAssetIssuer  string `json:"buying_asset_issuer,omitempty"`
+	SellingAssetType   string `json:"selling_asset_type"`
+	SellingAssetCode   string `json:"selling_asset_code,omitempty"`
+	SellingAssetIssuer string `json:"selling_asset_issuer,omitempty"`
+}
+
+type SetOptionsDetails struct {
+	HomeDomain      string   `json:"home_domain,omitempty"`
+	InflationDest   string   `json:"inflation_dest,omitempty"`
+	MasterKeyWeight *int     `json:"master_key_weight,omitempty"`
+	SignerKey       string   `json:"signer_key,omitempty"`
+	SignerWeight    *int     `json:"signer_weight,omitempty"`
+	LowThreshold    *int     `json:"low_threshold,omitempty"`
+	MedThreshold    *int     `json:"med_threshold,omitempty"`
+	HighThreshold   *int     `json:"high_threshold,omitempty"`
+	SetFlags        []string `json:"set_flags_s,omitempty"`
+	ClearFlags      []string `json:"clear_flags_s,omitempty"`
+}
+
+type ChangeTrustDetails struct {
+	Limit           string `json:"limit"`
+	Trustor         string `json:"trustor"`
+	Trustee         string `json:"trustee,omitempty"`
+	LiquidityPoolID string `json:"liquidity_pool_id,omitempty"`
+	AssetRef
+}
+
+type AllowTrustDetails struct {
+	Trustor                        string `json:"trustor"`
+	Trustee                        string `json:"trustee"`
+	Authorize                      bool   `json:"authorize"`
+	AuthorizeToMaintainLiabilities bool   `json:"authorize_to_maintain_liabilities"`
+	AssetRef
+}
+
+type AccountMergeDetails struct {
+	Account string `json:"account"`
+	Into    string `json:"into"`
+}
+
+type ManageDataDetails struct {
+	Name  string `json:"name"`
+	Value string `json:"value"` // Base64, empty when the entry was removed
+}
+
+type BumpSequenceDetails struct {
+	BumpTo string `json:"bump_to"`
+}
+
+type Claimant struct {
+	Destination string          `json:"destination"`
+	Predicate   json.RawMessage `json:"predicate"`
+}
+
+type CreateClaimableBalanceDetails struct {
+	Asset     string     `json:"asset"`
+	Amount    string     `json:"amount"`
+	Claimants []Claimant `json:"claimants"`
+}
+
+type ClaimClaimableBalanceDetails struct {
+	BalanceID string `json:"balance_id"`
+	Claimant  string `json:"claimant"`
+}
+
+type BeginSponsoringFutureReservesDetails struct {
+	SponsoredID string `json:"sponsored_id"`
+}
+
+type EndSponsoringFutureReservesDetails struct {
+	BeginSponsor string `json:"begin_sponsor"`
+}
+
+type RevokeSponsorshipDetails struct {
+	AccountID                string `json:"account_id,omitempty"`
+	ClaimableBalanceID       string `json:"claimable_balance_id,omitempty"`
+	DataAccountID            string `json:"data_account_id,omitempty"`
+	DataName                 string `json:"data_name,omitempty"`
+	OfferID                  string `json:"offer_id,omitempty"`
+	TrustlineAccountID       string `json:"trustline_account_id,omitempty"`
+	TrustlineAsset           string `json:"trustline_asset,omitempty"`
+	TrustlineLiquidityPoolID string `json:"trustline_liquidity_pool_id,omitempty"`
+	SignerAccountID          string `json:"signer_account_id,omitempty"`
+	SignerKey                string `json:"signer_key,omitempty"`
+}
+
+type ClawbackDetails struct {
+	From   string `json:"from"`
+	Amount string `json:"amount"`
+	AssetRef
+}
+
+type ClawbackClaimableBalanceDetails struct {
+	BalanceID string `json:"balance_id"`
+}
+
+type SetTrustLineFlagsDetails struct {
+	Trustor    string   `json:"trustor"`
+	SetFlags   []string `json:"set_flags_s,omitempty"`
+	ClearFlags []string `json:"clear_flags_s,omitempty"`
+	AssetRef
+}
+
+type LiquidityPoolDepositDetails struct {
+	LiquidityPoolID   string        `json:"liquidity_pool_id"`
+	ReservesMax       []AssetAmount `json:"reserves_max"`
+	MinPrice          string        `json:"min_price"`
+	MaxPrice          string        `json:"max_price"`
+	ReservesDeposited []AssetAmount `json:"reserves_deposited"`
+	SharesReceived    string        `json:"shares_received"`
+}
+
+type LiquidityPoolWithdrawDetails struct {
+	LiquidityPoolID  string        `json:"liquidity_pool_id"`
+	ReservesMin      []AssetAmount `json:"reserves_min"`
+	Shares           string        `json:"shares"`
+	ReservesReceived []AssetAmount `json:"reserves_received"`
+}
+
+// AssetBalanceChange is a Stellar Asset Contract transfer made by a contract invocation
+type AssetBalanceChange struct {
+	Type   string `json:"type"` // transfer, mint, clawback or burn
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+	Amount string `json:"amount"`
+	AssetRef
+}
+
+type InvokeHostFunctionDetails struct {
+	Function            string               `json:"function"`
+	Address             string               `json:"address,omitempty"`
+	Salt                string               `json:"salt,omitempty"`
+	AssetBalanceChanges []AssetBalanceChange `json:"asset_balance_changes,omitempty"`
+}
+
+type ExtendFootprintTTLDetails struct {
+	ExtendTo uint32 `json:"extend_to"`
+}
+
+// EmptyDetails is used by operations without parameters: inflation and restore_footprint
+type EmptyDetails struct{}
+
+// DecodeDetails decodes a Horizon operation record into the detail type of its operation
+// type. Unknown types, and records that do not match their type, are returned as a
+// generic JSON object so no operation is dropped.
+func DecodeDetails(operationType string, record []byte) interface{} {
+	var details interface{}
+	switch operationType {
+	case TypeCreateAccount:
+		details = &CreateAccountDetails{}
+	case TypePayment:
+		details = &PaymentDetails{}
+	case TypePathPaymentStrictReceive, TypePathPaymentStrictSend:
+		details = &PathPaymentDetails{}
+	case TypeManageSellOffer, TypeManageBuyOffer, TypeCreatePassiveSellOffer:
+		details = &OfferDetails{}
+	case TypeSetOptions:
+		details = &SetOptionsDetails{}
+	case TypeChangeTrust:
+		details = &ChangeTrustDetails{}
+	case TypeAllowTrust:
+		details = &AllowTrustDetails{}
+	case TypeAccountMerge:
+		details = &AccountMergeDetails{}
+	case TypeInflation, TypeRestoreFootprint:
+		return &EmptyDetails{}
+	case TypeManageData:
+		details = &ManageDataDetails{}
+	case TypeBumpSequence:
+		details = &BumpSequenceDetails{}
+	case TypeCreateClaimableBalance:
+		details = &CreateClaimableBalanceDetails{}
+	case TypeClaimClaimableBalance:
+		details = &ClaimClaimableBalanceDetails{}
+	case TypeBeginSponsoringFutureReserves:
+		details = &BeginSponsoringFutureReservesDetails{}
+	case TypeEndSponsoringFutureReserves:
+		details = &EndSponsoringFutureReservesDetails{}
+	case TypeRevokeSponsorship:
+		details = &RevokeSponsorshipDetails{}
+	case TypeClawback:
+		details = &ClawbackDetails{}
+	case TypeClawbackClaimableBalance:
+		details = &ClawbackClaimableBalanceDetails{}
+	case TypeSetTrustLineFlags:
+		details = &SetTrustLineFlagsDetails{}
+	case TypeLiquidityPoolDeposit:
+		details = &LiquidityPoolDepositDetails{}
+	case TypeLiquidityPoolWithdraw:
+		details = &LiquidityPoolWithdrawDetails{}
+	case TypeInvokeHostFunction:
+		details = &InvokeHostFunctionDetails{}
+	case TypeExtendFootprintTTL:
+		details = &ExtendFootprintTTLDetails{}
+	}
+
+	if details != nil && json.Unmarshal(record, details) == nil {
+		return details
+	}
+
+	var generic map[string]interface{}
+	if err := json.Unmarshal(record, &generic); err != nil {
+		return map[string]interface{}{}
+	}
+	return generic
+}
@@ -0,0 +1,28 @@
+package ledger
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository stores the local index of managed wallets' ledger history
+type Repository interface {
+	// Save stores a batch and advances the wallet's cursor atomically; operations indexed before are skipped
+	Save(ctx context.Context, batch *Batch) error
+	// GetState returns the wallet's index state, nil if it was never indexed
+	GetState(ctx context.Context, walletID uuid.UUID) (*IndexState, error)
+	MarkBackfilled(ctx context.Context, walletID uuid.UUID) error
+	// ListOperations returns the operations matching the filter, with their effects
+	ListOperations(ctx context.Context, filter Filter) ([]*Operation, error)
+	// FindTransactions returns the transactions with the given hashes, in no particular order
+	FindTransactions(ctx context.Context, hashes []string) ([]*Transaction, error)
+}
+
+// SnapshotRepository stores wallets' balance snapshots
+type SnapshotRepository interface {
+	Save(ctx context.Context, snapshot *BalanceSnapshot) error
+	// FindLatest returns the wallet's last snapshot taken at or before at, nil if there is none
+	FindLatest(ctx context.Context, walletID uuid.UUID, at time.Time) (*BalanceSnapshot, error)
+}
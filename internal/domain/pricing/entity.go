@@ -0,0 +1,46 @@
+package pricing
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Reference currencies a wallet can be valued in
+const (
+	CurrencyUSD = "USD"
+	CurrencyXLM = "XLM"
+)
+
+// NativeAsset identifies XLM; credit assets are identified as "CODE:ISSUER"
+const NativeAsset = "native"
+
+// Where a quote came from
+const (
+	SourceOrderbook = "orderbook"      // Mid price of the best bid and ask
+	SourceTrades    = "trades"         // Price of the most recent trade
+	SourcePool      = "liquidity_pool" // Ratio of the pool's reserves
+	SourceExternal  = "external"       // External price feed
+)
+
+// Quote is the price of one unit of an asset in a reference currency
+type Quote struct {
+	Asset      string // "native" or "CODE:ISSUER"
+	Currency   string // USD or XLM
+	Price      decimal.Decimal
+	Source     string
+	ObservedAt time.Time // When the price was observed at its source
+}
+
+// Source prices assets. Quote returns a nil quote without error when the source has
+// no price for the pair, so callers can fall through to the next source.
+type Source interface {
+	Name() string
+	Quote(ctx context.Context, asset, currency string) (*Quote, error)
+}
+
+// IsSupportedCurrency reports whether wallets can be valued in currency
+func IsSupportedCurrency(currency string) bool {
+	return currency == CurrencyUSD || currency == CurrencyXLM
+}
@@ -0,0 +1,106 @@
+package reconciliation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of discrepancy, from the point of view of our records
+const (
+	KindMissing    = "missing"    // On the ledger, not in our records
+	KindUnexpected = "unexpected" // In our records, not on the ledger
+	KindMismatched = "mismatched" // In both, with different values
+)
+
+// Records that are compared
+const (
+	RecordOperation   = "operation"   // Indexed operation, by operation ID
+	RecordTransaction = "transaction" // Indexed transaction, by hash
+	RecordSubmission  = "submission"  // Transaction we submitted, by submission ID
+	RecordBalance     = "balance"     // Balance derived from the index, by asset
+)
+
+// Status is the outcome of a reconciliation run
+type Status string
+
+const (
+	StatusClean         Status = "clean"         // Everything compared matched
+	StatusDiscrepancies Status = "discrepancies" // At least one discrepancy was found
+	StatusFailed        Status = "failed"        // The run could not complete
+)
+
+// Discrepancy is one difference between our records and the ledger
+type Discrepancy struct {
+	Kind      string
+	Record    string
+	Reference string // Operation ID, transaction hash, submission ID or asset
+	Field     string // Field that differs, set for mismatches
+	Expected  string // Value on the ledger
+	Actual    string // Value in our records
+}
+
+// Report is the result of reconciling one wallet's records against Horizon
+type Report struct {
+	ID                 uuid.UUID
+	WalletID           uuid.UUID
+	Status             Status
+	WindowStart        time.Time // Operations and submissions from here on were compared
+	Cursor             string    // Last indexed operation; later operations were not compared
+	OperationsChecked  int
+	SubmissionsChecked int
+	BalancesChecked    bool // False while the index is behind the ledger or still backfilling
+	DiscrepancyCount   int
+	Discrepancies      []Discrepancy // Not loaded when reports are listed
+	Error              string        // Why the run failed
+	StartedAt          time.Time
+	FinishedAt         time.Time
+}
+
+// NewReport starts a report for a wallet
+func NewReport(walletID uuid.UUID, windowStart time.Time) *Report {
+	return &Report{
+		ID:          uuid.New(),
+		WalletID:    walletID,
+		WindowStart: windowStart,
+		StartedAt:   time.Now(),
+	}
+}
+
+// Add records a discrepancy
+func (r *Report) Add(kind, record, reference, field, expected, actual string) {
+	r.Discrepancies = append(r.Discrepancies, Discrepancy{
+		Kind:      kind,
+		Record:    record,
+		Reference: reference,
+		Field:     field,
+		Expected:  expected,
+		Actual:    actual,
+	})
+}
+
+// Finish sets the report's status from its discrepancies, or marks it failed when err is set
+func (r *Report) Finish(err error) {
+	r.FinishedAt = time.Now()
+	r.DiscrepancyCount = len(r.Discrepancies)
+	switch {
+	case err != nil:
+		r.Status = StatusFailed
+		r.Error = err.Error()
+	case len(r.Discrepancies) > 0:
+		r.Status = StatusDiscrepancies
+	default:
+		r.Status = StatusClean
+	}
+}
+
+// Count returns the number of discrepancies of a kind
+func (r *Report) Count(kind string) int {
+	n := 0
+	for _, d := range r.Discrepancies {
+		if d.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,22 @@
+package reconciliation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Filter selects reconciliation reports; zero values match all
+type Filter struct {
+	WalletID uuid.UUID
+	Status   Status
+}
+
+type Repository interface {
+	// Save stores a finished report with its discrepancies
+	Save(ctx context.Context, report *Report) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Report, error)
+	// List returns the matching reports, newest first, without their discrepancies
+	List(ctx context.Context, filter Filter, limit, offset int) ([]*Report, error)
+	Count(ctx context.Context, filter Filter) (int64, error)
+}
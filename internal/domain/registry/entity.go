@@ -0,0 +1,60 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var codePattern = regexp.MustCompile(`^[a-zA-Z0-9]{1,12}$`)
+
+// AssetMetadata is what an issuer publishes about an asset in its stellar.toml (SEP-1)
+type AssetMetadata struct {
+	Code            string
+	Issuer          string
+	HomeDomain      string // Issuer account's home_domain, empty if not set
+	Listed          bool   // The asset appears in the home domain's [[CURRENCIES]]
+	Name            string
+	Description     string
+	Image           string
+	DisplayDecimals int // Decimals to display, 7 when not published
+	Status          string
+	IsAssetAnchored bool
+	AnchorAssetType string // fiat, crypto, stock, ...
+	AnchorAsset     string // e.g. USD
+	OrgName         string
+	FetchedAt       time.Time
+}
+
+// MetadataResolver resolves SEP-1 metadata for a credit asset
+type MetadataResolver interface {
+	Resolve(ctx context.Context, code, issuer string) (*AssetMetadata, error)
+}
+
+// VerifiedAsset is an asset an administrator has placed on the allow-list
+type VerifiedAsset struct {
+	Code      string
+	Issuer    string
+	Note      string
+	CreatedAt time.Time
+}
+
+// NewVerifiedAsset validates and creates an allow-list entry
+func NewVerifiedAsset(code, issuer, note string) (*VerifiedAsset, error) {
+	if !codePattern.MatchString(code) || strings.EqualFold(code, "XLM") {
+		return nil, fmt.Errorf("asset code must be 1-12 alphanumeric characters and cannot be XLM")
+	}
+
+	if !strings.HasPrefix(issuer, "G") || len(issuer) != 56 {
+		return nil, fmt.Errorf("issuer must be a valid Stellar public key")
+	}
+
+	return &VerifiedAsset{
+		Code:      code,
+		Issuer:    issuer,
+		Note:      note,
+		CreatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,11 @@
+package registry
+
+import "context"
+
+// Repository stores the admin allow-list of verified assets
+type Repository interface {
+	Add(ctx context.Context, asset *VerifiedAsset) error
+	Remove(ctx context.Context, code, issuer string) error
+	IsVerified(ctx context.Context, code, issuer string) (bool, error)
+	List(ctx context.Context) ([]*VerifiedAsset, error)
+}
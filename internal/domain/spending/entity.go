@@ -0,0 +1,172 @@
+package spending
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Scopes a policy applies to
+const (
+	ScopeWallet = "wallet" // Payments from one wallet, by any user
+	ScopeUser   = "user"   // Payments by one user, from any wallet
+)
+
+// Limits a spend can exceed
+const (
+	LimitPerTransaction = "per_transaction"
+	LimitDaily          = "daily"
+	LimitMonthly        = "monthly"
+	LimitCount          = "count"
+)
+
+// Policy limits the payments of a wallet, or of a user across wallets. Zero values
+// leave a limit unset.
+type Policy struct {
+	ID                uuid.UUID
+	Scope             string
+	SubjectID         string          // Wallet ID or user ID
+	Asset             string          // "native" or "CODE:ISSUER"; empty counts payments of any asset and only allows MaxCount
+	MaxPerTransaction decimal.Decimal // Largest single payment
+	DailyLimit        decimal.Decimal // Total per UTC day
+	MonthlyLimit      decimal.Decimal // Total per UTC calendar month
+	MaxCount          int             // Payments per CountWindow
+	CountWindow       time.Duration   // Rolling window of MaxCount
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewPolicy creates a policy after validating its limits
+func NewPolicy(scope, subjectID, asset string, maxPerTransaction, daily, monthly decimal.Decimal, maxCount int, countWindow time.Duration) (*Policy, error) {
+	if scope != ScopeWallet && scope != ScopeUser {
+		return nil, fmt.Errorf("scope must be wallet or user")
+	}
+	if subjectID == "" {
+		return nil, fmt.Errorf("subject id is required")
+	}
+	if maxPerTransaction.IsNegative() || daily.IsNegative() || monthly.IsNegative() || maxCount < 0 {
+		return nil, fmt.Errorf("limits cannot be negative")
+	}
+	if asset == "" && !(maxPerTransaction.IsZero() && daily.IsZero() && monthly.IsZero()) {
+		return nil, fmt.Errorf("amount limits need an asset")
+	}
+	if maxCount > 0 && countWindow <= 0 {
+		return nil, fmt.Errorf("count window is required with a count limit")
+	}
+	if maxPerTransaction.IsZero() && daily.IsZero() && monthly.IsZero() && maxCount == 0 {
+		return nil, fmt.Errorf("at least one limit is required")
+	}
+
+	now := time.Now()
+	return &Policy{
+		ID:                uuid.New(),
+		Scope:             scope,
+		SubjectID:         subjectID,
+		Asset:             asset,
+		MaxPerTransaction: maxPerTransaction,
+		DailyLimit:        daily,
+		MonthlyLimit:      monthly,
+		MaxCount:          maxCount,
+		CountWindow:       countWindow,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}, nil
+}
+
+// Applies reports whether the policy limits the spend
+func (p *Policy) Applies(s *Spend) bool {
+	switch {
+	case p.Asset != "" && p.Asset != s.Asset:
+		return false
+	case p.Scope == ScopeWallet:
+		return p.SubjectID == s.WalletID.String()
+	default:
+		return p.SubjectID == s.UserID
+	}
+}
+
+// Usage is what the spends a policy counts add up to in its current periods
+type Usage struct {
+	Daily   decimal.Decimal
+	Monthly decimal.Decimal
+	Count   int
+}
+
+// Periods returns the start of the policy's current day, month and count window at now
+func (p *Policy) Periods(now time.Time) (day, month, window time.Time) {
+	now = now.UTC()
+	day = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	month = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	window = now.Add(-p.CountWindow)
+	return day, month, window
+}
+
+// Check returns the first limit the spend would exceed on top of usage, nil if it fits
+func (p *Policy) Check(s *Spend, usage Usage) *Violation {
+	violation := func(limit string, max, remaining decimal.Decimal) *Violation {
+		return &Violation{Policy: p, Limit: limit, Max: max, Remaining: decimal.Max(remaining, decimal.Zero)}
+	}
+
+	switch {
+	case !p.MaxPerTransaction.IsZero() && s.Amount.GreaterThan(p.MaxPerTransaction):
+		return violation(LimitPerTransaction, p.MaxPerTransaction, p.MaxPerTransaction)
+	case !p.DailyLimit.IsZero() && usage.Daily.Add(s.Amount).GreaterThan(p.DailyLimit):
+		return violation(LimitDaily, p.DailyLimit, p.DailyLimit.Sub(usage.Daily))
+	case !p.MonthlyLimit.IsZero() && usage.Monthly.Add(s.Amount).GreaterThan(p.MonthlyLimit):
+		return violation(LimitMonthly, p.MonthlyLimit, p.MonthlyLimit.Sub(usage.Monthly))
+	case p.MaxCount > 0 && usage.Count+1 > p.MaxCount:
+		max := decimal.NewFromInt(int64(p.MaxCount))
+		return violation(LimitCount, max, max.Sub(decimal.NewFromInt(int64(usage.Count))))
+	}
+
+	return nil
+}
+
+// Violation is a limit a spend would exceed
+type Violation struct {
+	Policy    *Policy
+	Limit     string
+	Max       decimal.Decimal
+	Remaining decimal.Decimal
+}
+
+func (v *Violation) Error() string {
+	asset := v.Policy.Asset
+	if asset == "" {
+		asset = "all assets"
+	}
+
+	switch v.Limit {
+	case LimitPerTransaction:
+		return fmt.Sprintf("%s limit of %s per transaction for %s", v.Policy.Scope, v.Max, asset)
+	case LimitCount:
+		return fmt.Sprintf("%s limit of %s payments per %s for %s, %s remaining", v.Policy.Scope, v.Max, v.Policy.CountWindow, asset, v.Remaining)
+	default:
+		return fmt.Sprintf("%s %s limit of %s for %s, %s remaining", v.Policy.Scope, v.Limit, v.Max, asset, v.Remaining)
+	}
+}
+
+// Spend is a payment reserved against the limits before it is signed
+type Spend struct {
+	ID              uuid.UUID
+	WalletID        uuid.UUID
+	UserID          string
+	Asset           string
+	Amount          decimal.Decimal
+	TransactionHash string // Set once signed; spends whose submission failed or expired stop counting
+	CreatedAt       time.Time
+}
+
+// NewSpend creates a spend of amount of asset from a wallet by a user
+func NewSpend(walletID uuid.UUID, userID, asset string, amount decimal.Decimal) *Spend {
+	return &Spend{
+		ID:        uuid.New(),
+		WalletID:  walletID,
+		UserID:    userID,
+		Asset:     asset,
+		Amount:    amount,
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,183 @@
+package spending
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func dec(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func TestPolicyCheck(t *testing.T) {
+	walletID := uuid.New()
+
+	tests := []struct {
+		name          string
+		policy        Policy
+		amount        string
+		usage         Usage
+		wantLimit     string // Empty when the spend fits
+		wantRemaining string
+	}{
+		{
+			name:   "no usage within every limit",
+			policy: Policy{MaxPerTransaction: dec("100"), DailyLimit: dec("500"), MonthlyLimit: dec("2000"), MaxCount: 5},
+			amount: "100",
+		},
+		{
+			name:          "over the per transaction limit",
+			policy:        Policy{MaxPerTransaction: dec("100")},
+			amount:        "100.0000001",
+			wantLimit:     LimitPerTransaction,
+			wantRemaining: "100",
+		},
+		{
+			name:   "exactly reaches the daily limit",
+			policy: Policy{DailyLimit: dec("500")},
+			amount: "100",
+			usage:  Usage{Daily: dec("400")},
+		},
+		{
+			name:          "over the daily limit",
+			policy:        Policy{DailyLimit: dec("500")},
+			amount:        "100",
+			usage:         Usage{Daily: dec("450")},
+			wantLimit:     LimitDaily,
+			wantRemaining: "50",
+		},
+		{
+			name:          "daily usage already over the limit",
+			policy:        Policy{DailyLimit: dec("500")},
+			amount:        "1",
+			usage:         Usage{Daily: dec("600")},
+			wantLimit:     LimitDaily,
+			wantRemaining: "0",
+		},
+		{
+			name:          "over the monthly limit",
+			policy:        Policy{DailyLimit: dec("500"), MonthlyLimit: dec("2000")},
+			amount:        "100",
+			usage:         Usage{Daily: dec("100"), Monthly: dec("1950")},
+			wantLimit:     LimitMonthly,
+			wantRemaining: "50",
+		},
+		{
+			name:          "per transaction checked before daily",
+			policy:        Policy{MaxPerTransaction: dec("100"), DailyLimit: dec("500")},
+			amount:        "200",
+			usage:         Usage{Daily: dec("500")},
+			wantLimit:     LimitPerTransaction,
+			wantRemaining: "100",
+		},
+		{
+			name:   "last payment of the count window",
+			policy: Policy{MaxCount: 3, CountWindow: time.Hour},
+			amount: "1000000",
+			usage:  Usage{Count: 2},
+		},
+		{
+			name:          "over the count limit",
+			policy:        Policy{MaxCount: 3, CountWindow: time.Hour},
+			amount:        "1",
+			usage:         Usage{Count: 3},
+			wantLimit:     LimitCount,
+			wantRemaining: "0",
+		},
+		{
+			name:   "unset limits allow anything",
+			policy: Policy{},
+			amount: "1000000",
+			usage:  Usage{Daily: dec("1000000"), Monthly: dec("1000000"), Count: 1000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := tt.policy
+			policy.Scope = ScopeWallet
+			policy.SubjectID = walletID.String()
+			policy.Asset = "native"
+
+			violation := policy.Check(NewSpend(walletID, "user-1", "native", dec(tt.amount)), tt.usage)
+			if tt.wantLimit == "" {
+				if violation != nil {
+					t.Fatalf("Check() = %s, want no violation", violation.Error())
+				}
+				return
+			}
+
+			if violation == nil {
+				t.Fatalf("Check() = nil, want %s violation", tt.wantLimit)
+			}
+			if violation.Limit != tt.wantLimit {
+				t.Errorf("Check() limit = %s, want %s", violation.Limit, tt.wantLimit)
+			}
+			if !violation.Remaining.Equal(dec(tt.wantRemaining)) {
+				t.Errorf("Check() remaining = %s, want %s", violation.Remaining, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestPolicyPeriods(t *testing.T) {
+	tests := []struct {
+		name       string
+		window     time.Duration
+		now        time.Time
+		wantDay    time.Time
+		wantMonth  time.Time
+		wantWindow time.Time
+	}{
+		{
+			name:       "middle of a month",
+			window:     time.Hour,
+			now:        time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC),
+			wantDay:    time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			wantMonth:  time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantWindow: time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "window reaching into the previous month",
+			window:     48 * time.Hour,
+			now:        time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+			wantDay:    time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantMonth:  time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantWindow: time.Date(2025, 2, 27, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "periods are UTC days and months",
+			window:     time.Hour,
+			now:        time.Date(2025, 4, 1, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)),
+			wantDay:    time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+			wantMonth:  time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantWindow: time.Date(2025, 3, 31, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "no count window",
+			now:        time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC),
+			wantDay:    time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+			wantMonth:  time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			wantWindow: time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Policy{CountWindow: tt.window}
+			day, month, window := p.Periods(tt.now)
+			if !day.Equal(tt.wantDay) {
+				t.Errorf("Periods() day = %s, want %s", day, tt.wantDay)
+			}
+			if !month.Equal(tt.wantMonth) {
+				t.Errorf("Periods() month = %s, want %s", month, tt.wantMonth)
+			}
+			if !window.Equal(tt.wantWindow) {
+				t.Errorf("Periods() window = %s, want %s", window, tt.wantWindow)
+			}
+		})
+	}
+}
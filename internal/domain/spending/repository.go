@@ -0,0 +1,31 @@
+package spending
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// SavePolicy creates the policy, or replaces the limits of the one with the same scope,
+	// subject and asset, whose ID it takes
+	SavePolicy(ctx context.Context, policy *Policy) error
+	DeletePolicy(ctx context.Context, id uuid.UUID) error
+	// ListPolicies returns the policies of a scope, optionally of one subject
+	ListPolicies(ctx context.Context, scope, subjectID string) ([]*Policy, error)
+	// ListApplicable returns the policies that limit payments from the wallet by the user
+	ListApplicable(ctx context.Context, walletID uuid.UUID, userID string) ([]*Policy, error)
+	// Usage returns what the spends the policy counts add up to at now. Spends never
+	// attached to a transaction stop counting after a while.
+	Usage(ctx context.Context, policy *Policy, now time.Time) (Usage, error)
+	// Reserve records the spend if every policy that applies to it allows it, and otherwise
+	// returns the first violation. Policies are read and the spend written in one
+	// transaction serialized per wallet and per user, so concurrent payments cannot
+	// exceed a limit together.
+	Reserve(ctx context.Context, spend *Spend) (*Violation, error)
+	// Attach links a spend to its signed transaction; a payment is only submitted once attached
+	Attach(ctx context.Context, spendID uuid.UUID, transactionHash string) error
+	// Release deletes a spend whose payment was never signed
+	Release(ctx context.Context, spendID uuid.UUID) error
+}
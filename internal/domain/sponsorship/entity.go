@@ -0,0 +1,112 @@
+package sponsorship
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status represents the lifecycle state of a sponsorship
+type Status string
+
+const (
+	StatusActive      Status = "active"      // The sponsor pays the reserve
+	StatusRevoked     Status = "revoked"     // The sponsored account pays its own reserve
+	StatusTransferred Status = "transferred" // Another sponsor took over; see ReplacedByID
+)
+
+// Types of ledger entries whose reserve can be sponsored
+const (
+	TypeAccount   = "account"   // Base reserve of the account itself
+	TypeTrustline = "trustline" // Reserve of one trustline of the account
+)
+
+// Sponsorship records a ledger entry of a managed wallet whose reserve is paid by another managed wallet
+type Sponsorship struct {
+	ID                 uuid.UUID
+	Type               string
+	SponsorWalletID    uuid.UUID
+	SponsorPublicKey   string
+	SponsoredWalletID  uuid.UUID
+	SponsoredPublicKey string
+	AssetCode          string // Trustline sponsorships only
+	AssetIssuer        string
+	Status             Status
+	ReplacedByID       *uuid.UUID // Sponsorship created by a transfer
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	EndedAt            *time.Time // When the sponsorship was revoked or transferred
+}
+
+// NewSponsorship creates an active sponsorship. Asset code and issuer are required for
+// trustline sponsorships and must be empty for account sponsorships.
+func NewSponsorship(
+	sponsorshipType string,
+	sponsorWalletID uuid.UUID,
+	sponsorPublicKey string,
+	sponsoredWalletID uuid.UUID,
+	sponsoredPublicKey string,
+	assetCode string,
+	assetIssuer string,
+) (*Sponsorship, error) {
+	switch sponsorshipType {
+	case TypeAccount:
+		if assetCode != "" || assetIssuer != "" {
+			return nil, fmt.Errorf("account sponsorships do not take an asset")
+		}
+	case TypeTrustline:
+		if assetCode == "" || assetIssuer == "" {
+			return nil, fmt.Errorf("trustline sponsorships require asset code and issuer")
+		}
+	default:
+		return nil, fmt.Errorf("unknown sponsorship type %q", sponsorshipType)
+	}
+
+	if sponsorWalletID == sponsoredWalletID {
+		return nil, fmt.Errorf("a wallet cannot sponsor itself")
+	}
+
+	now := time.Now()
+	return &Sponsorship{
+		ID:                 uuid.New(),
+		Type:               sponsorshipType,
+		SponsorWalletID:    sponsorWalletID,
+		SponsorPublicKey:   sponsorPublicKey,
+		SponsoredWalletID:  sponsoredWalletID,
+		SponsoredPublicKey: sponsoredPublicKey,
+		AssetCode:          assetCode,
+		AssetIssuer:        assetIssuer,
+		Status:             StatusActive,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}, nil
+}
+
+// IsActive reports whether the sponsor still pays the reserve
+func (s *Sponsorship) IsActive() bool {
+	return s.Status == StatusActive
+}
+
+// Revoke marks the sponsorship as ended; the sponsored account now pays the reserve
+func (s *Sponsorship) Revoke() {
+	now := time.Now()
+	s.Status = StatusRevoked
+	s.EndedAt = &now
+	s.UpdatedAt = now
+}
+
+// TransferTo ends the sponsorship in favour of one held by a new sponsor
+func (s *Sponsorship) TransferTo(newSponsorWalletID uuid.UUID, newSponsorPublicKey string) (*Sponsorship, error) {
+	next, err := NewSponsorship(s.Type, newSponsorWalletID, newSponsorPublicKey, s.SponsoredWalletID, s.SponsoredPublicKey, s.AssetCode, s.AssetIssuer)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Status = StatusTransferred
+	s.ReplacedByID = &next.ID
+	s.EndedAt = &next.CreatedAt
+	s.UpdatedAt = next.CreatedAt
+
+	return next, nil
+}
@@ -0,0 +1,26 @@
+package sponsorship
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Wallet roles used to list sponsorships
+const (
+	RoleSponsor   = "sponsor"
+	RoleSponsored = "sponsored"
+)
+
+type Repository interface {
+	Create(ctx context.Context, sponsorship *Sponsorship) error
+	Update(ctx context.Context, sponsorship *Sponsorship) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Sponsorship, error)
+	// FindActive returns the active sponsorship of a ledger entry; asset is empty for accounts
+	FindActive(ctx context.Context, sponsorshipType, sponsoredPublicKey, assetCode, assetIssuer string) (*Sponsorship, error)
+	// ListByWallet returns sponsorships where the wallet has the given role, newest first; an empty status matches all
+	ListByWallet(ctx context.Context, walletID uuid.UUID, role string, status Status, limit, offset int) ([]*Sponsorship, error)
+	CountByWallet(ctx context.Context, walletID uuid.UUID, role string, status Status) (int64, error)
+	// Transfer ends a sponsorship and records its replacement atomically
+	Transfer(ctx context.Context, ended *Sponsorship, replacement *Sponsorship) error
+}
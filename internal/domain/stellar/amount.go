@@ -0,0 +1,28 @@
+package stellar
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// AmountDecimals is the precision of Stellar amounts (1 stroop = 0.0000001)
+const AmountDecimals = 7
+
+// ParseAmount parses a positive amount with at most 7 decimal places
+func ParseAmount(value string) (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	if !amount.IsPositive() {
+		return decimal.Decimal{}, fmt.Errorf("amount must be positive")
+	}
+
+	if amount.Exponent() < -AmountDecimals {
+		return decimal.Decimal{}, fmt.Errorf("amount cannot have more than %d decimal places", AmountDecimals)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,13 @@
+package stellar
+
+import (
+	"regexp"
+	"strings"
+)
+
+var assetCodePattern = regexp.MustCompile(`^[a-zA-Z0-9]{1,12}$`)
+
+// IsValidAssetCode reports whether code can identify a credit asset (1-12 alphanumerics, not XLM)
+func IsValidAssetCode(code string) bool {
+	return assetCodePattern.MatchString(code) && !strings.EqualFold(code, "XLM")
+}
@@ -7,12 +7,16 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// AssetTypePoolShares is the balance type of liquidity pool shares
+const AssetTypePoolShares = "liquidity_pool_shares"
+
 type Balance struct {
-	AssetType   string // "native", "credit_alphanum4", "credit_alphanum12"
-	AssetCode   string // "XLM", "USDC", etc
-	AssetIssuer string // Issuer public key (empty for native)
-	Amount      decimal.Decimal
-	Limit       *decimal.Decimal // For trustlines
+	AssetType       string // "native", "credit_alphanum4", "credit_alphanum12", "liquidity_pool_shares"
+	AssetCode       string // "XLM", "USDC", etc
+	AssetIssuer     string // Issuer public key (empty for native)
+	LiquidityPoolID string // Pool ID (hex) for pool shares
+	Amount          decimal.Decimal
+	Limit           *decimal.Decimal // For trustlines
 }
 
 func (b *Balance) Validate() error {
@@ -28,12 +32,20 @@ func (b *Balance) Validate() error {
 		"native":            true,
 		"credit_alphanum4":  true,
 		"credit_alphanum12": true,
+		AssetTypePoolShares: true,
 	}
 
 	if !validTypes[b.AssetType] {
 		return fmt.Errorf("invalid asset type: %s", b.AssetType)
 	}
 
+	if b.AssetType == AssetTypePoolShares {
+		if b.LiquidityPoolID == "" {
+			return fmt.Errorf("liquidity pool id is required for pool shares")
+		}
+		return nil
+	}
+
 	if b.AssetType != "native" {
 		if b.AssetCode == "" {
 			return fmt.Errorf("asset code is required for non-native assets")
@@ -0,0 +1,80 @@
+package stellar
+
+import (
+	"context"
+	"time"
+)
+
+// FeeDistribution holds the per-percentile fee values reported by Horizon, in stroops
+type FeeDistribution struct {
+	Min  int64
+	Mode int64
+	P10  int64
+	P20  int64
+	P30  int64
+	P40  int64
+	P50  int64
+	P60  int64
+	P70  int64
+	P80  int64
+	P90  int64
+	P95  int64
+	P99  int64
+	Max  int64
+}
+
+// Percentile returns the fee for the given percentile, rounding up to the
+// nearest percentile Horizon reports
+func (d FeeDistribution) Percentile(p int) int64 {
+	switch {
+	case p <= 10:
+		return d.P10
+	case p <= 20:
+		return d.P20
+	case p <= 30:
+		return d.P30
+	case p <= 40:
+		return d.P40
+	case p <= 50:
+		return d.P50
+	case p <= 60:
+		return d.P60
+	case p <= 70:
+		return d.P70
+	case p <= 80:
+		return d.P80
+	case p <= 90:
+		return d.P90
+	case p <= 95:
+		return d.P95
+	case p <= 99:
+		return d.P99
+	default:
+		return d.Max
+	}
+}
+
+// FeeStats is a snapshot of the network fee market taken from Horizon /fee_stats
+type FeeStats struct {
+	LastLedger          uint32
+	LastLedgerBaseFee   int64
+	LedgerCapacityUsage float64
+	FeeCharged          FeeDistribution
+	MaxFee              FeeDistribution
+	FetchedAt           time.Time
+}
+
+// FeeEstimate is the base fee (per operation, in stroops) chosen for a transaction
+type FeeEstimate struct {
+	BaseFee    int64
+	Percentile int
+	MaxFee     int64 // Cap applied to BaseFee
+	Capped     bool  // True when the percentile fee exceeded MaxFee
+	Stats      FeeStats
+}
+
+// FeeEstimator picks a base fee for new transactions based on current network conditions.
+// maxFee caps the returned base fee; zero means the estimator's configured default.
+type FeeEstimator interface {
+	EstimateFee(ctx context.Context, maxFee int64) (*FeeEstimate, error)
+}
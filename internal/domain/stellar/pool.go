@@ -0,0 +1,33 @@
+package stellar
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// LiquidityPool is a constant product AMM pool
+type LiquidityPool struct {
+	ID              string // Pool ID (hex)
+	FeeBP           uint32 // Trading fee in basis points
+	TotalTrustlines uint64
+	TotalShares     decimal.Decimal
+	Reserves        []PoolReserve // Ordered as asset A, asset B
+}
+
+// PoolReserve is the amount of one asset held by a pool
+type PoolReserve struct {
+	Asset  string // "native" or "CODE:ISSUER"
+	Amount decimal.Decimal
+}
+
+// ShareOf returns the reserves redeemable for the given number of pool shares
+func (p *LiquidityPool) ShareOf(shares decimal.Decimal) []PoolReserve {
+	out := make([]PoolReserve, 0, len(p.Reserves))
+	for _, r := range p.Reserves {
+		amount := decimal.Zero
+		if p.TotalShares.IsPositive() {
+			amount = r.Amount.Mul(shares).Div(p.TotalShares).Truncate(AmountDecimals)
+		}
+		out = append(out, PoolReserve{Asset: r.Asset, Amount: amount})
+	}
+	return out
+}
@@ -0,0 +1,129 @@
+package submission
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status represents the lifecycle state of a submitted transaction
+type Status string
+
+const (
+	StatusPending Status = "pending" // Submitted, outcome not yet known
+	StatusSuccess Status = "success" // Included in a ledger and successful
+	StatusFailed  Status = "failed"  // Rejected by the network or failed in a ledger
+	StatusExpired Status = "expired" // Time or ledger bounds passed without inclusion
+)
+
+// Kinds of operations tracked as submissions
+const (
+	KindPayment       = "payment"
+	KindCreateAccount = "create_account"
+	KindChangeTrust   = "change_trust"
+	KindSetOptions    = "set_options"
+	KindSetTrustFlags = "set_trust_line_flags"
+	KindClawback      = "clawback"
+
+	// DEX offers; OfferID is recorded once the transaction succeeds
+	KindManageSellOffer  = "manage_sell_offer"
+	KindManageBuyOffer   = "manage_buy_offer"
+	KindPassiveSellOffer = "create_passive_sell_offer"
+	KindCancelOffer      = "cancel_offer"
+
+	// AMM liquidity pools
+	KindLiquidityPoolDeposit  = "liquidity_pool_deposit"
+	KindLiquidityPoolWithdraw = "liquidity_pool_withdraw"
+
+	// Claimable balances
+	KindCreateClaimableBalance = "create_claimable_balance"
+	KindClaimClaimableBalance  = "claim_claimable_balance"
+
+	// Sponsored reserves
+	KindSponsorAccount      = "sponsor_account"
+	KindSponsorTrustline    = "sponsor_trustline"
+	KindRevokeSponsorship   = "revoke_sponsorship"
+	KindTransferSponsorship = "transfer_sponsorship"
+
+	// Wallet closure; preparation batches precede the transaction holding AccountMerge
+	KindCloseWallet  = "close_wallet"
+	KindAccountMerge = "account_merge"
+)
+
+// Submission tracks a transaction built and signed for a managed wallet
+type Submission struct {
+	ID           uuid.UUID
+	WalletID     uuid.UUID
+	Kind         string // Operation kind, e.g. "payment"
+	Hash         string // Transaction hash (hex)
+	EnvelopeXDR  string // Signed transaction envelope (base64)
+	Status       Status
+	ValidUntil   time.Time // Upper time bound of the transaction
+	MinLedger    uint32    // Optional lower ledger bound (0 = none)
+	MaxLedger    uint32    // Optional upper ledger bound (0 = none)
+	Ledger       int32     // Ledger the transaction was included in
+	ResultCode   string    // Horizon transaction result code on failure
+	ErrorMessage string
+	OfferID      int64 // DEX offer created, updated or deleted by the transaction (0 = none)
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func NewSubmission(walletID uuid.UUID, kind, hash, envelopeXDR string, validUntil time.Time) (*Submission, error) {
+	if walletID == uuid.Nil {
+		return nil, fmt.Errorf("wallet id is required")
+	}
+
+	if kind == "" {
+		return nil, fmt.Errorf("kind is required")
+	}
+
+	if len(hash) != 64 {
+		return nil, fmt.Errorf("invalid transaction hash: must be 64 hex characters")
+	}
+
+	if validUntil.IsZero() {
+		return nil, fmt.Errorf("valid until is required")
+	}
+
+	now := time.Now()
+	return &Submission{
+		ID:          uuid.New(),
+		WalletID:    walletID,
+		Kind:        kind,
+		Hash:        hash,
+		EnvelopeXDR: envelopeXDR,
+		Status:      StatusPending,
+		ValidUntil:  validUntil,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// IsFinal reports whether the submission reached a terminal state
+func (s *Submission) IsFinal() bool {
+	return s.Status != StatusPending
+}
+
+// MarkSucceeded records the ledger the transaction was included in
+func (s *Submission) MarkSucceeded(ledger int32) {
+	s.Status = StatusSuccess
+	s.Ledger = ledger
+	s.UpdatedAt = time.Now()
+}
+
+// MarkFailed records a rejection or failed inclusion
+func (s *Submission) MarkFailed(resultCode, message string) {
+	s.Status = StatusFailed
+	s.ResultCode = resultCode
+	s.ErrorMessage = message
+	s.UpdatedAt = time.Now()
+}
+
+// MarkExpired records that the transaction can no longer be included.
+// Once expired it is safe to rebuild and resubmit the same operations.
+func (s *Submission) MarkExpired() {
+	s.Status = StatusExpired
+	s.UpdatedAt = time.Now()
+}
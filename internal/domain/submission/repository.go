@@ -0,0 +1,26 @@
+package submission
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, submission *Submission) error
+	Update(ctx context.Context, submission *Submission) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Submission, error)
+	// FindByHash returns the submission of a transaction, nil when there is none
+	FindByHash(ctx context.Context, hash string) (*Submission, error)
+	ListByWallet(ctx context.Context, walletID uuid.UUID, limit, offset int) ([]*Submission, error)
+	CountByWallet(ctx context.Context, walletID uuid.UUID) (int64, error)
+	// ListByOffer returns a wallet's submissions that touched a DEX offer, newest first
+	ListByOffer(ctx context.Context, walletID uuid.UUID, offerID int64) ([]*Submission, error)
+	// ListPendingPast returns pending submissions whose upper time bound is before the given
+	// time or whose upper ledger bound is at or below the given ledger (0 checks time only)
+	ListPendingPast(ctx context.Context, before time.Time, ledger uint32, limit int) ([]*Submission, error)
+	// ListSettledSince returns a wallet's submissions with a known outcome created at or after
+	// the given time, oldest first
+	ListSettledSince(ctx context.Context, walletID uuid.UUID, since time.Time) ([]*Submission, error)
+}
@@ -8,11 +8,22 @@ import (
 	"github.com/google/uuid"
 )
 
+// Status represents whether a wallet can still be used
+type Status string
+
+const (
+	StatusActive Status = "active"
+	StatusClosed Status = "closed" // Archived after its account was merged; kept for audit
+)
+
 type Wallet struct {
 	ID           uuid.UUID
 	PublicKey    string // Stellar public key (G...)
 	EncryptedKey string // Encrypted private key
 	Network      string // "testnet" ou "mainnet"
+	Status       Status
+	ClosedAt     *time.Time
+	MergedInto   string // Account that received the remaining XLM, empty if the account was never funded
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
@@ -39,7 +50,22 @@ func NewWallet(publicKey, encryptedKey, network string) (*Wallet, error) {
 		PublicKey:    publicKey,
 		EncryptedKey: encryptedKey,
 		Network:      network,
+		Status:       StatusActive,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}, nil
 }
+
+// IsClosed reports whether the wallet was archived and must not sign anything
+func (w *Wallet) IsClosed() bool {
+	return w.Status == StatusClosed
+}
+
+// Close archives the wallet after its account was merged into mergedInto
+func (w *Wallet) Close(mergedInto string) {
+	now := time.Now()
+	w.Status = StatusClosed
+	w.ClosedAt = &now
+	w.MergedInto = mergedInto
+	w.UpdatedAt = now
+}
@@ -8,8 +8,12 @@ import (
 
 type Repository interface {
 	Create(ctx context.Context, wallet *Wallet) error
+	// Update persists the wallet's status; keys and network never change
+	Update(ctx context.Context, wallet *Wallet) error
 	FindByID(ctx context.Context, id uuid.UUID) (*Wallet, error)
 	FindByPublicKey(ctx context.Context, publicKey string) (*Wallet, error)
 	List(ctx context.Context, limit, offset int) ([]*Wallet, error)
 	Count(ctx context.Context) (int64, error)
+	// ListActive returns every wallet on the network that has not been closed, oldest first
+	ListActive(ctx context.Context, network string) ([]*Wallet, error)
 }
@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+
+	"github.com/google/uuid"
+)
+
+// Endpoint is a URL registered by a user to receive signed event deliveries
+type Endpoint struct {
+	ID              uuid.UUID
+	OwnerID         string // User that registered the endpoint; only they can see and manage it
+	URL             string
+	Description     string
+	EventTypes      []event.Type
+	WalletIDs       []uuid.UUID // Wallets whose events are delivered; empty means every wallet
+	EncryptedSecret string      // Signing secret, encrypted like wallet keys
+	Active          bool        // Inactive endpoints receive no new deliveries
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewEndpoint creates an active endpoint subscribed to the given event types
+func NewEndpoint(ownerID, rawURL, description string, eventTypes []event.Type, walletIDs []uuid.UUID, encryptedSecret string) (*Endpoint, error) {
+	if ownerID == "" {
+		return nil, fmt.Errorf("owner id is required")
+	}
+
+	if encryptedSecret == "" {
+		return nil, fmt.Errorf("encrypted secret is required")
+	}
+
+	now := time.Now()
+	e := &Endpoint{
+		ID:              uuid.New(),
+		OwnerID:         ownerID,
+		Description:     description,
+		WalletIDs:       walletIDs,
+		EncryptedSecret: encryptedSecret,
+		Active:          true,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	if err := e.SetURL(rawURL); err != nil {
+		return nil, err
+	}
+	if err := e.SetEventTypes(eventTypes); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// IsValidURL reports whether rawURL can receive deliveries: an absolute https URL.
+// Payloads are signed but not encrypted, so plain http is not accepted.
+func IsValidURL(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	return err == nil && parsed.Scheme == "https" && parsed.Host != ""
+}
+
+// SetURL changes where deliveries are sent
+func (e *Endpoint) SetURL(rawURL string) error {
+	if !IsValidURL(rawURL) {
+		return fmt.Errorf("invalid webhook url %q", rawURL)
+	}
+
+	e.URL = rawURL
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetEventTypes replaces the subscribed event types, dropping duplicates
+func (e *Endpoint) SetEventTypes(eventTypes []event.Type) error {
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("at least one event type is required")
+	}
+
+	seen := make(map[event.Type]bool, len(eventTypes))
+	types := make([]event.Type, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		if !event.IsValidType(t) {
+			return fmt.Errorf("unknown event type %q", t)
+		}
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+
+	e.EventTypes = types
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Subscribes reports whether the endpoint wants the event
+func (e *Endpoint) Subscribes(ev *event.Event) bool {
+	if !e.Active {
+		return false
+	}
+
+	typeMatches := false
+	for _, subscribed := range e.EventTypes {
+		if subscribed == ev.Type {
+			typeMatches = true
+			break
+		}
+	}
+	if !typeMatches {
+		return false
+	}
+
+	if len(e.WalletIDs) == 0 {
+		return true
+	}
+	for _, id := range e.WalletIDs {
+		if id == ev.WalletID {
+			return true
+		}
+	}
+	return false
+}
+
+// DeliveryStatus represents where a delivery is in the outbox
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"   // Waiting for its next attempt
+	DeliveryDelivered DeliveryStatus = "delivered" // The endpoint answered with a 2xx status
+	DeliveryDead      DeliveryStatus = "dead"      // Gave up after the maximum attempts; can be redelivered
+)
+
+// Delivery is one event queued for one endpoint. Deliveries are written to the
+// outbox when the event is published and sent by the delivery worker.
+type Delivery struct {
+	ID             uuid.UUID
+	EndpointID     uuid.UUID
+	EventID        uuid.UUID
+	EventType      event.Type
+	Payload        []byte // JSON body, fixed when the delivery is queued
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int // HTTP status of the last attempt, zero if no response
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// NewDelivery queues an event for an endpoint, due immediately
+func NewDelivery(endpointID, eventID uuid.UUID, eventType event.Type, payload []byte) *Delivery {
+	now := time.Now()
+	return &Delivery{
+		ID:            uuid.New(),
+		EndpointID:    endpointID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        DeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// MarkDelivered records a successful attempt
+func (d *Delivery) MarkDelivered(statusCode int) {
+	now := time.Now()
+	d.Attempts++
+	d.Status = DeliveryDelivered
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+}
+
+// MarkFailed records a failed attempt. The delivery is retried at retryAt, or moved
+// to the dead-letter queue once maxAttempts have been made.
+func (d *Delivery) MarkFailed(statusCode int, message string, maxAttempts int, retryAt time.Time) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = message
+	d.UpdatedAt = time.Now()
+
+	if d.Attempts >= maxAttempts {
+		d.Status = DeliveryDead
+		return
+	}
+	d.NextAttemptAt = retryAt
+}
+
+// Abandon moves a pending delivery to the dead-letter queue without sending it
+func (d *Delivery) Abandon(message string) {
+	d.Status = DeliveryDead
+	d.LastError = message
+	d.UpdatedAt = time.Now()
+}
+
+// Redeliver queues a delivered or dead delivery again with a fresh attempt budget
+func (d *Delivery) Redeliver() error {
+	if d.Status == DeliveryPending {
+		return fmt.Errorf("delivery is already pending")
+	}
+
+	now := time.Now()
+	d.Status = DeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = now
+	d.DeliveredAt = nil
+	d.UpdatedAt = now
+	return nil
+}
+
+// Attempt is the log entry of one HTTP request made for a delivery
+type Attempt struct {
+	ID           uuid.UUID
+	DeliveryID   uuid.UUID
+	Number       int // 1 for the first attempt
+	StatusCode   int // Zero if no response was received
+	Error        string
+	ResponseBody string // Truncated response body
+	Duration     time.Duration
+	CreatedAt    time.Time
+}
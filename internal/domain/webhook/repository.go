@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+
+	"github.com/google/uuid"
+)
+
+type EndpointRepository interface {
+	Create(ctx context.Context, endpoint *Endpoint) error
+	Update(ctx context.Context, endpoint *Endpoint) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Endpoint, error)
+	// ListByOwner returns the owner's endpoints, newest first
+	ListByOwner(ctx context.Context, ownerID string, limit, offset int) ([]*Endpoint, error)
+	CountByOwner(ctx context.Context, ownerID string) (int64, error)
+	// ListSubscribed returns every active endpoint subscribed to the event type
+	ListSubscribed(ctx context.Context, eventType event.Type) ([]*Endpoint, error)
+}
+
+type DeliveryRepository interface {
+	// Enqueue inserts the delivery unless the endpoint already has one for the same event,
+	// and reports whether it was inserted
+	Enqueue(ctx context.Context, delivery *Delivery) (bool, error)
+	Update(ctx context.Context, delivery *Delivery) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Delivery, error)
+	// ListByEndpoint returns the endpoint's deliveries, newest first; an empty status matches all
+	ListByEndpoint(ctx context.Context, endpointID uuid.UUID, status DeliveryStatus, limit, offset int) ([]*Delivery, error)
+	CountByEndpoint(ctx context.Context, endpointID uuid.UUID, status DeliveryStatus) (int64, error)
+	// ClaimDue returns pending deliveries whose next attempt is due and postpones them
+	// by lease, so concurrent workers do not send the same delivery twice
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error)
+	// RecordAttempt saves the delivery's new state together with the attempt's log entry
+	RecordAttempt(ctx context.Context, delivery *Delivery, attempt *Attempt) error
+	ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]*Attempt, error)
+}
+
+// Response is what an endpoint answered to a delivery request
+type Response struct {
+	StatusCode int
+	Body       string
+}
+
+// Sender posts signed delivery payloads to endpoints
+type Sender interface {
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (*Response, error)
+}
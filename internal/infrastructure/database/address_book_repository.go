@@ -0,0 +1,186 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"quasarflow-api/internal/domain/addressbook"
+
+	"github.com/google/uuid"
+)
+
+const addressBookEntryColumns = `id, scope, subject_id, name, address, memo, memo_type, federation_address, created_by,
+        created_at, usable_at`
+
+type PostgresAddressBookRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresAddressBookRepository(db *sql.DB) *PostgresAddressBookRepository {
+	return &PostgresAddressBookRepository{db: db}
+}
+
+func (r *PostgresAddressBookRepository) Create(ctx context.Context, e *addressbook.Entry) error {
+	query := `
+        INSERT INTO address_book_entries (` + addressBookEntryColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+    `
+
+	_, err := r.db.ExecContext(ctx, query,
+		e.ID,
+		e.Scope,
+		e.SubjectID,
+		e.Name,
+		e.Address,
+		e.Memo,
+		e.MemoType,
+		e.FederationAddress,
+		e.CreatedBy,
+		e.CreatedAt,
+		e.UsableAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create address book entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAddressBookRepository) FindByID(ctx context.Context, id uuid.UUID) (*addressbook.Entry, error) {
+	query := `SELECT ` + addressBookEntryColumns + ` FROM address_book_entries WHERE id = $1`
+
+	e, err := scanAddressBookEntry(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("address book entry not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find address book entry: %w", err)
+	}
+
+	return e, nil
+}
+
+func (r *PostgresAddressBookRepository) List(ctx context.Context, scope, subjectID string) ([]*addressbook.Entry, error) {
+	query := `
+        SELECT ` + addressBookEntryColumns + `
+        FROM address_book_entries
+        WHERE scope = $1 AND subject_id = $2
+        ORDER BY name ASC
+    `
+
+	return r.list(ctx, query, scope, subjectID)
+}
+
+func (r *PostgresAddressBookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM address_book_entries WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete address book entry: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("address book entry not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresAddressBookRepository) FindForPayment(ctx context.Context, walletID uuid.UUID, userID, address string) ([]*addressbook.Entry, error) {
+	query := `
+        SELECT ` + addressBookEntryColumns + `
+        FROM address_book_entries
+        WHERE address = $1
+            AND ((scope = 'wallet' AND subject_id = $2) OR (scope = 'user' AND $3 <> '' AND subject_id = $3))
+    `
+
+	return r.list(ctx, query, address, walletID.String(), userID)
+}
+
+func (r *PostgresAddressBookRepository) GetMode(ctx context.Context, scope, subjectID string) (*addressbook.Mode, error) {
+	query := `
+        SELECT allowlist_only, relaxes_at, updated_at
+        FROM address_book_modes
+        WHERE scope = $1 AND subject_id = $2
+    `
+
+	mode := &addressbook.Mode{Scope: scope, SubjectID: subjectID}
+	var relaxesAt sql.NullTime
+
+	err := r.db.QueryRowContext(ctx, query, scope, subjectID).Scan(&mode.AllowlistOnly, &relaxesAt, &mode.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return mode, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address book mode: %w", err)
+	}
+
+	if relaxesAt.Valid {
+		mode.RelaxesAt = &relaxesAt.Time
+	}
+
+	return mode, nil
+}
+
+func (r *PostgresAddressBookRepository) SaveMode(ctx context.Context, mode *addressbook.Mode) error {
+	query := `
+        INSERT INTO address_book_modes (scope, subject_id, allowlist_only, relaxes_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (scope, subject_id) DO UPDATE SET
+            allowlist_only = EXCLUDED.allowlist_only,
+            relaxes_at = EXCLUDED.relaxes_at,
+            updated_at = EXCLUDED.updated_at
+    `
+
+	if _, err := r.db.ExecContext(ctx, query, mode.Scope, mode.SubjectID, mode.AllowlistOnly, mode.RelaxesAt, mode.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to save address book mode: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAddressBookRepository) list(ctx context.Context, query string, args ...interface{}) ([]*addressbook.Entry, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list address book entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*addressbook.Entry, 0)
+	for rows.Next() {
+		e, err := scanAddressBookEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+func scanAddressBookEntry(row rowScanner) (*addressbook.Entry, error) {
+	e := &addressbook.Entry{}
+
+	err := row.Scan(
+		&e.ID,
+		&e.Scope,
+		&e.SubjectID,
+		&e.Name,
+		&e.Address,
+		&e.Memo,
+		&e.MemoType,
+		&e.FederationAddress,
+		&e.CreatedBy,
+		&e.CreatedAt,
+		&e.UsableAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
@@ -0,0 +1,383 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/approval"
+
+	"github.com/google/uuid"
+)
+
+const approvalPolicyColumns = `id, wallet_id, asset, threshold, quorum, ttl_seconds, created_at, updated_at`
+
+const approvalRequestColumns = `id, wallet_id, policy_id, asset, amount, destination, memo, payment, requested_by, quorum,
+        approvals, status, transaction_hash, submission_id, error, expires_at, created_at, updated_at, version`
+
+type PostgresApprovalRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresApprovalRepository(db *sql.DB) *PostgresApprovalRepository {
+	return &PostgresApprovalRepository{db: db}
+}
+
+func (r *PostgresApprovalRepository) SavePolicy(ctx context.Context, p *approval.Policy) error {
+	query := `
+        INSERT INTO approval_policies (` + approvalPolicyColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        ON CONFLICT (wallet_id, asset) DO UPDATE SET
+            threshold = EXCLUDED.threshold,
+            quorum = EXCLUDED.quorum,
+            ttl_seconds = EXCLUDED.ttl_seconds,
+            updated_at = EXCLUDED.updated_at
+        RETURNING id, created_at
+    `
+
+	err := r.db.QueryRowContext(ctx, query,
+		p.ID,
+		p.WalletID,
+		p.Asset,
+		p.Threshold,
+		p.Quorum,
+		int64(p.TTL/time.Second),
+		p.CreatedAt,
+		p.UpdatedAt,
+	).Scan(&p.ID, &p.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save approval policy: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresApprovalRepository) DeletePolicy(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM approval_policies WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete approval policy: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("approval policy not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresApprovalRepository) ListPolicies(ctx context.Context, walletID uuid.UUID) ([]*approval.Policy, error) {
+	query := `
+        SELECT ` + approvalPolicyColumns + `
+        FROM approval_policies
+        WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR wallet_id = $1)
+        ORDER BY wallet_id ASC, asset ASC
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := make([]*approval.Policy, 0)
+	for rows.Next() {
+		p, err := scanApprovalPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+
+	return policies, rows.Err()
+}
+
+func (r *PostgresApprovalRepository) FindPolicy(ctx context.Context, walletID uuid.UUID, asset string) (*approval.Policy, error) {
+	query := `SELECT ` + approvalPolicyColumns + ` FROM approval_policies WHERE wallet_id = $1 AND asset = $2`
+
+	p, err := scanApprovalPolicy(r.db.QueryRowContext(ctx, query, walletID, asset))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find approval policy: %w", err)
+	}
+
+	return p, nil
+}
+
+func (r *PostgresApprovalRepository) Create(ctx context.Context, req *approval.Request) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+        INSERT INTO approval_requests (` + approvalRequestColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+    `
+
+	_, err = tx.ExecContext(ctx, query,
+		req.ID,
+		req.WalletID,
+		req.PolicyID,
+		req.Asset,
+		req.Amount,
+		req.Destination,
+		req.Memo,
+		string(req.Payment),
+		req.RequestedBy,
+		req.Quorum,
+		req.Approvals,
+		req.Status,
+		req.TransactionHash,
+		req.SubmissionID,
+		req.Error,
+		req.ExpiresAt,
+		req.CreatedAt,
+		req.UpdatedAt,
+		req.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create approval request: %w", err)
+	}
+
+	if err := insertApprovalAudit(ctx, tx, req); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit approval request: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresApprovalRepository) FindByID(ctx context.Context, id uuid.UUID) (*approval.Request, error) {
+	query := `SELECT ` + approvalRequestColumns + ` FROM approval_requests WHERE id = $1`
+
+	req, err := scanApprovalRequest(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("approval request not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find approval request: %w", err)
+	}
+
+	query = `
+        SELECT action, actor, comment, created_at
+        FROM approval_audit
+        WHERE request_id = $1
+        ORDER BY number ASC
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval audit: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e approval.AuditEntry
+		if err := rows.Scan(&e.Action, &e.Actor, &e.Comment, &e.At); err != nil {
+			return nil, err
+		}
+		req.Trail = append(req.Trail, e)
+	}
+
+	return req, rows.Err()
+}
+
+func (r *PostgresApprovalRepository) List(ctx context.Context, filter approval.Filter, limit, offset int) ([]*approval.Request, error) {
+	query := `
+        SELECT ` + approvalRequestColumns + `
+        FROM approval_requests
+        WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR wallet_id = $1) AND ($2 = '' OR status = $2)
+        ORDER BY created_at DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	return r.list(ctx, query, filter.WalletID, filter.Status, limit, offset)
+}
+
+func (r *PostgresApprovalRepository) Count(ctx context.Context, filter approval.Filter) (int64, error) {
+	query := `
+        SELECT COUNT(*)
+        FROM approval_requests
+        WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR wallet_id = $1) AND ($2 = '' OR status = $2)
+    `
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, filter.WalletID, filter.Status).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count approval requests: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PostgresApprovalRepository) ListExpired(ctx context.Context, now time.Time) ([]*approval.Request, error) {
+	query := `
+        SELECT ` + approvalRequestColumns + `
+        FROM approval_requests
+        WHERE status = 'pending' AND expires_at <= $1
+        ORDER BY expires_at ASC
+    `
+
+	return r.list(ctx, query, now)
+}
+
+func (r *PostgresApprovalRepository) ListUnsettled(ctx context.Context, before time.Time) ([]*approval.Request, error) {
+	query := `
+        SELECT ` + approvalRequestColumns + `
+        FROM approval_requests
+        WHERE status = 'approved' AND updated_at < $1
+        ORDER BY updated_at ASC
+    `
+
+	return r.list(ctx, query, before)
+}
+
+func (r *PostgresApprovalRepository) Update(ctx context.Context, req *approval.Request) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+        UPDATE approval_requests
+        SET approvals = $3, status = $4, transaction_hash = $5, submission_id = $6, error = $7, updated_at = $8,
+            version = version + 1
+        WHERE id = $1 AND version = $2
+    `
+
+	result, err := tx.ExecContext(ctx, query,
+		req.ID,
+		req.Version,
+		req.Approvals,
+		req.Status,
+		req.TransactionHash,
+		req.SubmissionID,
+		req.Error,
+		req.UpdatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update approval request: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return false, nil
+	}
+
+	if err := insertApprovalAudit(ctx, tx, req); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit approval request: %w", err)
+	}
+
+	req.Version++
+	return true, nil
+}
+
+func (r *PostgresApprovalRepository) list(ctx context.Context, query string, args ...interface{}) ([]*approval.Request, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval requests: %w", err)
+	}
+	defer rows.Close()
+
+	requests := make([]*approval.Request, 0)
+	for rows.Next() {
+		req, err := scanApprovalRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, req)
+	}
+
+	return requests, rows.Err()
+}
+
+// insertApprovalAudit saves the audit entries not saved yet; entries are numbered by
+// their position in the trail, which only grows
+func insertApprovalAudit(ctx context.Context, db execer, req *approval.Request) error {
+	query := `
+        INSERT INTO approval_audit (request_id, number, action, actor, comment, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        ON CONFLICT (request_id, number) DO NOTHING
+    `
+
+	for i, e := range req.Trail {
+		if _, err := db.ExecContext(ctx, query, req.ID, i+1, e.Action, e.Actor, e.Comment, e.At); err != nil {
+			return fmt.Errorf("failed to save approval audit: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func scanApprovalPolicy(row rowScanner) (*approval.Policy, error) {
+	p := &approval.Policy{}
+	var ttlSeconds int64
+
+	err := row.Scan(
+		&p.ID,
+		&p.WalletID,
+		&p.Asset,
+		&p.Threshold,
+		&p.Quorum,
+		&ttlSeconds,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.TTL = time.Duration(ttlSeconds) * time.Second
+
+	return p, nil
+}
+
+func scanApprovalRequest(row rowScanner) (*approval.Request, error) {
+	req := &approval.Request{}
+
+	err := row.Scan(
+		&req.ID,
+		&req.WalletID,
+		&req.PolicyID,
+		&req.Asset,
+		&req.Amount,
+		&req.Destination,
+		&req.Memo,
+		&req.Payment,
+		&req.RequestedBy,
+		&req.Quorum,
+		&req.Approvals,
+		&req.Status,
+		&req.TransactionHash,
+		&req.SubmissionID,
+		&req.Error,
+		&req.ExpiresAt,
+		&req.CreatedAt,
+		&req.UpdatedAt,
+		&req.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,216 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"quasarflow-api/internal/domain/asset"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const assetColumns = `id, code, issuer_wallet_id, issuer_public_key, distribution_wallet_id,
+        auth_required, auth_revocable, clawback_enabled, locked, minted, clawed_back, created_at, updated_at`
+
+type PostgresAssetRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresAssetRepository(db *sql.DB) *PostgresAssetRepository {
+	return &PostgresAssetRepository{db: db}
+}
+
+func (r *PostgresAssetRepository) Create(ctx context.Context, a *asset.Asset) error {
+	query := `
+        INSERT INTO assets (` + assetColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+    `
+
+	_, err := r.db.ExecContext(ctx, query,
+		a.ID,
+		a.Code,
+		a.IssuerWalletID,
+		a.IssuerPublicKey,
+		a.DistributionWalletID,
+		a.AuthRequired,
+		a.AuthRevocable,
+		a.ClawbackEnabled,
+		a.Locked,
+		a.Minted,
+		a.ClawedBack,
+		a.CreatedAt,
+		a.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create asset: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAssetRepository) UpdateFlags(ctx context.Context, a *asset.Asset) error {
+	query := `
+        UPDATE assets
+        SET auth_required = $2, auth_revocable = $3, clawback_enabled = $4, updated_at = $5
+        WHERE id = $1
+    `
+
+	_, err := r.db.ExecContext(ctx, query,
+		a.ID,
+		a.AuthRequired,
+		a.AuthRevocable,
+		a.ClawbackEnabled,
+		a.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update asset flags: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAssetRepository) MarkLocked(ctx context.Context, a *asset.Asset) error {
+	query := `UPDATE assets SET locked = TRUE, updated_at = NOW() WHERE id = $1 RETURNING updated_at`
+
+	if err := r.db.QueryRowContext(ctx, query, a.ID).Scan(&a.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to mark asset locked: %w", err)
+	}
+	a.Locked = true
+
+	return nil
+}
+
+func (r *PostgresAssetRepository) AddMinted(ctx context.Context, a *asset.Asset, amount decimal.Decimal) error {
+	query := `
+        UPDATE assets
+        SET minted = minted + $2, updated_at = NOW()
+        WHERE id = $1
+        RETURNING minted, clawed_back, updated_at
+    `
+
+	if err := r.db.QueryRowContext(ctx, query, a.ID, amount).Scan(&a.Minted, &a.ClawedBack, &a.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to record minted supply: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAssetRepository) AddClawedBack(ctx context.Context, a *asset.Asset, amount decimal.Decimal) error {
+	query := `
+        UPDATE assets
+        SET clawed_back = clawed_back + $2, updated_at = NOW()
+        WHERE id = $1
+        RETURNING minted, clawed_back, updated_at
+    `
+
+	if err := r.db.QueryRowContext(ctx, query, a.ID, amount).Scan(&a.Minted, &a.ClawedBack, &a.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to record clawed back supply: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAssetRepository) FindByID(ctx context.Context, id uuid.UUID) (*asset.Asset, error) {
+	query := `SELECT ` + assetColumns + ` FROM assets WHERE id = $1`
+
+	return r.find(ctx, query, id)
+}
+
+func (r *PostgresAssetRepository) FindByCodeAndIssuer(ctx context.Context, code, issuerPublicKey string) (*asset.Asset, error) {
+	query := `SELECT ` + assetColumns + ` FROM assets WHERE code = $1 AND issuer_public_key = $2`
+
+	return r.find(ctx, query, code, issuerPublicKey)
+}
+
+func (r *PostgresAssetRepository) List(ctx context.Context, limit, offset int) ([]*asset.Asset, error) {
+	query := `
+        SELECT ` + assetColumns + `
+        FROM assets
+        ORDER BY created_at DESC
+        LIMIT $1 OFFSET $2
+    `
+
+	return r.list(ctx, query, limit, offset)
+}
+
+func (r *PostgresAssetRepository) Count(ctx context.Context) (int64, error) {
+	query := `SELECT COUNT(*) FROM assets`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count assets: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PostgresAssetRepository) ListByIssuerWallet(ctx context.Context, issuerWalletID uuid.UUID) ([]*asset.Asset, error) {
+	query := `
+        SELECT ` + assetColumns + `
+        FROM assets
+        WHERE issuer_wallet_id = $1
+        ORDER BY created_at ASC
+    `
+
+	return r.list(ctx, query, issuerWalletID)
+}
+
+func (r *PostgresAssetRepository) find(ctx context.Context, query string, args ...interface{}) (*asset.Asset, error) {
+	a, err := scanAsset(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("asset not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find asset: %w", err)
+	}
+
+	return a, nil
+}
+
+func (r *PostgresAssetRepository) list(ctx context.Context, query string, args ...interface{}) ([]*asset.Asset, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assets: %w", err)
+	}
+	defer rows.Close()
+
+	assets := make([]*asset.Asset, 0)
+	for rows.Next() {
+		a, err := scanAsset(rows)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, a)
+	}
+
+	return assets, rows.Err()
+}
+
+func scanAsset(row rowScanner) (*asset.Asset, error) {
+	a := &asset.Asset{}
+	err := row.Scan(
+		&a.ID,
+		&a.Code,
+		&a.IssuerWalletID,
+		&a.IssuerPublicKey,
+		&a.DistributionWalletID,
+		&a.AuthRequired,
+		&a.AuthRevocable,
+		&a.ClawbackEnabled,
+		&a.Locked,
+		&a.Minted,
+		&a.ClawedBack,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
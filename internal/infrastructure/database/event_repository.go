@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+
+	"github.com/google/uuid"
+)
+
+// eventData is the JSON stored in wallet_events.data; only the detail matching the type is set
+type eventData struct {
+	Wallet         *event.Wallet         `json:"wallet,omitempty"`
+	Payment        *event.Payment        `json:"payment,omitempty"`
+	Transaction    *event.Transaction    `json:"transaction,omitempty"`
+	Trustline      *event.Trustline      `json:"trustline,omitempty"`
+	Reconciliation *event.Reconciliation `json:"reconciliation,omitempty"`
+	Approval       *event.Approval       `json:"approval,omitempty"`
+}
+
+type PostgresEventRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresEventRepository(db *sql.DB) *PostgresEventRepository {
+	return &PostgresEventRepository{db: db}
+}
+
+func (r *PostgresEventRepository) Save(ctx context.Context, e *event.Event) (bool, error) {
+	data, err := json.Marshal(eventData{
+		Wallet:         e.Wallet,
+		Payment:        e.Payment,
+		Transaction:    e.Transaction,
+		Trustline:      e.Trustline,
+		Reconciliation: e.Reconciliation,
+		Approval:       e.Approval,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to encode event data: %w", err)
+	}
+
+	query := `
+        INSERT INTO wallet_events (id, wallet_id, type, public_key, data, occurred_at, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (id) DO NOTHING
+    `
+
+	result, err := r.db.ExecContext(ctx, query,
+		e.ID,
+		e.WalletID,
+		string(e.Type),
+		e.PublicKey,
+		data,
+		e.OccurredAt,
+		e.CreatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to save event: %w", err)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to save event: %w", err)
+	}
+
+	return inserted > 0, nil
+}
+
+func (r *PostgresEventRepository) ListAfter(ctx context.Context, walletID, afterID uuid.UUID, limit int) ([]*event.Event, error) {
+	// Resolved separately so a missing cursor is reported instead of matching nothing
+	var after int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT seq FROM wallet_events WHERE id = $1 AND wallet_id = $2`,
+		afterID, walletID,
+	).Scan(&after)
+	if err == sql.ErrNoRows {
+		return nil, event.ErrEventNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+
+	query := `
+        SELECT id, wallet_id, type, public_key, data, occurred_at, created_at
+        FROM wallet_events
+        WHERE wallet_id = $1 AND seq > $2
+        ORDER BY seq ASC
+        LIMIT $3
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, walletID, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*event.Event
+	for rows.Next() {
+		var (
+			e         event.Event
+			eventType string
+			raw       []byte
+			data      eventData
+		)
+		if err := rows.Scan(&e.ID, &e.WalletID, &eventType, &e.PublicKey, &raw, &e.OccurredAt, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, fmt.Errorf("failed to decode event data: %w", err)
+		}
+
+		e.Type = event.Type(eventType)
+		e.Wallet = data.Wallet
+		e.Payment = data.Payment
+		e.Transaction = data.Transaction
+		e.Trustline = data.Trustline
+		e.Reconciliation = data.Reconciliation
+		e.Approval = data.Approval
+		events = append(events, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *PostgresEventRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM wallet_events WHERE created_at < $1`
+
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete events: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete events: %w", err)
+	}
+
+	return deleted, nil
+}
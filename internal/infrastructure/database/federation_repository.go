@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"quasarflow-api/internal/domain/federation"
+
+	"github.com/google/uuid"
+)
+
+type PostgresFederationRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresFederationRepository(db *sql.DB) *PostgresFederationRepository {
+	return &PostgresFederationRepository{db: db}
+}
+
+func (r *PostgresFederationRepository) Create(ctx context.Context, n *federation.Name) error {
+	query := `
+        INSERT INTO federation_names (name, wallet_id, created_at)
+        VALUES ($1, $2, $3)
+    `
+
+	_, err := r.db.ExecContext(ctx, query, n.Name, n.WalletID, n.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create federation name: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresFederationRepository) FindByName(ctx context.Context, name string) (*federation.Name, error) {
+	query := `
+        SELECT name, wallet_id, created_at
+        FROM federation_names
+        WHERE name = $1
+    `
+
+	return r.find(ctx, query, name)
+}
+
+func (r *PostgresFederationRepository) FindByWalletID(ctx context.Context, walletID uuid.UUID) (*federation.Name, error) {
+	query := `
+        SELECT name, wallet_id, created_at
+        FROM federation_names
+        WHERE wallet_id = $1
+    `
+
+	return r.find(ctx, query, walletID)
+}
+
+func (r *PostgresFederationRepository) find(ctx context.Context, query string, arg interface{}) (*federation.Name, error) {
+	n := &federation.Name{}
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(&n.Name, &n.WalletID, &n.CreatedAt)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("federation name not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find federation name: %w", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,297 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"quasarflow-api/internal/domain/ledger"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const ledgerOperationColumns = `position, id, wallet_id, transaction_hash, type, source_account, successful,
+        counterparties, assets, details, closed_at`
+
+const ledgerTransactionColumns = `hash, id, ledger, source_account, successful, max_fee, fee_charged,
+        operation_count, memo_type, memo, closed_at`
+
+type PostgresLedgerRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresLedgerRepository(db *sql.DB) *PostgresLedgerRepository {
+	return &PostgresLedgerRepository{db: db}
+}
+
+func (r *PostgresLedgerRepository) Save(ctx context.Context, batch *ledger.Batch) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, t := range batch.Transactions {
+		query := `
+            INSERT INTO ledger_transactions (` + ledgerTransactionColumns + `)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+            ON CONFLICT (hash) DO NOTHING
+        `
+		if _, err := tx.ExecContext(ctx, query,
+			t.Hash, t.ID, t.Ledger, t.SourceAccount, t.Successful, t.MaxFee, t.FeeCharged,
+			t.OperationCount, t.MemoType, t.Memo, t.ClosedAt,
+		); err != nil {
+			return fmt.Errorf("failed to index transaction: %w", err)
+		}
+	}
+
+	for _, op := range batch.Operations {
+		query := `
+            INSERT INTO ledger_operations (` + ledgerOperationColumns + `)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+            ON CONFLICT (wallet_id, position) DO NOTHING
+        `
+		result, err := tx.ExecContext(ctx, query,
+			op.Position, op.ID, op.WalletID, op.TransactionHash, op.Type, op.SourceAccount, op.Successful,
+			pq.Array(op.Counterparties), pq.Array(op.Assets), op.Details, op.ClosedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to index operation: %w", err)
+		}
+
+		inserted, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to index operation: %w", err)
+		}
+		if inserted == 0 {
+			// Indexed before, together with its effects
+			continue
+		}
+
+		for _, e := range op.Effects {
+			query := `
+                INSERT INTO ledger_effects (wallet_id, id, operation_position, type, details)
+                VALUES ($1, $2, $3, $4, $5)
+                ON CONFLICT (wallet_id, id) DO NOTHING
+            `
+			if _, err := tx.ExecContext(ctx, query, op.WalletID, e.ID, op.Position, e.Type, e.Details); err != nil {
+				return fmt.Errorf("failed to index effect: %w", err)
+			}
+		}
+	}
+
+	query := `
+        INSERT INTO ledger_index_state (wallet_id, cursor, updated_at)
+        VALUES ($1, $2, NOW())
+        ON CONFLICT (wallet_id) DO UPDATE SET cursor = EXCLUDED.cursor, updated_at = EXCLUDED.updated_at
+    `
+	if _, err := tx.ExecContext(ctx, query, batch.WalletID, batch.Cursor); err != nil {
+		return fmt.Errorf("failed to save index cursor: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresLedgerRepository) GetState(ctx context.Context, walletID uuid.UUID) (*ledger.IndexState, error) {
+	query := `SELECT wallet_id, cursor, backfilled_at, updated_at FROM ledger_index_state WHERE wallet_id = $1`
+
+	state := &ledger.IndexState{}
+	err := r.db.QueryRowContext(ctx, query, walletID).Scan(
+		&state.WalletID,
+		&state.Cursor,
+		&state.BackfilledAt,
+		&state.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get index state: %w", err)
+	}
+
+	return state, nil
+}
+
+func (r *PostgresLedgerRepository) MarkBackfilled(ctx context.Context, walletID uuid.UUID) error {
+	query := `
+        INSERT INTO ledger_index_state (wallet_id, cursor, backfilled_at, updated_at)
+        VALUES ($1, '', NOW(), NOW())
+        ON CONFLICT (wallet_id) DO UPDATE SET backfilled_at = EXCLUDED.backfilled_at, updated_at = EXCLUDED.updated_at
+    `
+
+	if _, err := r.db.ExecContext(ctx, query, walletID); err != nil {
+		return fmt.Errorf("failed to mark wallet backfilled: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresLedgerRepository) ListOperations(ctx context.Context, filter ledger.Filter) ([]*ledger.Operation, error) {
+	conditions := []string{"wallet_id = $1"}
+	args := []interface{}{filter.WalletID}
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.From != nil {
+		addCondition("closed_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		addCondition("closed_at < $%d", *filter.To)
+	}
+	if filter.Asset != "" {
+		addCondition("$%d = ANY(assets)", filter.Asset)
+	}
+	if filter.Counterparty != "" {
+		addCondition("$%d = ANY(counterparties)", filter.Counterparty)
+	}
+	if len(filter.Types) > 0 {
+		addCondition("type = ANY($%d)", pq.Array(filter.Types))
+	}
+
+	order := "DESC"
+	if filter.Order == ledger.OrderAsc {
+		order = "ASC"
+	}
+	if filter.After != 0 {
+		if order == "ASC" {
+			addCondition("position > $%d", filter.After)
+		} else {
+			addCondition("position < $%d", filter.After)
+		}
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`
+        SELECT %s
+        FROM ledger_operations
+        WHERE %s
+        ORDER BY position %s
+        LIMIT $%d
+    `, ledgerOperationColumns, strings.Join(conditions, " AND "), order, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list operations: %w", err)
+	}
+	defer rows.Close()
+
+	var operations []*ledger.Operation
+	byPosition := make(map[int64]*ledger.Operation)
+	positions := make([]int64, 0)
+	for rows.Next() {
+		op := &ledger.Operation{}
+		if err := rows.Scan(
+			&op.Position,
+			&op.ID,
+			&op.WalletID,
+			&op.TransactionHash,
+			&op.Type,
+			&op.SourceAccount,
+			&op.Successful,
+			pq.Array(&op.Counterparties),
+			pq.Array(&op.Assets),
+			&op.Details,
+			&op.ClosedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan operation: %w", err)
+		}
+		operations = append(operations, op)
+		byPosition[op.Position] = op
+		positions = append(positions, op.Position)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating operations: %w", err)
+	}
+
+	if len(operations) == 0 {
+		return operations, nil
+	}
+
+	if err := r.loadEffects(ctx, filter.WalletID, positions, byPosition); err != nil {
+		return nil, err
+	}
+
+	return operations, nil
+}
+
+// loadEffects attaches the stored effects to their operations
+func (r *PostgresLedgerRepository) loadEffects(ctx context.Context, walletID uuid.UUID, positions []int64, byPosition map[int64]*ledger.Operation) error {
+	query := `
+        SELECT operation_position, id, type, details
+        FROM ledger_effects
+        WHERE wallet_id = $1 AND operation_position = ANY($2)
+        ORDER BY id ASC
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, walletID, pq.Array(positions))
+	if err != nil {
+		return fmt.Errorf("failed to list effects: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			position int64
+			e        ledger.Effect
+		)
+		if err := rows.Scan(&position, &e.ID, &e.Type, &e.Details); err != nil {
+			return fmt.Errorf("failed to scan effect: %w", err)
+		}
+		if op, ok := byPosition[position]; ok {
+			op.Effects = append(op.Effects, e)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating effects: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresLedgerRepository) FindTransactions(ctx context.Context, hashes []string) ([]*ledger.Transaction, error) {
+	query := `SELECT ` + ledgerTransactionColumns + ` FROM ledger_transactions WHERE hash = ANY($1)`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(hashes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var transactions []*ledger.Transaction
+	for rows.Next() {
+		t := &ledger.Transaction{}
+		if err := rows.Scan(
+			&t.Hash,
+			&t.ID,
+			&t.Ledger,
+			&t.SourceAccount,
+			&t.Successful,
+			&t.MaxFee,
+			&t.FeeCharged,
+			&t.OperationCount,
+			&t.MemoType,
+			&t.Memo,
+			&t.ClosedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan transaction: %w", err)
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating transactions: %w", err)
+	}
+
+	return transactions, nil
+}
@@ -0,0 +1,173 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"quasarflow-api/internal/domain/reconciliation"
+
+	"github.com/google/uuid"
+)
+
+const reconciliationReportColumns = `id, wallet_id, status, window_start, cursor, operations_checked, submissions_checked,
+        balances_checked, discrepancy_count, error, started_at, finished_at`
+
+type PostgresReconciliationRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresReconciliationRepository(db *sql.DB) *PostgresReconciliationRepository {
+	return &PostgresReconciliationRepository{db: db}
+}
+
+func (r *PostgresReconciliationRepository) Save(ctx context.Context, report *reconciliation.Report) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+        INSERT INTO reconciliation_reports (` + reconciliationReportColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+    `
+
+	_, err = tx.ExecContext(ctx, query,
+		report.ID,
+		report.WalletID,
+		report.Status,
+		report.WindowStart,
+		report.Cursor,
+		report.OperationsChecked,
+		report.SubmissionsChecked,
+		report.BalancesChecked,
+		report.DiscrepancyCount,
+		report.Error,
+		report.StartedAt,
+		report.FinishedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save reconciliation report: %w", err)
+	}
+
+	for i, d := range report.Discrepancies {
+		query := `
+            INSERT INTO reconciliation_discrepancies (report_id, number, kind, record, reference, field, expected, actual)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        `
+
+		if _, err := tx.ExecContext(ctx, query, report.ID, i+1, d.Kind, d.Record, d.Reference, d.Field, d.Expected, d.Actual); err != nil {
+			return fmt.Errorf("failed to save reconciliation discrepancy: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit reconciliation report: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresReconciliationRepository) FindByID(ctx context.Context, id uuid.UUID) (*reconciliation.Report, error) {
+	query := `SELECT ` + reconciliationReportColumns + ` FROM reconciliation_reports WHERE id = $1`
+
+	report, err := scanReconciliationReport(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("reconciliation report not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reconciliation report: %w", err)
+	}
+
+	query = `
+        SELECT kind, record, reference, field, expected, actual
+        FROM reconciliation_discrepancies
+        WHERE report_id = $1
+        ORDER BY number ASC
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reconciliation discrepancies: %w", err)
+	}
+	defer rows.Close()
+
+	report.Discrepancies = make([]reconciliation.Discrepancy, 0, report.DiscrepancyCount)
+	for rows.Next() {
+		var d reconciliation.Discrepancy
+		if err := rows.Scan(&d.Kind, &d.Record, &d.Reference, &d.Field, &d.Expected, &d.Actual); err != nil {
+			return nil, err
+		}
+		report.Discrepancies = append(report.Discrepancies, d)
+	}
+
+	return report, rows.Err()
+}
+
+func (r *PostgresReconciliationRepository) List(ctx context.Context, filter reconciliation.Filter, limit, offset int) ([]*reconciliation.Report, error) {
+	query := `
+        SELECT ` + reconciliationReportColumns + `
+        FROM reconciliation_reports
+        WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR wallet_id = $1) AND ($2 = '' OR status = $2)
+        ORDER BY started_at DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, filter.WalletID, filter.Status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reconciliation reports: %w", err)
+	}
+	defer rows.Close()
+
+	reports := make([]*reconciliation.Report, 0)
+	for rows.Next() {
+		report, err := scanReconciliationReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
+
+func (r *PostgresReconciliationRepository) Count(ctx context.Context, filter reconciliation.Filter) (int64, error) {
+	query := `
+        SELECT COUNT(*)
+        FROM reconciliation_reports
+        WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR wallet_id = $1) AND ($2 = '' OR status = $2)
+    `
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, filter.WalletID, filter.Status).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count reconciliation reports: %w", err)
+	}
+
+	return count, nil
+}
+
+func scanReconciliationReport(row rowScanner) (*reconciliation.Report, error) {
+	report := &reconciliation.Report{}
+
+	err := row.Scan(
+		&report.ID,
+		&report.WalletID,
+		&report.Status,
+		&report.WindowStart,
+		&report.Cursor,
+		&report.OperationsChecked,
+		&report.SubmissionsChecked,
+		&report.BalancesChecked,
+		&report.DiscrepancyCount,
+		&report.Error,
+		&report.StartedAt,
+		&report.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"quasarflow-api/internal/domain/registry"
+)
+
+type PostgresRegistryRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRegistryRepository(db *sql.DB) *PostgresRegistryRepository {
+	return &PostgresRegistryRepository{db: db}
+}
+
+func (r *PostgresRegistryRepository) Add(ctx context.Context, a *registry.VerifiedAsset) error {
+	query := `
+        INSERT INTO verified_assets (code, issuer, note, created_at)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (code, issuer) DO UPDATE SET note = EXCLUDED.note
+    `
+
+	_, err := r.db.ExecContext(ctx, query, a.Code, a.Issuer, a.Note, a.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add verified asset: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresRegistryRepository) Remove(ctx context.Context, code, issuer string) error {
+	query := `DELETE FROM verified_assets WHERE code = $1 AND issuer = $2`
+
+	result, err := r.db.ExecContext(ctx, query, code, issuer)
+	if err != nil {
+		return fmt.Errorf("failed to remove verified asset: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("verified asset not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresRegistryRepository) IsVerified(ctx context.Context, code, issuer string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM verified_assets WHERE code = $1 AND issuer = $2)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, code, issuer).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check verified asset: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (r *PostgresRegistryRepository) List(ctx context.Context) ([]*registry.VerifiedAsset, error) {
+	query := `
+        SELECT code, issuer, note, created_at
+        FROM verified_assets
+        ORDER BY code ASC, issuer ASC
+    `
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list verified assets: %w", err)
+	}
+	defer rows.Close()
+
+	assets := make([]*registry.VerifiedAsset, 0)
+	for rows.Next() {
+		a := &registry.VerifiedAsset{}
+		if err := rows.Scan(&a.Code, &a.Issuer, &a.Note, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan verified asset: %w", err)
+		}
+		assets = append(assets, a)
+	}
+
+	return assets, rows.Err()
+}
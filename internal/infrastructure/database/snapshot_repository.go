@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/ledger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type PostgresSnapshotRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSnapshotRepository(db *sql.DB) *PostgresSnapshotRepository {
+	return &PostgresSnapshotRepository{db: db}
+}
+
+func (r *PostgresSnapshotRepository) Save(ctx context.Context, snapshot *ledger.BalanceSnapshot) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+        INSERT INTO balance_snapshots (wallet_id, taken_at, asset, balance, ledger)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (wallet_id, taken_at, asset) DO NOTHING
+    `
+	for asset, balance := range snapshot.Balances {
+		if _, err := tx.ExecContext(ctx, query, snapshot.WalletID, snapshot.TakenAt, asset, balance, int64(snapshot.Ledger)); err != nil {
+			return fmt.Errorf("failed to save balance snapshot: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresSnapshotRepository) FindLatest(ctx context.Context, walletID uuid.UUID, at time.Time) (*ledger.BalanceSnapshot, error) {
+	query := `
+        SELECT taken_at, asset, balance, ledger
+        FROM balance_snapshots
+        WHERE wallet_id = $1 AND taken_at = (
+            SELECT MAX(taken_at) FROM balance_snapshots WHERE wallet_id = $1 AND taken_at <= $2
+        )
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, walletID, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find balance snapshot: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshot *ledger.BalanceSnapshot
+	for rows.Next() {
+		var (
+			takenAt time.Time
+			asset   string
+			balance decimal.Decimal
+			seq     int64
+		)
+		if err := rows.Scan(&takenAt, &asset, &balance, &seq); err != nil {
+			return nil, fmt.Errorf("failed to scan balance snapshot: %w", err)
+		}
+		if snapshot == nil {
+			snapshot = &ledger.BalanceSnapshot{
+				WalletID: walletID,
+				Balances: make(map[string]decimal.Decimal),
+				TakenAt:  takenAt,
+				Ledger:   uint32(seq),
+			}
+		}
+		snapshot.Balances[asset] = balance
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating balance snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
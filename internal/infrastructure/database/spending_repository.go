@@ -0,0 +1,271 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/spending"
+
+	"github.com/google/uuid"
+)
+
+const spendingPolicyColumns = `id, scope, subject_id, asset, max_per_transaction, daily_limit, monthly_limit, max_count,
+        count_window_seconds, created_at, updated_at`
+
+type PostgresSpendingRepository struct {
+	db *sql.DB
+	// unattachedTTL is how long a spend counts before its transaction is submitted.
+	// Payments are only submitted once attached and before their transaction's upper time
+	// bound, so an older spend without a submission belongs to a payment that failed or
+	// whose process died, and whose release was lost.
+	unattachedTTL time.Duration
+}
+
+// NewPostgresSpendingRepository creates a spending repository. unattachedTTL must cover
+// the time from reserving a spend to its transaction's upper time bound.
+func NewPostgresSpendingRepository(db *sql.DB, unattachedTTL time.Duration) *PostgresSpendingRepository {
+	return &PostgresSpendingRepository{db: db, unattachedTTL: unattachedTTL}
+}
+
+// querier is satisfied by both *sql.DB and *sql.Tx
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func (r *PostgresSpendingRepository) SavePolicy(ctx context.Context, p *spending.Policy) error {
+	query := `
+        INSERT INTO spending_policies (` + spendingPolicyColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+        ON CONFLICT (scope, subject_id, asset) DO UPDATE SET
+            max_per_transaction = EXCLUDED.max_per_transaction,
+            daily_limit = EXCLUDED.daily_limit,
+            monthly_limit = EXCLUDED.monthly_limit,
+            max_count = EXCLUDED.max_count,
+            count_window_seconds = EXCLUDED.count_window_seconds,
+            updated_at = EXCLUDED.updated_at
+        RETURNING id, created_at
+    `
+
+	err := r.db.QueryRowContext(ctx, query,
+		p.ID,
+		p.Scope,
+		p.SubjectID,
+		p.Asset,
+		p.MaxPerTransaction,
+		p.DailyLimit,
+		p.MonthlyLimit,
+		p.MaxCount,
+		int64(p.CountWindow/time.Second),
+		p.CreatedAt,
+		p.UpdatedAt,
+	).Scan(&p.ID, &p.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save spending policy: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresSpendingRepository) DeletePolicy(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM spending_policies WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete spending policy: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("spending policy not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresSpendingRepository) ListPolicies(ctx context.Context, scope, subjectID string) ([]*spending.Policy, error) {
+	query := `
+        SELECT ` + spendingPolicyColumns + `
+        FROM spending_policies
+        WHERE scope = $1 AND ($2 = '' OR subject_id = $2)
+        ORDER BY subject_id ASC, asset ASC
+    `
+
+	return listSpendingPolicies(ctx, r.db, query, scope, subjectID)
+}
+
+func (r *PostgresSpendingRepository) ListApplicable(ctx context.Context, walletID uuid.UUID, userID string) ([]*spending.Policy, error) {
+	return listApplicablePolicies(ctx, r.db, walletID, userID)
+}
+
+func (r *PostgresSpendingRepository) Usage(ctx context.Context, p *spending.Policy, now time.Time) (spending.Usage, error) {
+	return spendingUsage(ctx, r.db, p, now, now.Add(-r.unattachedTTL))
+}
+
+func (r *PostgresSpendingRepository) Reserve(ctx context.Context, spend *spending.Spend) (*spending.Violation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Payments from the same wallet or by the same user wait for each other here. Wallets
+	// are always locked before users, so two reservations cannot deadlock.
+	locks := []string{"spending:wallet:" + spend.WalletID.String()}
+	if spend.UserID != "" {
+		locks = append(locks, "spending:user:"+spend.UserID)
+	}
+	for _, key := range locks {
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, key); err != nil {
+			return nil, fmt.Errorf("failed to lock spending: %w", err)
+		}
+	}
+
+	policies, err := listApplicablePolicies(ctx, tx, spend.WalletID, spend.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range policies {
+		if !p.Applies(spend) {
+			continue
+		}
+		usage, err := spendingUsage(ctx, tx, p, spend.CreatedAt, spend.CreatedAt.Add(-r.unattachedTTL))
+		if err != nil {
+			return nil, err
+		}
+		if violation := p.Check(spend, usage); violation != nil {
+			return violation, nil
+		}
+	}
+
+	query := `
+        INSERT INTO spending_records (id, wallet_id, user_id, asset, amount, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+    `
+
+	if _, err := tx.ExecContext(ctx, query, spend.ID, spend.WalletID, spend.UserID, spend.Asset, spend.Amount, spend.CreatedAt); err != nil {
+		return nil, fmt.Errorf("failed to save spending record: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit spending record: %w", err)
+	}
+
+	return nil, nil
+}
+
+func (r *PostgresSpendingRepository) Attach(ctx context.Context, spendID uuid.UUID, transactionHash string) error {
+	query := `UPDATE spending_records SET transaction_hash = $2 WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, spendID, transactionHash); err != nil {
+		return fmt.Errorf("failed to attach transaction to spending record: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresSpendingRepository) Release(ctx context.Context, spendID uuid.UUID) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM spending_records WHERE id = $1`, spendID); err != nil {
+		return fmt.Errorf("failed to release spending record: %w", err)
+	}
+
+	return nil
+}
+
+func listApplicablePolicies(ctx context.Context, db querier, walletID uuid.UUID, userID string) ([]*spending.Policy, error) {
+	query := `
+        SELECT ` + spendingPolicyColumns + `
+        FROM spending_policies
+        WHERE (scope = 'wallet' AND subject_id = $1) OR (scope = 'user' AND $2 <> '' AND subject_id = $2)
+        ORDER BY scope DESC, asset ASC
+    `
+
+	return listSpendingPolicies(ctx, db, query, walletID.String(), userID)
+}
+
+func listSpendingPolicies(ctx context.Context, db querier, query string, args ...interface{}) ([]*spending.Policy, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list spending policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := make([]*spending.Policy, 0)
+	for rows.Next() {
+		p, err := scanSpendingPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+
+	return policies, rows.Err()
+}
+
+// spendingUsage sums the records a policy counts. Records of submissions that failed or
+// expired are left out; records without a submission, whether attached to a transaction
+// or not, count until unattachedSince, after which their payment can no longer be submitted.
+func spendingUsage(ctx context.Context, db querier, p *spending.Policy, now, unattachedSince time.Time) (spending.Usage, error) {
+	column := "r.wallet_id"
+	if p.Scope == spending.ScopeUser {
+		column = "r.user_id"
+	}
+
+	day, month, window := p.Periods(now)
+	since := month
+	if window.Before(since) {
+		since = window
+	}
+
+	query := `
+        SELECT
+            COALESCE(SUM(r.amount) FILTER (WHERE r.created_at >= $3), 0),
+            COALESCE(SUM(r.amount) FILTER (WHERE r.created_at >= $4), 0),
+            COUNT(*) FILTER (WHERE r.created_at >= $5)
+        FROM spending_records r
+        LEFT JOIN transaction_submissions s ON s.hash = r.transaction_hash
+        WHERE ` + column + ` = $1
+            AND ($2 = '' OR r.asset = $2)
+            AND r.created_at >= $6
+            AND (s.hash IS NOT NULL OR r.created_at >= $7)
+            AND (s.status IS NULL OR s.status IN ('pending', 'success'))
+    `
+
+	var usage spending.Usage
+	err := db.QueryRowContext(ctx, query, p.SubjectID, p.Asset, day, month, window, since, unattachedSince).
+		Scan(&usage.Daily, &usage.Monthly, &usage.Count)
+	if err != nil {
+		return spending.Usage{}, fmt.Errorf("failed to sum spending: %w", err)
+	}
+
+	return usage, nil
+}
+
+func scanSpendingPolicy(row rowScanner) (*spending.Policy, error) {
+	p := &spending.Policy{}
+	var windowSeconds int64
+
+	err := row.Scan(
+		&p.ID,
+		&p.Scope,
+		&p.SubjectID,
+		&p.Asset,
+		&p.MaxPerTransaction,
+		&p.DailyLimit,
+		&p.MonthlyLimit,
+		&p.MaxCount,
+		&windowSeconds,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.CountWindow = time.Duration(windowSeconds) * time.Second
+
+	return p, nil
+}
@@ -0,0 +1,216 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"quasarflow-api/internal/domain/sponsorship"
+
+	"github.com/google/uuid"
+)
+
+const sponsorshipColumns = `id, type, sponsor_wallet_id, sponsor_public_key, sponsored_wallet_id, sponsored_public_key,
+        asset_code, asset_issuer, status, replaced_by_id, created_at, updated_at, ended_at`
+
+type PostgresSponsorshipRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSponsorshipRepository(db *sql.DB) *PostgresSponsorshipRepository {
+	return &PostgresSponsorshipRepository{db: db}
+}
+
+func (r *PostgresSponsorshipRepository) Create(ctx context.Context, s *sponsorship.Sponsorship) error {
+	if err := insertSponsorship(ctx, r.db, s); err != nil {
+		return fmt.Errorf("failed to create sponsorship: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresSponsorshipRepository) Update(ctx context.Context, s *sponsorship.Sponsorship) error {
+	if err := updateSponsorship(ctx, r.db, s); err != nil {
+		return fmt.Errorf("failed to update sponsorship: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresSponsorshipRepository) FindByID(ctx context.Context, id uuid.UUID) (*sponsorship.Sponsorship, error) {
+	query := `SELECT ` + sponsorshipColumns + ` FROM sponsorships WHERE id = $1`
+
+	return r.find(ctx, query, id)
+}
+
+func (r *PostgresSponsorshipRepository) FindActive(ctx context.Context, sponsorshipType, sponsoredPublicKey, assetCode, assetIssuer string) (*sponsorship.Sponsorship, error) {
+	query := `
+        SELECT ` + sponsorshipColumns + `
+        FROM sponsorships
+        WHERE type = $1 AND sponsored_public_key = $2 AND asset_code = $3 AND asset_issuer = $4
+          AND status = 'active'
+    `
+
+	return r.find(ctx, query, sponsorshipType, sponsoredPublicKey, assetCode, assetIssuer)
+}
+
+func (r *PostgresSponsorshipRepository) ListByWallet(ctx context.Context, walletID uuid.UUID, role string, status sponsorship.Status, limit, offset int) ([]*sponsorship.Sponsorship, error) {
+	query := `
+        SELECT ` + sponsorshipColumns + `
+        FROM sponsorships
+        WHERE ` + roleColumn(role) + ` = $1 AND ($2 = '' OR status = $2)
+        ORDER BY created_at DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, walletID, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sponsorships: %w", err)
+	}
+	defer rows.Close()
+
+	sponsorships := make([]*sponsorship.Sponsorship, 0)
+	for rows.Next() {
+		s, err := scanSponsorship(rows)
+		if err != nil {
+			return nil, err
+		}
+		sponsorships = append(sponsorships, s)
+	}
+
+	return sponsorships, rows.Err()
+}
+
+func (r *PostgresSponsorshipRepository) CountByWallet(ctx context.Context, walletID uuid.UUID, role string, status sponsorship.Status) (int64, error) {
+	query := `SELECT COUNT(*) FROM sponsorships WHERE ` + roleColumn(role) + ` = $1 AND ($2 = '' OR status = $2)`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, walletID, status).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count sponsorships: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PostgresSponsorshipRepository) Transfer(ctx context.Context, ended *sponsorship.Sponsorship, replacement *sponsorship.Sponsorship) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The ended row must leave the active index before the replacement is inserted,
+	// and the replacement must exist before the ended row can reference it
+	replacedBy := ended.ReplacedByID
+	ended.ReplacedByID = nil
+	err = updateSponsorship(ctx, tx, ended)
+	ended.ReplacedByID = replacedBy
+	if err != nil {
+		return fmt.Errorf("failed to end sponsorship: %w", err)
+	}
+
+	if err := insertSponsorship(ctx, tx, replacement); err != nil {
+		return fmt.Errorf("failed to create replacement sponsorship: %w", err)
+	}
+
+	if err := updateSponsorship(ctx, tx, ended); err != nil {
+		return fmt.Errorf("failed to link replacement sponsorship: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit sponsorship transfer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresSponsorshipRepository) find(ctx context.Context, query string, args ...interface{}) (*sponsorship.Sponsorship, error) {
+	s, err := scanSponsorship(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("sponsorship not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sponsorship: %w", err)
+	}
+
+	return s, nil
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func insertSponsorship(ctx context.Context, db execer, s *sponsorship.Sponsorship) error {
+	query := `
+        INSERT INTO sponsorships (` + sponsorshipColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+    `
+
+	_, err := db.ExecContext(ctx, query,
+		s.ID,
+		s.Type,
+		s.SponsorWalletID,
+		s.SponsorPublicKey,
+		s.SponsoredWalletID,
+		s.SponsoredPublicKey,
+		s.AssetCode,
+		s.AssetIssuer,
+		s.Status,
+		s.ReplacedByID,
+		s.CreatedAt,
+		s.UpdatedAt,
+		s.EndedAt,
+	)
+	return err
+}
+
+func updateSponsorship(ctx context.Context, db execer, s *sponsorship.Sponsorship) error {
+	query := `
+        UPDATE sponsorships
+        SET status = $2, replaced_by_id = $3, updated_at = $4, ended_at = $5
+        WHERE id = $1
+    `
+
+	_, err := db.ExecContext(ctx, query,
+		s.ID,
+		s.Status,
+		s.ReplacedByID,
+		s.UpdatedAt,
+		s.EndedAt,
+	)
+	return err
+}
+
+// roleColumn maps a wallet role to the column it is matched against
+func roleColumn(role string) string {
+	if role == sponsorship.RoleSponsored {
+		return "sponsored_wallet_id"
+	}
+	return "sponsor_wallet_id"
+}
+
+func scanSponsorship(row rowScanner) (*sponsorship.Sponsorship, error) {
+	s := &sponsorship.Sponsorship{}
+	err := row.Scan(
+		&s.ID,
+		&s.Type,
+		&s.SponsorWalletID,
+		&s.SponsorPublicKey,
+		&s.SponsoredWalletID,
+		&s.SponsoredPublicKey,
+		&s.AssetCode,
+		&s.AssetIssuer,
+		&s.Status,
+		&s.ReplacedByID,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+		&s.EndedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type PostgresStreamCursorRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresStreamCursorRepository(db *sql.DB) *PostgresStreamCursorRepository {
+	return &PostgresStreamCursorRepository{db: db}
+}
+
+func (r *PostgresStreamCursorRepository) GetCursor(ctx context.Context, walletID uuid.UUID) (string, error) {
+	query := `SELECT cursor FROM stream_cursors WHERE wallet_id = $1`
+
+	var cursor string
+	err := r.db.QueryRowContext(ctx, query, walletID).Scan(&cursor)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get stream cursor: %w", err)
+	}
+
+	return cursor, nil
+}
+
+func (r *PostgresStreamCursorRepository) SaveCursor(ctx context.Context, walletID uuid.UUID, cursor string) error {
+	query := `
+        INSERT INTO stream_cursors (wallet_id, cursor, updated_at)
+        VALUES ($1, $2, NOW())
+        ON CONFLICT (wallet_id) DO UPDATE SET cursor = EXCLUDED.cursor, updated_at = EXCLUDED.updated_at
+    `
+
+	if _, err := r.db.ExecContext(ctx, query, walletID, cursor); err != nil {
+		return fmt.Errorf("failed to save stream cursor: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/submission"
+
+	"github.com/google/uuid"
+)
+
+const submissionColumns = `id, wallet_id, kind, hash, envelope_xdr, status, valid_until,
+        min_ledger, max_ledger, ledger, result_code, error_message, offer_id, created_at, updated_at`
+
+type PostgresSubmissionRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSubmissionRepository(db *sql.DB) *PostgresSubmissionRepository {
+	return &PostgresSubmissionRepository{db: db}
+}
+
+func (r *PostgresSubmissionRepository) Create(ctx context.Context, s *submission.Submission) error {
+	query := `
+        INSERT INTO transaction_submissions (` + submissionColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+    `
+
+	_, err := r.db.ExecContext(ctx, query,
+		s.ID,
+		s.WalletID,
+		s.Kind,
+		s.Hash,
+		s.EnvelopeXDR,
+		s.Status,
+		s.ValidUntil,
+		s.MinLedger,
+		s.MaxLedger,
+		s.Ledger,
+		s.ResultCode,
+		s.ErrorMessage,
+		s.OfferID,
+		s.CreatedAt,
+		s.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create submission: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresSubmissionRepository) Update(ctx context.Context, s *submission.Submission) error {
+	query := `
+        UPDATE transaction_submissions
+        SET status = $2, ledger = $3, result_code = $4, error_message = $5, offer_id = $6, updated_at = $7
+        WHERE id = $1
+    `
+
+	_, err := r.db.ExecContext(ctx, query,
+		s.ID,
+		s.Status,
+		s.Ledger,
+		s.ResultCode,
+		s.ErrorMessage,
+		s.OfferID,
+		s.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update submission: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresSubmissionRepository) FindByID(ctx context.Context, id uuid.UUID) (*submission.Submission, error) {
+	query := `SELECT ` + submissionColumns + ` FROM transaction_submissions WHERE id = $1`
+
+	s, err := scanSubmission(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("submission not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find submission: %w", err)
+	}
+
+	return s, nil
+}
+
+func (r *PostgresSubmissionRepository) FindByHash(ctx context.Context, hash string) (*submission.Submission, error) {
+	query := `SELECT ` + submissionColumns + ` FROM transaction_submissions WHERE hash = $1`
+
+	s, err := scanSubmission(r.db.QueryRowContext(ctx, query, hash))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find submission: %w", err)
+	}
+
+	return s, nil
+}
+
+func (r *PostgresSubmissionRepository) ListByWallet(ctx context.Context, walletID uuid.UUID, limit, offset int) ([]*submission.Submission, error) {
+	query := `
+        SELECT ` + submissionColumns + `
+        FROM transaction_submissions
+        WHERE wallet_id = $1
+        ORDER BY created_at DESC
+        LIMIT $2 OFFSET $3
+    `
+
+	return r.list(ctx, query, walletID, limit, offset)
+}
+
+func (r *PostgresSubmissionRepository) CountByWallet(ctx context.Context, walletID uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM transaction_submissions WHERE wallet_id = $1`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, walletID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count submissions: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PostgresSubmissionRepository) ListByOffer(ctx context.Context, walletID uuid.UUID, offerID int64) ([]*submission.Submission, error) {
+	query := `
+        SELECT ` + submissionColumns + `
+        FROM transaction_submissions
+        WHERE wallet_id = $1 AND offer_id = $2
+        ORDER BY created_at DESC
+    `
+
+	return r.list(ctx, query, walletID, offerID)
+}
+
+func (r *PostgresSubmissionRepository) ListPendingPast(ctx context.Context, before time.Time, ledger uint32, limit int) ([]*submission.Submission, error) {
+	query := `
+        SELECT ` + submissionColumns + `
+        FROM transaction_submissions
+        WHERE status = $1
+          AND (valid_until < $2 OR (max_ledger <> 0 AND max_ledger <= $3))
+        ORDER BY valid_until ASC
+        LIMIT $4
+    `
+
+	return r.list(ctx, query, submission.StatusPending, before, int64(ledger), limit)
+}
+
+func (r *PostgresSubmissionRepository) ListSettledSince(ctx context.Context, walletID uuid.UUID, since time.Time) ([]*submission.Submission, error) {
+	query := `
+        SELECT ` + submissionColumns + `
+        FROM transaction_submissions
+        WHERE wallet_id = $1 AND status <> $2 AND created_at >= $3
+        ORDER BY created_at ASC
+    `
+
+	return r.list(ctx, query, walletID, submission.StatusPending, since)
+}
+
+func (r *PostgresSubmissionRepository) list(ctx context.Context, query string, args ...interface{}) ([]*submission.Submission, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list submissions: %w", err)
+	}
+	defer rows.Close()
+
+	submissions := make([]*submission.Submission, 0)
+	for rows.Next() {
+		s, err := scanSubmission(rows)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, s)
+	}
+
+	return submissions, rows.Err()
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSubmission(row rowScanner) (*submission.Submission, error) {
+	s := &submission.Submission{}
+	err := row.Scan(
+		&s.ID,
+		&s.WalletID,
+		&s.Kind,
+		&s.Hash,
+		&s.EnvelopeXDR,
+		&s.Status,
+		&s.ValidUntil,
+		&s.MinLedger,
+		&s.MaxLedger,
+		&s.Ledger,
+		&s.ResultCode,
+		&s.ErrorMessage,
+		&s.OfferID,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/google/uuid"
 )
 
+const walletColumns = `id, public_key, encrypted_key, network, status, closed_at, merged_into, created_at, updated_at`
+
 type PostgresWalletRepository struct {
 	db *sql.DB
 }
@@ -20,8 +22,8 @@ func NewPostgresWalletRepository(db *sql.DB) *PostgresWalletRepository {
 
 func (r *PostgresWalletRepository) Create(ctx context.Context, w *wallet.Wallet) error {
 	query := `
-        INSERT INTO wallets (id, public_key, encrypted_key, network, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6)
+        INSERT INTO wallets (` + walletColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
     `
 
 	_, err := r.db.ExecContext(ctx, query,
@@ -29,6 +31,9 @@ func (r *PostgresWalletRepository) Create(ctx context.Context, w *wallet.Wallet)
 		w.PublicKey,
 		w.EncryptedKey,
 		w.Network,
+		w.Status,
+		w.ClosedAt,
+		w.MergedInto,
 		w.CreatedAt,
 		w.UpdatedAt,
 	)
@@ -40,9 +45,31 @@ func (r *PostgresWalletRepository) Create(ctx context.Context, w *wallet.Wallet)
 	return nil
 }
 
+func (r *PostgresWalletRepository) Update(ctx context.Context, w *wallet.Wallet) error {
+	query := `
+        UPDATE wallets
+        SET status = $2, closed_at = $3, merged_into = $4, updated_at = $5
+        WHERE id = $1
+    `
+
+	_, err := r.db.ExecContext(ctx, query,
+		w.ID,
+		w.Status,
+		w.ClosedAt,
+		w.MergedInto,
+		w.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update wallet: %w", err)
+	}
+
+	return nil
+}
+
 func (r *PostgresWalletRepository) FindByID(ctx context.Context, id uuid.UUID) (*wallet.Wallet, error) {
 	query := `
-        SELECT id, public_key, encrypted_key, network, created_at, updated_at
+        SELECT ` + walletColumns + `
         FROM wallets
         WHERE id = $1
     `
@@ -53,6 +80,9 @@ func (r *PostgresWalletRepository) FindByID(ctx context.Context, id uuid.UUID) (
 		&w.PublicKey,
 		&w.EncryptedKey,
 		&w.Network,
+		&w.Status,
+		&w.ClosedAt,
+		&w.MergedInto,
 		&w.CreatedAt,
 		&w.UpdatedAt,
 	)
@@ -70,7 +100,7 @@ func (r *PostgresWalletRepository) FindByID(ctx context.Context, id uuid.UUID) (
 
 func (r *PostgresWalletRepository) List(ctx context.Context, limit, offset int) ([]*wallet.Wallet, error) {
 	query := `
-        SELECT id, public_key, encrypted_key, network, created_at, updated_at
+        SELECT ` + walletColumns + `
         FROM wallets
         ORDER BY created_at DESC
         LIMIT $1 OFFSET $2
@@ -90,6 +120,45 @@ func (r *PostgresWalletRepository) List(ctx context.Context, limit, offset int)
 			&w.PublicKey,
 			&w.EncryptedKey,
 			&w.Network,
+			&w.Status,
+			&w.ClosedAt,
+			&w.MergedInto,
+			&w.CreatedAt,
+			&w.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		wallets = append(wallets, w)
+	}
+
+	return wallets, nil
+}
+
+func (r *PostgresWalletRepository) ListActive(ctx context.Context, network string) ([]*wallet.Wallet, error) {
+	query := `
+        SELECT ` + walletColumns + `
+        FROM wallets
+        WHERE network = $1 AND status = 'active'
+        ORDER BY created_at ASC
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active wallets: %w", err)
+	}
+	defer rows.Close()
+
+	wallets := make([]*wallet.Wallet, 0)
+	for rows.Next() {
+		w := &wallet.Wallet{}
+		if err := rows.Scan(
+			&w.ID,
+			&w.PublicKey,
+			&w.EncryptedKey,
+			&w.Network,
+			&w.Status,
+			&w.ClosedAt,
+			&w.MergedInto,
 			&w.CreatedAt,
 			&w.UpdatedAt,
 		); err != nil {
@@ -103,7 +172,7 @@ func (r *PostgresWalletRepository) List(ctx context.Context, limit, offset int)
 
 func (r *PostgresWalletRepository) FindByPublicKey(ctx context.Context, publicKey string) (*wallet.Wallet, error) {
 	query := `
-        SELECT id, public_key, encrypted_key, network, created_at, updated_at
+        SELECT ` + walletColumns + `
         FROM wallets
         WHERE public_key = $1
     `
@@ -114,6 +183,9 @@ func (r *PostgresWalletRepository) FindByPublicKey(ctx context.Context, publicKe
 		&w.PublicKey,
 		&w.EncryptedKey,
 		&w.Network,
+		&w.Status,
+		&w.ClosedAt,
+		&w.MergedInto,
 		&w.CreatedAt,
 		&w.UpdatedAt,
 	)
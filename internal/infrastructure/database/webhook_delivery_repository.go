@@ -0,0 +1,260 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+const webhookDeliveryColumns = `id, endpoint_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+        last_status_code, last_error, created_at, updated_at, delivered_at`
+
+const webhookAttemptColumns = `id, delivery_id, number, status_code, error, response_body, duration_ms, created_at`
+
+type PostgresWebhookDeliveryRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresWebhookDeliveryRepository(db *sql.DB) *PostgresWebhookDeliveryRepository {
+	return &PostgresWebhookDeliveryRepository{db: db}
+}
+
+func (r *PostgresWebhookDeliveryRepository) Enqueue(ctx context.Context, d *webhook.Delivery) (bool, error) {
+	query := `
+        INSERT INTO webhook_deliveries (` + webhookDeliveryColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+        ON CONFLICT (endpoint_id, event_id) DO NOTHING
+    `
+
+	result, err := r.db.ExecContext(ctx, query,
+		d.ID,
+		d.EndpointID,
+		d.EventID,
+		d.EventType,
+		d.Payload,
+		d.Status,
+		d.Attempts,
+		d.NextAttemptAt,
+		d.LastStatusCode,
+		d.LastError,
+		d.CreatedAt,
+		d.UpdatedAt,
+		d.DeliveredAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+	}
+
+	return inserted > 0, nil
+}
+
+func (r *PostgresWebhookDeliveryRepository) Update(ctx context.Context, d *webhook.Delivery) error {
+	if err := updateWebhookDelivery(ctx, r.db, d); err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookDeliveryRepository) FindByID(ctx context.Context, id uuid.UUID) (*webhook.Delivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries WHERE id = $1`
+
+	d, err := scanWebhookDelivery(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("webhook delivery not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook delivery: %w", err)
+	}
+
+	return d, nil
+}
+
+func (r *PostgresWebhookDeliveryRepository) ListByEndpoint(ctx context.Context, endpointID uuid.UUID, status webhook.DeliveryStatus, limit, offset int) ([]*webhook.Delivery, error) {
+	query := `
+        SELECT ` + webhookDeliveryColumns + `
+        FROM webhook_deliveries
+        WHERE endpoint_id = $1 AND ($2 = '' OR status = $2)
+        ORDER BY created_at DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	return r.list(ctx, query, endpointID, status, limit, offset)
+}
+
+func (r *PostgresWebhookDeliveryRepository) CountByEndpoint(ctx context.Context, endpointID uuid.UUID, status webhook.DeliveryStatus) (int64, error) {
+	query := `SELECT COUNT(*) FROM webhook_deliveries WHERE endpoint_id = $1 AND ($2 = '' OR status = $2)`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, endpointID, status).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PostgresWebhookDeliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*webhook.Delivery, error) {
+	query := `
+        UPDATE webhook_deliveries
+        SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+        WHERE id IN (
+            SELECT id FROM webhook_deliveries
+            WHERE status = 'pending' AND next_attempt_at <= NOW()
+            ORDER BY next_attempt_at ASC
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING ` + webhookDeliveryColumns
+
+	return r.list(ctx, query, limit, lease.Milliseconds())
+}
+
+func (r *PostgresWebhookDeliveryRepository) RecordAttempt(ctx context.Context, d *webhook.Delivery, a *webhook.Attempt) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := updateWebhookDelivery(ctx, tx, d); err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	query := `
+        INSERT INTO webhook_delivery_attempts (` + webhookAttemptColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `
+
+	_, err = tx.ExecContext(ctx, query,
+		a.ID,
+		a.DeliveryID,
+		a.Number,
+		a.StatusCode,
+		a.Error,
+		a.ResponseBody,
+		a.Duration.Milliseconds(),
+		a.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook attempt: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit webhook attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookDeliveryRepository) ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]*webhook.Attempt, error) {
+	query := `
+        SELECT ` + webhookAttemptColumns + `
+        FROM webhook_delivery_attempts
+        WHERE delivery_id = $1
+        ORDER BY number ASC
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook attempts: %w", err)
+	}
+	defer rows.Close()
+
+	attempts := make([]*webhook.Attempt, 0)
+	for rows.Next() {
+		a := &webhook.Attempt{}
+		var durationMs int64
+		if err := rows.Scan(
+			&a.ID,
+			&a.DeliveryID,
+			&a.Number,
+			&a.StatusCode,
+			&a.Error,
+			&a.ResponseBody,
+			&durationMs,
+			&a.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		a.Duration = time.Duration(durationMs) * time.Millisecond
+		attempts = append(attempts, a)
+	}
+
+	return attempts, rows.Err()
+}
+
+func (r *PostgresWebhookDeliveryRepository) list(ctx context.Context, query string, args ...interface{}) ([]*webhook.Delivery, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*webhook.Delivery, 0)
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func updateWebhookDelivery(ctx context.Context, db execer, d *webhook.Delivery) error {
+	query := `
+        UPDATE webhook_deliveries
+        SET status = $2, attempts = $3, next_attempt_at = $4, last_status_code = $5, last_error = $6,
+            updated_at = $7, delivered_at = $8
+        WHERE id = $1
+    `
+
+	_, err := db.ExecContext(ctx, query,
+		d.ID,
+		d.Status,
+		d.Attempts,
+		d.NextAttemptAt,
+		d.LastStatusCode,
+		d.LastError,
+		d.UpdatedAt,
+		d.DeliveredAt,
+	)
+	return err
+}
+
+func scanWebhookDelivery(row rowScanner) (*webhook.Delivery, error) {
+	d := &webhook.Delivery{}
+
+	err := row.Scan(
+		&d.ID,
+		&d.EndpointID,
+		&d.EventID,
+		&d.EventType,
+		&d.Payload,
+		&d.Status,
+		&d.Attempts,
+		&d.NextAttemptAt,
+		&d.LastStatusCode,
+		&d.LastError,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+		&d.DeliveredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,203 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/webhook"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const webhookEndpointColumns = `id, owner_id, url, description, event_types, wallet_ids, encrypted_secret, active, created_at, updated_at`
+
+type PostgresWebhookEndpointRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresWebhookEndpointRepository(db *sql.DB) *PostgresWebhookEndpointRepository {
+	return &PostgresWebhookEndpointRepository{db: db}
+}
+
+func (r *PostgresWebhookEndpointRepository) Create(ctx context.Context, e *webhook.Endpoint) error {
+	query := `
+        INSERT INTO webhook_endpoints (` + webhookEndpointColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `
+
+	_, err := r.db.ExecContext(ctx, query,
+		e.ID,
+		e.OwnerID,
+		e.URL,
+		e.Description,
+		pq.Array(eventTypeStrings(e.EventTypes)),
+		pq.Array(uuidStrings(e.WalletIDs)),
+		e.EncryptedSecret,
+		e.Active,
+		e.CreatedAt,
+		e.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookEndpointRepository) Update(ctx context.Context, e *webhook.Endpoint) error {
+	query := `
+        UPDATE webhook_endpoints
+        SET url = $2, description = $3, event_types = $4, wallet_ids = $5, encrypted_secret = $6, active = $7, updated_at = $8
+        WHERE id = $1
+    `
+
+	_, err := r.db.ExecContext(ctx, query,
+		e.ID,
+		e.URL,
+		e.Description,
+		pq.Array(eventTypeStrings(e.EventTypes)),
+		pq.Array(uuidStrings(e.WalletIDs)),
+		e.EncryptedSecret,
+		e.Active,
+		e.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the endpoint together with its deliveries and their logs
+func (r *PostgresWebhookEndpointRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM webhook_endpoints WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookEndpointRepository) FindByID(ctx context.Context, id uuid.UUID) (*webhook.Endpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + ` FROM webhook_endpoints WHERE id = $1`
+
+	e, err := scanWebhookEndpoint(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("webhook endpoint not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook endpoint: %w", err)
+	}
+
+	return e, nil
+}
+
+func (r *PostgresWebhookEndpointRepository) ListByOwner(ctx context.Context, ownerID string, limit, offset int) ([]*webhook.Endpoint, error) {
+	query := `
+        SELECT ` + webhookEndpointColumns + `
+        FROM webhook_endpoints
+        WHERE owner_id = $1
+        ORDER BY created_at DESC
+        LIMIT $2 OFFSET $3
+    `
+
+	return r.list(ctx, query, ownerID, limit, offset)
+}
+
+func (r *PostgresWebhookEndpointRepository) CountByOwner(ctx context.Context, ownerID string) (int64, error) {
+	query := `SELECT COUNT(*) FROM webhook_endpoints WHERE owner_id = $1`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, ownerID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count webhook endpoints: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PostgresWebhookEndpointRepository) ListSubscribed(ctx context.Context, eventType event.Type) ([]*webhook.Endpoint, error) {
+	query := `
+        SELECT ` + webhookEndpointColumns + `
+        FROM webhook_endpoints
+        WHERE active AND event_types @> ARRAY[$1]::TEXT[]
+    `
+
+	return r.list(ctx, query, string(eventType))
+}
+
+func (r *PostgresWebhookEndpointRepository) list(ctx context.Context, query string, args ...interface{}) ([]*webhook.Endpoint, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	endpoints := make([]*webhook.Endpoint, 0)
+	for rows.Next() {
+		e, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, e)
+	}
+
+	return endpoints, rows.Err()
+}
+
+func scanWebhookEndpoint(row rowScanner) (*webhook.Endpoint, error) {
+	e := &webhook.Endpoint{}
+	var eventTypes, walletIDs []string
+
+	err := row.Scan(
+		&e.ID,
+		&e.OwnerID,
+		&e.URL,
+		&e.Description,
+		pq.Array(&eventTypes),
+		pq.Array(&walletIDs),
+		&e.EncryptedSecret,
+		&e.Active,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e.EventTypes = make([]event.Type, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		e.EventTypes = append(e.EventTypes, event.Type(t))
+	}
+
+	e.WalletIDs = make([]uuid.UUID, 0, len(walletIDs))
+	for _, id := range walletIDs {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid wallet id %q: %w", id, err)
+		}
+		e.WalletIDs = append(e.WalletIDs, parsed)
+	}
+
+	return e, nil
+}
+
+func eventTypeStrings(types []event.Type) []string {
+	values := make([]string, 0, len(types))
+	for _, t := range types {
+		values = append(values, string(t))
+	}
+	return values
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	return values
+}
@@ -0,0 +1,63 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/pkg/logger"
+)
+
+type subscription struct {
+	name    string
+	handler event.Handler
+}
+
+// Bus delivers events in-process to every subscriber, in subscription order
+type Bus struct {
+	mu            sync.RWMutex
+	subscriptions []subscription
+	logger        logger.Logger
+}
+
+func NewBus(logger logger.Logger) *Bus {
+	return &Bus{logger: logger}
+}
+
+// Subscribe registers a handler that receives every published event
+func (b *Bus) Subscribe(name string, handler event.Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subscriptions = append(b.subscriptions, subscription{name: name, handler: handler})
+}
+
+// Publish calls every subscriber synchronously. All subscribers are called even if
+// one fails; the first failure is returned so the producer can retry the event.
+func (b *Bus) Publish(ctx context.Context, e *event.Event) error {
+	b.mu.RLock()
+	subscriptions := b.subscriptions
+	b.mu.RUnlock()
+
+	b.logger.Info("event published",
+		logger.String("event_id", e.ID.String()),
+		logger.String("type", string(e.Type)),
+		logger.String("wallet_id", e.WalletID.String()),
+	)
+
+	var firstErr error
+	for _, s := range subscriptions {
+		if err := s.handler(ctx, e); err != nil {
+			b.logger.Error("event subscriber failed",
+				logger.String("subscriber", s.name),
+				logger.String("event_id", e.ID.String()),
+				logger.Error(err))
+			if firstErr == nil {
+				firstErr = fmt.Errorf("subscriber %s: %w", s.name, err)
+			}
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,84 @@
+package eventbus
+
+import (
+	"sync"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type listener struct {
+	events chan *event.Event
+}
+
+// Hub fans live events out to the listeners of each wallet. Broadcast never blocks:
+// a listener whose buffer is full is dropped and its channel closed, so a slow client
+// reconnects and replays from the event store instead of stalling the publisher.
+type Hub struct {
+	mu        sync.Mutex
+	listeners map[uuid.UUID]map[*listener]struct{}
+	buffer    int
+	logger    logger.Logger
+}
+
+func NewHub(buffer int, logger logger.Logger) *Hub {
+	return &Hub{
+		listeners: make(map[uuid.UUID]map[*listener]struct{}),
+		buffer:    buffer,
+		logger:    logger,
+	}
+}
+
+// Broadcast sends an event to every listener of its wallet
+func (h *Hub) Broadcast(e *event.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for l := range h.listeners[e.WalletID] {
+		select {
+		case l.events <- e:
+		default:
+			h.logger.Warn("dropping lagging stream listener",
+				logger.String("wallet_id", e.WalletID.String()),
+				logger.String("event_id", e.ID.String()))
+			h.remove(e.WalletID, l)
+		}
+	}
+}
+
+// Listen registers a listener for a wallet's events
+func (h *Hub) Listen(walletID uuid.UUID) (<-chan *event.Event, func()) {
+	l := &listener{events: make(chan *event.Event, h.buffer)}
+
+	h.mu.Lock()
+	if h.listeners[walletID] == nil {
+		h.listeners[walletID] = make(map[*listener]struct{})
+	}
+	h.listeners[walletID][l] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return l.events, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			h.remove(walletID, l)
+		})
+	}
+}
+
+// remove closes a listener's channel; the caller holds h.mu
+func (h *Hub) remove(walletID uuid.UUID, l *listener) {
+	listeners := h.listeners[walletID]
+	if _, ok := listeners[l]; !ok {
+		return
+	}
+
+	delete(listeners, l)
+	close(l.events)
+	if len(listeners) == 0 {
+		delete(h.listeners, walletID)
+	}
+}
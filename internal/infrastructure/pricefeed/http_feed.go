@@ -0,0 +1,100 @@
+package pricefeed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"quasarflow-api/internal/domain/pricing"
+
+	"github.com/shopspring/decimal"
+)
+
+// maxResponseBytes bounds the size of a price feed response
+const maxResponseBytes = 64 * 1024
+
+// feedResponse is the JSON document a price feed returns
+type feedResponse struct {
+	Price     string    `json:"price"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// HTTPFeed prices assets from an external HTTP price feed. It requests
+// {url}?asset={asset}&currency={currency}, where asset is "native" or "CODE:ISSUER",
+// and expects {"price": "1.0001", "timestamp": "2025-01-27T12:00:00Z"}.
+// A 404 response means the feed has no price for the pair.
+type HTTPFeed struct {
+	url        string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewHTTPFeed creates an external price feed; apiKey is sent as a bearer token when set
+func NewHTTPFeed(feedURL, apiKey string, timeout time.Duration) *HTTPFeed {
+	return &HTTPFeed{
+		url:        feedURL,
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Name identifies the source in logs
+func (f *HTTPFeed) Name() string {
+	return "external_feed"
+}
+
+// Quote fetches the price of asset in currency from the feed
+func (f *HTTPFeed) Quote(ctx context.Context, asset, currency string) (*pricing.Quote, error) {
+	query := url.Values{}
+	query.Set("asset", asset)
+	query.Set("currency", currency)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create price feed request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if f.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+f.apiKey)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query price feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price feed returned %s", resp.Status)
+	}
+
+	var body feedResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode price feed response: %w", err)
+	}
+
+	price, err := decimal.NewFromString(body.Price)
+	if err != nil || !price.IsPositive() {
+		return nil, fmt.Errorf("price feed returned invalid price %q", body.Price)
+	}
+
+	observedAt := body.Timestamp
+	if observedAt.IsZero() {
+		observedAt = time.Now()
+	}
+
+	return &pricing.Quote{
+		Asset:      asset,
+		Currency:   currency,
+		Price:      price,
+		Source:     pricing.SourceExternal,
+		ObservedAt: observedAt,
+	}, nil
+}
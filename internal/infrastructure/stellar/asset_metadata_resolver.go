@@ -0,0 +1,158 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"quasarflow-api/internal/domain/registry"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+const (
+	defaultAssetMetadataCacheTTL = time.Hour
+	defaultDisplayDecimals       = 7
+)
+
+type cachedToml struct {
+	toml      *StellarToml // nil when the domain has no usable stellar.toml
+	expiresAt time.Time
+}
+
+type cachedHomeDomain struct {
+	domain    string
+	expiresAt time.Time
+}
+
+// AssetMetadataResolver resolves SEP-1 asset metadata from the issuer's home domain.
+// Home domains and stellar.toml files are cached so balance requests rarely leave the process;
+// failed fetches are cached as well so an unreachable domain does not slow every request.
+type AssetMetadataResolver struct {
+	horizon  *horizonclient.Client
+	toml     *StellarTomlClient
+	cacheTTL time.Duration
+	logger   logger.Logger
+
+	mu          sync.Mutex
+	homeDomains map[string]cachedHomeDomain
+	tomls       map[string]cachedToml
+}
+
+// NewAssetMetadataResolver creates a new asset metadata resolver
+func NewAssetMetadataResolver(horizon *horizonclient.Client, toml *StellarTomlClient, cacheTTL time.Duration, logger logger.Logger) *AssetMetadataResolver {
+	if cacheTTL <= 0 {
+		cacheTTL = defaultAssetMetadataCacheTTL
+	}
+
+	return &AssetMetadataResolver{
+		horizon:     horizon,
+		toml:        toml,
+		cacheTTL:    cacheTTL,
+		logger:      logger,
+		homeDomains: map[string]cachedHomeDomain{},
+		tomls:       map[string]cachedToml{},
+	}
+}
+
+// Resolve returns the metadata the issuer publishes for code. An asset whose issuer has
+// no home domain, or whose stellar.toml does not list it, resolves with Listed false.
+func (r *AssetMetadataResolver) Resolve(ctx context.Context, code, issuer string) (*registry.AssetMetadata, error) {
+	metadata := &registry.AssetMetadata{
+		Code:            code,
+		Issuer:          issuer,
+		DisplayDecimals: defaultDisplayDecimals,
+		FetchedAt:       time.Now(),
+	}
+
+	domain, err := r.homeDomain(issuer)
+	if err != nil {
+		return nil, err
+	}
+	metadata.HomeDomain = domain
+	if domain == "" {
+		return metadata, nil
+	}
+
+	stellarToml := r.stellarToml(ctx, domain)
+	if stellarToml == nil {
+		return metadata, nil
+	}
+
+	metadata.OrgName = stellarToml.Documentation.OrgName
+
+	for _, currency := range stellarToml.Currencies {
+		if currency.Code != code || currency.Issuer != issuer {
+			continue
+		}
+
+		metadata.Listed = true
+		metadata.Name = currency.Name
+		metadata.Description = currency.Desc
+		metadata.Image = currency.Image
+		metadata.Status = currency.Status
+		metadata.IsAssetAnchored = currency.IsAssetAnchored
+		metadata.AnchorAssetType = currency.AnchorAssetType
+		metadata.AnchorAsset = currency.AnchorAsset
+		if d := currency.DisplayDecimals; d != nil && *d >= 0 && *d <= 7 {
+			metadata.DisplayDecimals = *d
+		}
+		break
+	}
+
+	return metadata, nil
+}
+
+// homeDomain returns the issuer account's home_domain
+func (r *AssetMetadataResolver) homeDomain(issuer string) (string, error) {
+	r.mu.Lock()
+	entry, ok := r.homeDomains[issuer]
+	r.mu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.domain, nil
+	}
+
+	account, err := r.horizon.AccountDetail(horizonclient.AccountRequest{AccountID: issuer})
+	if err != nil && !horizonclient.IsNotFoundError(err) {
+		return "", fmt.Errorf("failed to load issuer account: %w", err)
+	}
+
+	domain := strings.ToLower(account.HomeDomain)
+
+	r.mu.Lock()
+	r.homeDomains[issuer] = cachedHomeDomain{domain: domain, expiresAt: time.Now().Add(r.cacheTTL)}
+	r.mu.Unlock()
+
+	return domain, nil
+}
+
+// stellarToml returns the domain's parsed stellar.toml, or nil if it cannot be fetched
+func (r *AssetMetadataResolver) stellarToml(ctx context.Context, domain string) *StellarToml {
+	r.mu.Lock()
+	entry, ok := r.tomls[domain]
+	r.mu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.toml
+	}
+
+	stellarToml, err := r.toml.Fetch(ctx, domain)
+	if err != nil {
+		r.logger.Warn("failed to fetch stellar.toml for asset metadata",
+			logger.Error(err),
+			logger.String("domain", domain))
+		if ctx.Err() != nil {
+			// The caller gave up; do not remember the domain as unreachable
+			return nil
+		}
+		stellarToml = nil
+	}
+
+	r.mu.Lock()
+	r.tomls[domain] = cachedToml{toml: stellarToml, expiresAt: time.Now().Add(r.cacheTTL)}
+	r.mu.Unlock()
+
+	return stellarToml
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/pkg/errors"
 
 	"github.com/shopspring/decimal"
 	"github.com/stellar/go/clients/horizonclient"
@@ -36,10 +37,11 @@ func (c *Client) GetAccountBalances(ctx context.Context, publicKey string) ([]st
 	balances := make([]stellar.Balance, 0, len(account.Balances))
 	for _, b := range account.Balances {
 		balance := stellar.Balance{
-			AssetType:   b.Asset.Type,
-			AssetCode:   b.Asset.Code,
-			AssetIssuer: b.Asset.Issuer,
-			Amount:      decimal.RequireFromString(b.Balance),
+			AssetType:       b.Asset.Type,
+			AssetCode:       b.Asset.Code,
+			AssetIssuer:     b.Asset.Issuer,
+			LiquidityPoolID: b.LiquidityPoolId,
+			Amount:          decimal.RequireFromString(b.Balance),
 		}
 
 		if b.Limit != "" {
@@ -53,6 +55,33 @@ func (c *Client) GetAccountBalances(ctx context.Context, publicKey string) ([]st
 	return balances, nil
 }
 
+// GetLiquidityPool retrieves a liquidity pool and its reserves
+func (c *Client) GetLiquidityPool(ctx context.Context, poolID string) (*stellar.LiquidityPool, error) {
+	pool, err := c.horizon.LiquidityPoolDetail(horizonclient.LiquidityPoolRequest{LiquidityPoolID: poolID})
+	if err != nil {
+		if horizonclient.IsNotFoundError(err) {
+			return nil, errors.ErrLiquidityPoolNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch liquidity pool: %w", err)
+	}
+
+	reserves := make([]stellar.PoolReserve, 0, len(pool.Reserves))
+	for _, r := range pool.Reserves {
+		reserves = append(reserves, stellar.PoolReserve{
+			Asset:  r.Asset,
+			Amount: decimal.RequireFromString(r.Amount),
+		})
+	}
+
+	return &stellar.LiquidityPool{
+		ID:              pool.ID,
+		FeeBP:           pool.FeeBP,
+		TotalTrustlines: pool.TotalTrustlines,
+		TotalShares:     decimal.RequireFromString(pool.TotalShares),
+		Reserves:        reserves,
+	}, nil
+}
+
 func (c *Client) GetHorizonClient() *horizonclient.Client {
 	return c.horizon
 }
@@ -0,0 +1,212 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"quasarflow-api/internal/domain/pricing"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+const defaultMaxTradeAge = 24 * time.Hour
+
+// DEXPriceSource prices assets from the Stellar DEX. It tries, in order, the mid price
+// of the order book, the most recent trade and the reserves of the pair's liquidity pool.
+// Reference currencies map to on-ledger assets: XLM is native, USD is a configured anchor asset.
+type DEXPriceSource struct {
+	horizon     *horizonclient.Client
+	currencies  map[string]string // Reference currency to asset ("native" or "CODE:ISSUER")
+	maxTradeAge time.Duration     // Trades older than this are not used as a price
+	logger      logger.Logger
+}
+
+// NewDEXPriceSource creates a DEX price source; usdAsset is the "CODE:ISSUER" asset treated as USD
+func NewDEXPriceSource(horizon *horizonclient.Client, usdAsset string, maxTradeAge time.Duration, logger logger.Logger) *DEXPriceSource {
+	if maxTradeAge <= 0 {
+		maxTradeAge = defaultMaxTradeAge
+	}
+
+	currencies := map[string]string{pricing.CurrencyXLM: pricing.NativeAsset}
+	if usdAsset != "" {
+		currencies[pricing.CurrencyUSD] = usdAsset
+	}
+
+	return &DEXPriceSource{
+		horizon:     horizon,
+		currencies:  currencies,
+		maxTradeAge: maxTradeAge,
+		logger:      logger,
+	}
+}
+
+// Name identifies the source in logs
+func (s *DEXPriceSource) Name() string {
+	return "stellar_dex"
+}
+
+// Quote returns the price of asset in currency, or nil when the DEX has no usable market
+func (s *DEXPriceSource) Quote(ctx context.Context, asset, currency string) (*pricing.Quote, error) {
+	counter, ok := s.currencies[currency]
+	if !ok {
+		return nil, nil
+	}
+
+	if asset == counter {
+		return &pricing.Quote{Asset: asset, Currency: currency, Price: decimal.NewFromInt(1), Source: pricing.SourceOrderbook, ObservedAt: time.Now()}, nil
+	}
+
+	for _, fetch := range []func(asset, counter string) (decimal.Decimal, string, time.Time, error){
+		s.orderbookPrice,
+		s.tradePrice,
+		s.poolPrice,
+	} {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		price, source, observedAt, err := fetch(asset, counter)
+		if err != nil {
+			return nil, err
+		}
+		if price.IsPositive() {
+			return &pricing.Quote{Asset: asset, Currency: currency, Price: price, Source: source, ObservedAt: observedAt}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// orderbookPrice returns the mid price of the best bid and ask; one-sided books have no price
+func (s *DEXPriceSource) orderbookPrice(asset, counter string) (decimal.Decimal, string, time.Time, error) {
+	sellingType, sellingCode, sellingIssuer := horizonAsset(asset)
+	buyingType, buyingCode, buyingIssuer := horizonAsset(counter)
+
+	book, err := s.horizon.OrderBook(horizonclient.OrderBookRequest{
+		SellingAssetType:   sellingType,
+		SellingAssetCode:   sellingCode,
+		SellingAssetIssuer: sellingIssuer,
+		BuyingAssetType:    buyingType,
+		BuyingAssetCode:    buyingCode,
+		BuyingAssetIssuer:  buyingIssuer,
+		Limit:              1,
+	})
+	if err != nil {
+		return decimal.Zero, "", time.Time{}, fmt.Errorf("failed to fetch order book: %w", err)
+	}
+
+	if len(book.Bids) == 0 || len(book.Asks) == 0 {
+		return decimal.Zero, "", time.Time{}, nil
+	}
+
+	bid, errBid := decimal.NewFromString(book.Bids[0].Price)
+	ask, errAsk := decimal.NewFromString(book.Asks[0].Price)
+	if errBid != nil || errAsk != nil {
+		return decimal.Zero, "", time.Time{}, nil
+	}
+
+	return bid.Add(ask).Div(decimal.NewFromInt(2)), pricing.SourceOrderbook, time.Now(), nil
+}
+
+// tradePrice returns the price of the most recent trade if it is not too old
+func (s *DEXPriceSource) tradePrice(asset, counter string) (decimal.Decimal, string, time.Time, error) {
+	baseType, baseCode, baseIssuer := horizonAsset(asset)
+	counterType, counterCode, counterIssuer := horizonAsset(counter)
+
+	page, err := s.horizon.Trades(horizonclient.TradeRequest{
+		BaseAssetType:      baseType,
+		BaseAssetCode:      baseCode,
+		BaseAssetIssuer:    baseIssuer,
+		CounterAssetType:   counterType,
+		CounterAssetCode:   counterCode,
+		CounterAssetIssuer: counterIssuer,
+		Order:              horizonclient.OrderDesc,
+		Limit:              1,
+	})
+	if err != nil {
+		return decimal.Zero, "", time.Time{}, fmt.Errorf("failed to fetch trades: %w", err)
+	}
+
+	if len(page.Embedded.Records) == 0 {
+		return decimal.Zero, "", time.Time{}, nil
+	}
+
+	t := page.Embedded.Records[0]
+	if time.Since(t.LedgerCloseTime) > s.maxTradeAge {
+		return decimal.Zero, "", time.Time{}, nil
+	}
+
+	baseAmount, errBase := decimal.NewFromString(t.BaseAmount)
+	counterAmount, errCounter := decimal.NewFromString(t.CounterAmount)
+	if errBase != nil || errCounter != nil || !baseAmount.IsPositive() || !counterAmount.IsPositive() {
+		return decimal.Zero, "", time.Time{}, nil
+	}
+
+	// Horizon reports the pair as requested, but guard against a reversed record
+	if assetID(t.BaseAssetType, t.BaseAssetCode, t.BaseAssetIssuer) != asset {
+		return baseAmount.Div(counterAmount), pricing.SourceTrades, t.LedgerCloseTime, nil
+	}
+
+	return counterAmount.Div(baseAmount), pricing.SourceTrades, t.LedgerCloseTime, nil
+}
+
+// poolPrice returns the ratio of the reserves of the pair's constant product pool
+func (s *DEXPriceSource) poolPrice(asset, counter string) (decimal.Decimal, string, time.Time, error) {
+	page, err := s.horizon.LiquidityPools(horizonclient.LiquidityPoolsRequest{
+		Reserves: []string{asset, counter},
+		Limit:    1,
+	})
+	if err != nil {
+		return decimal.Zero, "", time.Time{}, fmt.Errorf("failed to fetch liquidity pools: %w", err)
+	}
+
+	if len(page.Embedded.Records) == 0 {
+		return decimal.Zero, "", time.Time{}, nil
+	}
+
+	pool := page.Embedded.Records[0]
+	var assetReserve, counterReserve decimal.Decimal
+	for _, r := range pool.Reserves {
+		amount, err := decimal.NewFromString(r.Amount)
+		if err != nil {
+			return decimal.Zero, "", time.Time{}, nil
+		}
+		switch r.Asset {
+		case asset:
+			assetReserve = amount
+		case counter:
+			counterReserve = amount
+		}
+	}
+
+	if !assetReserve.IsPositive() || !counterReserve.IsPositive() {
+		return decimal.Zero, "", time.Time{}, nil
+	}
+
+	return counterReserve.Div(assetReserve), pricing.SourcePool, time.Now(), nil
+}
+
+// horizonAsset splits an asset identifier into Horizon query parameters
+func horizonAsset(asset string) (horizonclient.AssetType, string, string) {
+	if asset == pricing.NativeAsset {
+		return horizonclient.AssetTypeNative, "", ""
+	}
+
+	code, issuer, _ := strings.Cut(asset, ":")
+	if len(code) <= 4 {
+		return horizonclient.AssetType4, code, issuer
+	}
+	return horizonclient.AssetType12, code, issuer
+}
+
+// assetID formats a Horizon asset as "native" or "CODE:ISSUER"
+func assetID(assetType, code, issuer string) string {
+	if assetType == "native" {
+		return pricing.NativeAsset
+	}
+	return code + ":" + issuer
+}
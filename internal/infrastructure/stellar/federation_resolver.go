@@ -0,0 +1,141 @@
+package stellar
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"quasarflow-api/internal/domain/federation"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+	"quasarflow-api/pkg/safehttp"
+
+	federationclient "github.com/stellar/go/clients/federation"
+	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/strkey"
+)
+
+const (
+	defaultFederationCacheTTL = 10 * time.Minute
+	// maxFederationCacheEntries bounds the cache, since addresses come from API callers
+	maxFederationCacheEntries = 10000
+)
+
+type cachedRecord struct {
+	record    *federation.Record
+	expiresAt time.Time
+}
+
+// FederationResolver resolves SEP-2 federation addresses with the SDK's federation
+// client, which looks up the domain's FEDERATION_SERVER in stellar.toml. Domains must be
+// public DNS names and requests only connect to public addresses. Results are cached, up
+// to maxFederationCacheEntries addresses.
+type FederationResolver struct {
+	toml     *StellarTomlClient
+	cacheTTL time.Duration
+	logger   logger.Logger
+
+	mu    sync.Mutex
+	cache map[string]cachedRecord
+}
+
+// NewFederationResolver creates a new federation resolver; requests use the stellar.toml
+// client's guarded HTTP client and timeout
+func NewFederationResolver(toml *StellarTomlClient, cacheTTL time.Duration, logger logger.Logger) *FederationResolver {
+	if cacheTTL <= 0 {
+		cacheTTL = defaultFederationCacheTTL
+	}
+
+	return &FederationResolver{
+		toml:     toml,
+		cacheTTL: cacheTTL,
+		logger:   logger,
+		cache:    map[string]cachedRecord{},
+	}
+}
+
+// Resolve resolves name*domain to an account ID and optional memo
+func (r *FederationResolver) Resolve(ctx context.Context, address string) (*federation.Record, error) {
+	_, domain, err := federation.SplitAddress(address)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid federation address", err.Error())
+	}
+	if err := safehttp.CheckHostname(domain); err != nil {
+		return nil, errors.NewValidationError("Invalid federation address", err.Error())
+	}
+
+	if record := r.cached(address); record != nil {
+		return record, nil
+	}
+
+	httpClient := r.toml.http(ctx)
+	client := &federationclient.Client{
+		HTTP:        httpClient,
+		StellarTOML: &stellartoml.Client{HTTP: httpClient},
+	}
+
+	resp, err := client.LookupByAddress(address)
+	if err != nil {
+		r.logger.Warn("failed to resolve federation address",
+			logger.Error(err),
+			logger.String("address", address))
+		return nil, errors.NewNotFoundError("Federation address could not be resolved: " + address)
+	}
+
+	if !strkey.IsValidEd25519PublicKey(resp.AccountID) {
+		r.logger.Warn("federation server returned an invalid account id",
+			logger.String("address", address),
+			logger.String("account_id", resp.AccountID))
+		return nil, errors.NewNotFoundError("Federation address could not be resolved: " + address)
+	}
+
+	record := &federation.Record{
+		StellarAddress: address,
+		AccountID:      resp.AccountID,
+		MemoType:       resp.MemoType,
+		Memo:           resp.Memo.String(),
+	}
+
+	r.store(address, record)
+
+	return record, nil
+}
+
+// store caches a record. When the cache is full, expired entries are dropped first and
+// then the entry closest to expiring, which is the oldest one since they share a TTL.
+func (r *FederationResolver) store(address string, record *federation.Record) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if _, ok := r.cache[address]; !ok && len(r.cache) >= maxFederationCacheEntries {
+		oldest := ""
+		for key, entry := range r.cache {
+			if now.After(entry.expiresAt) {
+				delete(r.cache, key)
+			} else if oldest == "" || entry.expiresAt.Before(r.cache[oldest].expiresAt) {
+				oldest = key
+			}
+		}
+		if len(r.cache) >= maxFederationCacheEntries {
+			delete(r.cache, oldest)
+		}
+	}
+
+	r.cache[address] = cachedRecord{record: record, expiresAt: now.Add(r.cacheTTL)}
+}
+
+func (r *FederationResolver) cached(address string) *federation.Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.cache[address]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(r.cache, address)
+		return nil
+	}
+	return entry.record
+}
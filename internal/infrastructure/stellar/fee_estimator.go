@@ -0,0 +1,163 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/txnbuild"
+)
+
+const (
+	defaultFeePercentile    = 70
+	defaultFeeStatsCacheTTL = 5 * time.Second
+	defaultMaxBaseFee       = 10000
+)
+
+// FeeEstimatorConfig configures how base fees are derived from Horizon fee stats
+type FeeEstimatorConfig struct {
+	Percentile int           // Percentile of recent fees to pay (10-99)
+	MaxBaseFee int64         // Default cap when a request does not supply one
+	CacheTTL   time.Duration // How long fee stats are reused before refetching
+}
+
+// FeeEstimator reads Horizon /fee_stats, caches the result and derives a base fee
+type FeeEstimator struct {
+	horizon *horizonclient.Client
+	config  FeeEstimatorConfig
+	logger  logger.Logger
+
+	mu    sync.Mutex
+	stats *stellar.FeeStats
+}
+
+// NewFeeEstimator creates a fee estimator, falling back to sane defaults for unset values
+func NewFeeEstimator(horizon *horizonclient.Client, config FeeEstimatorConfig, logger logger.Logger) *FeeEstimator {
+	if config.Percentile <= 0 || config.Percentile > 100 {
+		config.Percentile = defaultFeePercentile
+	}
+	if config.MaxBaseFee < txnbuild.MinBaseFee {
+		config.MaxBaseFee = defaultMaxBaseFee
+	}
+	if config.CacheTTL <= 0 {
+		config.CacheTTL = defaultFeeStatsCacheTTL
+	}
+
+	return &FeeEstimator{
+		horizon: horizon,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// EstimateFee returns the base fee to use for a new transaction.
+// The fee is the configured percentile of recently charged fees, never lower than
+// the network minimum and never higher than maxFee (or the configured cap when zero).
+func (e *FeeEstimator) EstimateFee(ctx context.Context, maxFee int64) (*stellar.FeeEstimate, error) {
+	if maxFee <= 0 {
+		maxFee = e.config.MaxBaseFee
+	}
+	if maxFee < txnbuild.MinBaseFee {
+		return nil, errors.ErrMaxFeeTooLow
+	}
+
+	stats, err := e.feeStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee := stats.FeeCharged.Percentile(e.config.Percentile)
+	if baseFee < stats.LastLedgerBaseFee {
+		baseFee = stats.LastLedgerBaseFee
+	}
+	if baseFee < txnbuild.MinBaseFee {
+		baseFee = txnbuild.MinBaseFee
+	}
+
+	capped := false
+	if baseFee > maxFee {
+		baseFee = maxFee
+		capped = true
+	}
+
+	return &stellar.FeeEstimate{
+		BaseFee:    baseFee,
+		Percentile: e.config.Percentile,
+		MaxFee:     maxFee,
+		Capped:     capped,
+		Stats:      *stats,
+	}, nil
+}
+
+// feeStats returns cached fee stats, refreshing them once the cache TTL has passed.
+// If Horizon is unreachable the last known stats are reused.
+func (e *FeeEstimator) feeStats(ctx context.Context) (*stellar.FeeStats, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.stats != nil && time.Since(e.stats.FetchedAt) < e.config.CacheTTL {
+		return e.stats, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	fs, err := e.horizon.FeeStats()
+	if err != nil {
+		if e.stats != nil {
+			e.logger.Warn("failed to refresh fee stats, using cached values",
+				logger.Error(err),
+				logger.String("fetched_at", e.stats.FetchedAt.Format(time.RFC3339)))
+			return e.stats, nil
+		}
+		return nil, fmt.Errorf("failed to fetch fee stats: %w", err)
+	}
+
+	e.stats = &stellar.FeeStats{
+		LastLedger:          fs.LastLedger,
+		LastLedgerBaseFee:   fs.LastLedgerBaseFee,
+		LedgerCapacityUsage: fs.LedgerCapacityUsage,
+		FeeCharged: stellar.FeeDistribution{
+			Min:  fs.FeeCharged.Min,
+			Mode: fs.FeeCharged.Mode,
+			P10:  fs.FeeCharged.P10,
+			P20:  fs.FeeCharged.P20,
+			P30:  fs.FeeCharged.P30,
+			P40:  fs.FeeCharged.P40,
+			P50:  fs.FeeCharged.P50,
+			P60:  fs.FeeCharged.P60,
+			P70:  fs.FeeCharged.P70,
+			P80:  fs.FeeCharged.P80,
+			P90:  fs.FeeCharged.P90,
+			P95:  fs.FeeCharged.P95,
+			P99:  fs.FeeCharged.P99,
+			Max:  fs.FeeCharged.Max,
+		},
+		MaxFee: stellar.FeeDistribution{
+			Min:  fs.MaxFee.Min,
+			Mode: fs.MaxFee.Mode,
+			P10:  fs.MaxFee.P10,
+			P20:  fs.MaxFee.P20,
+			P30:  fs.MaxFee.P30,
+			P40:  fs.MaxFee.P40,
+			P50:  fs.MaxFee.P50,
+			P60:  fs.MaxFee.P60,
+			P70:  fs.MaxFee.P70,
+			P80:  fs.MaxFee.P80,
+			P90:  fs.MaxFee.P90,
+			P95:  fs.MaxFee.P95,
+			P99:  fs.MaxFee.P99,
+			Max:  fs.MaxFee.Max,
+		},
+		FetchedAt: time.Now(),
+	}
+
+	return e.stats, nil
+}
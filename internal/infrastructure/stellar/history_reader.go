@@ -0,0 +1,331 @@
+package stellar
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"quasarflow-api/internal/domain/ledger"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/strkey"
+)
+
+// maxHorizonPageSize is the largest page Horizon serves
+const maxHorizonPageSize = 200
+
+// HistoryReader reads an account's operations from Horizon as ledger records, together
+// with their transactions and their effects on the account. It serves both the ledger
+// index and history queries for accounts that are not indexed.
+type HistoryReader struct {
+	horizon *horizonclient.Client
+}
+
+func NewHistoryReader(horizon *horizonclient.Client) *HistoryReader {
+	return &HistoryReader{horizon: horizon}
+}
+
+// Page returns up to limit operations of the account following cursor, and the
+// transactions they belong to in page order. Failed transactions are included.
+func (hr *HistoryReader) Page(account, cursor string, limit int, order horizonclient.Order) ([]*ledger.Operation, []*ledger.Transaction, error) {
+	return hr.page(account, cursor, limit, order, hr.Decode)
+}
+
+// Headers is Page without the operations' details, references and effects. It saves the
+// request for effects made per operation, for callers that only compare identities.
+func (hr *HistoryReader) Headers(account, cursor string, limit int, order horizonclient.Order) ([]*ledger.Operation, []*ledger.Transaction, error) {
+	return hr.page(account, cursor, limit, order, hr.decodeHeader)
+}
+
+func (hr *HistoryReader) page(
+	account, cursor string,
+	limit int,
+	order horizonclient.Order,
+	decode func(account string, record operations.Operation) (*ledger.Operation, *ledger.Transaction, error),
+) ([]*ledger.Operation, []*ledger.Transaction, error) {
+	if limit <= 0 || limit > maxHorizonPageSize {
+		limit = maxHorizonPageSize
+	}
+
+	page, err := hr.horizon.Operations(horizonclient.OperationRequest{
+		ForAccount:    account,
+		Cursor:        cursor,
+		Order:         order,
+		Limit:         uint(limit),
+		IncludeFailed: true,
+		Join:          "transactions",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ops := make([]*ledger.Operation, 0, len(page.Embedded.Records))
+	var txs []*ledger.Transaction
+	seen := make(map[string]bool)
+	for _, record := range page.Embedded.Records {
+		op, tx, err := decode(account, record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read operation %s: %w", record.GetID(), err)
+		}
+
+		if !seen[tx.Hash] {
+			seen[tx.Hash] = true
+			txs = append(txs, tx)
+		}
+		ops = append(ops, op)
+	}
+
+	return ops, txs, nil
+}
+
+// Decode reads an operation record generically through its JSON form, so every
+// operation type is kept with its full details. The returned operation has no wallet.
+func (hr *HistoryReader) Decode(account string, record operations.Operation) (*ledger.Operation, *ledger.Transaction, error) {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var base operations.Base
+	if err := json.Unmarshal(raw, &base); err != nil {
+		return nil, nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, nil, err
+	}
+	delete(fields, "_links")
+	delete(fields, "transaction")
+
+	details, err := json.Marshal(fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	position, err := strconv.ParseInt(base.ID, 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid operation id: %w", err)
+	}
+
+	tx, err := hr.transaction(base)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refs := newReferences(account)
+	refs.collect(fields)
+
+	// Failed transactions have no effects
+	var effects []ledger.Effect
+	if base.TransactionSuccessful {
+		effects, err = hr.effects(base.ID, account, refs)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	op := &ledger.Operation{
+		ID:              base.ID,
+		Position:        position,
+		TransactionHash: base.TransactionHash,
+		Type:            base.Type,
+		SourceAccount:   base.SourceAccount,
+		Successful:      base.TransactionSuccessful,
+		Counterparties:  refs.accountList(),
+		Assets:          refs.assetList(),
+		Details:         details,
+		Effects:         effects,
+		ClosedAt:        base.LedgerCloseTime,
+	}
+
+	return op, toLedgerTransaction(tx), nil
+}
+
+// decodeHeader reads the identity of an operation record and its transaction
+func (hr *HistoryReader) decodeHeader(account string, record operations.Operation) (*ledger.Operation, *ledger.Transaction, error) {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var base operations.Base
+	if err := json.Unmarshal(raw, &base); err != nil {
+		return nil, nil, err
+	}
+
+	position, err := strconv.ParseInt(base.ID, 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid operation id: %w", err)
+	}
+
+	tx, err := hr.transaction(base)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ledger.Operation{
+		ID:              base.ID,
+		Position:        position,
+		TransactionHash: base.TransactionHash,
+		Type:            base.Type,
+		SourceAccount:   base.SourceAccount,
+		Successful:      base.TransactionSuccessful,
+		ClosedAt:        base.LedgerCloseTime,
+	}, toLedgerTransaction(tx), nil
+}
+
+func toLedgerTransaction(tx *horizon.Transaction) *ledger.Transaction {
+	return &ledger.Transaction{
+		ID:             tx.ID,
+		Hash:           tx.Hash,
+		Ledger:         tx.Ledger,
+		SourceAccount:  tx.Account,
+		Successful:     tx.Successful,
+		MaxFee:         tx.MaxFee,
+		FeeCharged:     tx.FeeCharged,
+		OperationCount: tx.OperationCount,
+		MemoType:       tx.MemoType,
+		Memo:           tx.Memo,
+		ClosedAt:       tx.LedgerCloseTime,
+	}
+}
+
+// transaction returns the operation's joined transaction, fetching it if Horizon did not embed it
+func (hr *HistoryReader) transaction(base operations.Base) (*horizon.Transaction, error) {
+	if base.Transaction != nil {
+		return base.Transaction, nil
+	}
+
+	tx, err := hr.horizon.TransactionDetail(base.TransactionHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transaction: %w", err)
+	}
+
+	return &tx, nil
+}
+
+// effects returns the operation's effects on the account and adds the accounts and
+// assets they mention to refs
+func (hr *HistoryReader) effects(operationID, account string, refs *references) ([]ledger.Effect, error) {
+	page, err := hr.horizon.Effects(horizonclient.EffectRequest{ForOperation: operationID, Limit: maxHorizonPageSize})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch effects: %w", err)
+	}
+
+	effects := make([]ledger.Effect, 0, len(page.Embedded.Records))
+	for _, record := range page.Embedded.Records {
+		raw, err := json.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+
+		var fields map[string]interface{}
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+		if fields["account"] != account {
+			continue
+		}
+		delete(fields, "_links")
+
+		details, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+
+		refs.collect(fields)
+		id, _ := fields["id"].(string)
+		effectType, _ := fields["type"].(string)
+		effects = append(effects, ledger.Effect{ID: id, Type: effectType, Details: details})
+	}
+
+	return effects, nil
+}
+
+// references collects the accounts and assets mentioned in Horizon records
+type references struct {
+	self     string
+	accounts map[string]bool
+	assets   map[string]bool
+}
+
+func newReferences(self string) *references {
+	return &references{
+		self:     self,
+		accounts: make(map[string]bool),
+		assets:   make(map[string]bool),
+	}
+}
+
+// collect walks a decoded JSON value. Assets appear as "<prefix>asset_type" with matching
+// code and issuer fields, or as canonical strings in "asset" fields.
+func (r *references) collect(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if strings.HasSuffix(key, "asset_type") {
+				r.addAsset(v, strings.TrimSuffix(key, "asset_type"))
+			}
+			if s, ok := field.(string); ok {
+				if key == "asset" {
+					r.addCanonicalAsset(s)
+				} else if s != r.self && strkey.IsValidEd25519PublicKey(s) {
+					r.accounts[s] = true
+				}
+			}
+			r.collect(field)
+		}
+	case []interface{}:
+		for _, item := range v {
+			r.collect(item)
+		}
+	}
+}
+
+func (r *references) addAsset(fields map[string]interface{}, prefix string) {
+	assetType, _ := fields[prefix+"asset_type"].(string)
+	if assetType == "native" {
+		r.assets["native"] = true
+		return
+	}
+
+	code, _ := fields[prefix+"asset_code"].(string)
+	issuer, _ := fields[prefix+"asset_issuer"].(string)
+	if code != "" && issuer != "" {
+		r.assets[code+":"+issuer] = true
+	}
+}
+
+func (r *references) addCanonicalAsset(asset string) {
+	if asset == "native" {
+		r.assets[asset] = true
+		return
+	}
+
+	parts := strings.Split(asset, ":")
+	if len(parts) == 2 && strkey.IsValidEd25519PublicKey(parts[1]) {
+		r.assets[asset] = true
+	}
+}
+
+func (r *references) accountList() []string {
+	return sortedKeys(r.accounts)
+}
+
+func (r *references) assetList() []string {
+	return sortedKeys(r.assets)
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
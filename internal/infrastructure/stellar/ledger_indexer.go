@@ -0,0 +1,177 @@
+package stellar
+
+import (
+	"context"
+	"time"
+
+	"quasarflow-api/internal/domain/ledger"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/protocols/horizon/operations"
+)
+
+// LedgerIndexerConfig controls how wallet history is indexed
+type LedgerIndexerConfig struct {
+	Network             string        // Only wallets on this network are indexed
+	RefreshInterval     time.Duration // How often newly created and closed wallets are picked up
+	ReconnectBackoff    time.Duration // First delay before resuming a failed backfill or stream
+	MaxReconnectBackoff time.Duration
+	BackfillPageSize    int // Operations fetched per backfill request
+}
+
+// LedgerIndexer fills the local ledger index with the operations and effects of every
+// active wallet. A wallet's history is first backfilled page by page, then new
+// operations are streamed; both resume from the last indexed operation.
+type LedgerIndexer struct {
+	horizon *horizonclient.Client
+	reader  *HistoryReader
+	index   ledger.Repository
+	config  LedgerIndexerConfig
+	streams *walletStreams
+	logger  logger.Logger
+}
+
+func NewLedgerIndexer(
+	horizon *horizonclient.Client,
+	wallets wallet.Repository,
+	index ledger.Repository,
+	config LedgerIndexerConfig,
+	logger logger.Logger,
+) *LedgerIndexer {
+	if config.BackfillPageSize <= 0 || config.BackfillPageSize > maxHorizonPageSize {
+		config.BackfillPageSize = maxHorizonPageSize
+	}
+
+	li := &LedgerIndexer{
+		horizon: horizon,
+		reader:  NewHistoryReader(horizon),
+		index:   index,
+		config:  config,
+		logger:  logger,
+	}
+	li.streams = newWalletStreams("ledger indexer", wallets, li.sync, walletStreamsConfig{
+		Network:             config.Network,
+		RefreshInterval:     config.RefreshInterval,
+		ReconnectBackoff:    config.ReconnectBackoff,
+		MaxReconnectBackoff: config.MaxReconnectBackoff,
+	}, logger)
+	return li
+}
+
+// Run blocks, indexing every active wallet until ctx is done
+func (li *LedgerIndexer) Run(ctx context.Context) {
+	li.streams.Run(ctx)
+}
+
+// sync backfills the wallet's history if that has not finished, then streams new
+// operations, and reports whether anything was indexed
+func (li *LedgerIndexer) sync(ctx context.Context, w *wallet.Wallet) (bool, error) {
+	state, err := li.index.GetState(ctx, w.ID)
+	if err != nil {
+		return false, err
+	}
+
+	cursor := ""
+	if state != nil {
+		cursor = state.Cursor
+	}
+
+	processed := false
+	if state == nil || state.BackfilledAt == nil {
+		cursor, processed, err = li.backfill(ctx, w, cursor)
+		if err != nil {
+			return processed, err
+		}
+	}
+
+	streamed, err := li.stream(ctx, w, cursor)
+	return processed || streamed, err
+}
+
+// backfill indexes the wallet's existing history in pages and returns the cursor to stream from
+func (li *LedgerIndexer) backfill(ctx context.Context, w *wallet.Wallet, cursor string) (string, bool, error) {
+	processed := false
+	for {
+		ops, txs, err := li.reader.Page(w.PublicKey, cursor, li.config.BackfillPageSize, horizonclient.OrderAsc)
+		if err != nil {
+			return cursor, processed, err
+		}
+
+		if len(ops) > 0 {
+			batch := li.batch(w, ops, txs)
+			if err := li.index.Save(ctx, batch); err != nil {
+				return cursor, processed, err
+			}
+			cursor = batch.Cursor
+			processed = true
+		}
+
+		if len(ops) < li.config.BackfillPageSize {
+			break
+		}
+	}
+
+	if err := li.index.MarkBackfilled(ctx, w.ID); err != nil {
+		return cursor, processed, err
+	}
+
+	li.logger.Info("wallet history backfilled",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("cursor", cursor))
+
+	return cursor, processed, nil
+}
+
+// stream indexes new operations as Horizon reports them. A failing operation ends
+// the stream so it is retried from the last indexed operation.
+func (li *LedgerIndexer) stream(ctx context.Context, w *wallet.Wallet, cursor string) (bool, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	processed := false
+	var indexErr error
+	err := li.horizon.StreamOperations(streamCtx, horizonclient.OperationRequest{
+		ForAccount:    w.PublicKey,
+		Cursor:        cursor,
+		IncludeFailed: true,
+		Join:          "transactions",
+	}, func(op operations.Operation) {
+		if indexErr != nil {
+			return
+		}
+
+		decoded, tx, err := li.reader.Decode(w.PublicKey, op)
+		if err == nil {
+			err = li.index.Save(streamCtx, li.batch(w, []*ledger.Operation{decoded}, []*ledger.Transaction{tx}))
+		}
+		if err != nil {
+			indexErr = err
+			cancel()
+			return
+		}
+		processed = true
+	})
+
+	if indexErr != nil {
+		return processed, indexErr
+	}
+
+	return processed, err
+}
+
+// batch assigns operations read from Horizon to the wallet and resumes after the last one.
+// Operation IDs double as Horizon paging tokens.
+func (li *LedgerIndexer) batch(w *wallet.Wallet, ops []*ledger.Operation, txs []*ledger.Transaction) *ledger.Batch {
+	for _, op := range ops {
+		op.WalletID = w.ID
+	}
+
+	return &ledger.Batch{
+		WalletID:     w.ID,
+		Transactions: txs,
+		Operations:   ops,
+		Cursor:       ops[len(ops)-1].ID,
+	}
+}
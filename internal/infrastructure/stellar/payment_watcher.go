@@ -0,0 +1,275 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/protocols/horizon/operations"
+)
+
+// PaymentWatcherConfig controls how wallet payment streams are opened and resumed
+type PaymentWatcherConfig struct {
+	Network             string        // Only wallets on this network are watched
+	RefreshInterval     time.Duration // How often newly created and closed wallets are picked up
+	ReconnectBackoff    time.Duration // First delay before reopening a failed stream
+	MaxReconnectBackoff time.Duration
+	SkipHistory         bool // Wallets without a saved cursor start at "now" instead of replaying their history
+}
+
+// PaymentWatcher streams payments of every active wallet from Horizon and publishes
+// payment.received and payment.sent events. Each wallet's paging cursor is saved after
+// its events are published, so a restart resumes at the first unpublished operation.
+type PaymentWatcher struct {
+	horizon   *horizonclient.Client
+	cursors   event.CursorRepository
+	publisher event.Publisher
+	config    PaymentWatcherConfig
+	streams   *walletStreams
+	logger    logger.Logger
+}
+
+func NewPaymentWatcher(
+	horizon *horizonclient.Client,
+	wallets wallet.Repository,
+	cursors event.CursorRepository,
+	publisher event.Publisher,
+	config PaymentWatcherConfig,
+	logger logger.Logger,
+) *PaymentWatcher {
+	pw := &PaymentWatcher{
+		horizon:   horizon,
+		cursors:   cursors,
+		publisher: publisher,
+		config:    config,
+		logger:    logger,
+	}
+	pw.streams = newWalletStreams("payment watcher", wallets, pw.stream, walletStreamsConfig{
+		Network:             config.Network,
+		RefreshInterval:     config.RefreshInterval,
+		ReconnectBackoff:    config.ReconnectBackoff,
+		MaxReconnectBackoff: config.MaxReconnectBackoff,
+	}, logger)
+	return pw
+}
+
+// Run blocks, keeping one stream open per active wallet until ctx is done
+func (pw *PaymentWatcher) Run(ctx context.Context) {
+	pw.streams.Run(ctx)
+}
+
+// stream runs one Horizon payment stream and reports whether any operation was processed.
+// A failing operation ends the stream so it is retried from the last saved cursor.
+func (pw *PaymentWatcher) stream(ctx context.Context, w *wallet.Wallet) (bool, error) {
+	cursor, err := pw.cursors.GetCursor(ctx, w.ID)
+	if err != nil {
+		return false, err
+	}
+	if cursor == "" && pw.config.SkipHistory {
+		cursor = "now"
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	processed := false
+	var handleErr error
+	err = pw.horizon.StreamPayments(streamCtx, horizonclient.OperationRequest{
+		ForAccount: w.PublicKey,
+		Cursor:     cursor,
+		Join:       "transactions",
+	}, func(op operations.Operation) {
+		if handleErr != nil {
+			return
+		}
+		if handleErr = pw.handle(streamCtx, w, op); handleErr != nil {
+			cancel()
+			return
+		}
+		processed = true
+	})
+
+	if handleErr != nil {
+		return processed, handleErr
+	}
+
+	return processed, err
+}
+
+// handle publishes the events of one operation and then advances the wallet's cursor
+func (pw *PaymentWatcher) handle(ctx context.Context, w *wallet.Wallet, op operations.Operation) error {
+	events, err := pw.decode(w, op)
+	if err != nil {
+		return fmt.Errorf("failed to decode operation %s: %w", op.GetID(), err)
+	}
+
+	for _, e := range events {
+		if err := pw.publisher.Publish(ctx, e); err != nil {
+			return fmt.Errorf("failed to publish %s for operation %s: %w", e.Type, op.GetID(), err)
+		}
+	}
+
+	return pw.cursors.SaveCursor(ctx, w.ID, op.PagingToken())
+}
+
+// decode turns a payment stream record into events for the wallet: received when it
+// is the destination, sent when it is the source, both for payments to itself
+func (pw *PaymentWatcher) decode(w *wallet.Wallet, op operations.Operation) ([]*event.Event, error) {
+	var (
+		base    operations.Base
+		payment paymentDetails
+		err     error
+	)
+
+	switch o := op.(type) {
+	case operations.Payment:
+		base = o.Base
+		payment, err = paymentFromAsset(o.From, o.To, o.Amount, o.Asset.Type, o.Asset.Code, o.Asset.Issuer)
+	case operations.PathPayment:
+		base = o.Payment.Base
+		payment, err = paymentFromAsset(o.From, o.To, o.Amount, o.Asset.Type, o.Asset.Code, o.Asset.Issuer)
+		if err == nil {
+			err = payment.setSource(o.SourceAmount, o.SourceAssetType, o.SourceAssetCode, o.SourceAssetIssuer)
+		}
+	case operations.PathPaymentStrictSend:
+		base = o.Payment.Base
+		payment, err = paymentFromAsset(o.From, o.To, o.Amount, o.Asset.Type, o.Asset.Code, o.Asset.Issuer)
+		if err == nil {
+			err = payment.setSource(o.SourceAmount, o.SourceAssetType, o.SourceAssetCode, o.SourceAssetIssuer)
+		}
+	case operations.CreateAccount:
+		base = o.Base
+		payment, err = paymentFromAsset(o.Funder, o.Account, o.StartingBalance, "native", "", "")
+	case operations.AccountMerge:
+		base = o.Base
+		payment, err = pw.mergedAmount(o)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := pw.transaction(base)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]event.Type, 0, 2)
+	if payment.to == w.PublicKey {
+		types = append(types, event.TypePaymentReceived)
+	}
+	if payment.from == w.PublicKey {
+		types = append(types, event.TypePaymentSent)
+	}
+
+	events := make([]*event.Event, 0, len(types))
+	for _, t := range types {
+		e := event.New(t, w.ID, base.ID)
+		e.PublicKey = w.PublicKey
+		e.Payment = &event.Payment{
+			OperationID:       base.ID,
+			OperationType:     base.Type,
+			TransactionHash:   base.TransactionHash,
+			Ledger:            tx.Ledger,
+			PagingToken:       base.PT,
+			From:              payment.from,
+			To:                payment.to,
+			Amount:            payment.amount,
+			AssetType:         payment.assetType,
+			AssetCode:         payment.assetCode,
+			AssetIssuer:       payment.assetIssuer,
+			SourceAmount:      payment.sourceAmount,
+			SourceAssetType:   payment.sourceAssetType,
+			SourceAssetCode:   payment.sourceAssetCode,
+			SourceAssetIssuer: payment.sourceAssetIssuer,
+			MemoType:          tx.MemoType,
+			Memo:              tx.Memo,
+		}
+		e.OccurredAt = base.LedgerCloseTime
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// transaction returns the operation's joined transaction, fetching it if Horizon did not embed it
+func (pw *PaymentWatcher) transaction(base operations.Base) (*horizon.Transaction, error) {
+	if base.Transaction != nil {
+		return base.Transaction, nil
+	}
+
+	tx, err := pw.horizon.TransactionDetail(base.TransactionHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transaction: %w", err)
+	}
+
+	return &tx, nil
+}
+
+// mergedAmount reads the XLM an account merge moved from its account_credited effect
+func (pw *PaymentWatcher) mergedAmount(op operations.AccountMerge) (paymentDetails, error) {
+	page, err := pw.horizon.Effects(horizonclient.EffectRequest{ForOperation: op.ID})
+	if err != nil {
+		return paymentDetails{}, fmt.Errorf("failed to fetch merge effects: %w", err)
+	}
+
+	for _, e := range page.Embedded.Records {
+		if credited, ok := e.(effects.AccountCredited); ok && credited.Account == op.Into {
+			return paymentFromAsset(op.Account, op.Into, credited.Amount, "native", "", "")
+		}
+	}
+
+	return paymentFromAsset(op.Account, op.Into, "0", "native", "", "")
+}
+
+// paymentDetails is the part of an event that differs between operation types
+type paymentDetails struct {
+	from              string
+	to                string
+	amount            decimal.Decimal
+	assetType         string
+	assetCode         string
+	assetIssuer       string
+	sourceAmount      *decimal.Decimal
+	sourceAssetType   string
+	sourceAssetCode   string
+	sourceAssetIssuer string
+}
+
+func paymentFromAsset(from, to, amount, assetType, assetCode, assetIssuer string) (paymentDetails, error) {
+	parsed, err := decimal.NewFromString(amount)
+	if err != nil {
+		return paymentDetails{}, fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+
+	return paymentDetails{
+		from:        from,
+		to:          to,
+		amount:      parsed,
+		assetType:   assetType,
+		assetCode:   assetCode,
+		assetIssuer: assetIssuer,
+	}, nil
+}
+
+func (p *paymentDetails) setSource(amount, assetType, assetCode, assetIssuer string) error {
+	parsed, err := decimal.NewFromString(amount)
+	if err != nil {
+		return fmt.Errorf("invalid source amount %q: %w", amount, err)
+	}
+
+	p.sourceAmount = &parsed
+	p.sourceAssetType = assetType
+	p.sourceAssetCode = assetCode
+	p.sourceAssetIssuer = assetIssuer
+	return nil
+}
@@ -0,0 +1,111 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"quasarflow-api/pkg/safehttp"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stellar/go/clients/stellartoml"
+)
+
+// StellarToml is the part of a SEP-1 stellar.toml file used for asset metadata. The SDK's
+// stellartoml.Response only covers the service endpoints, so the file is decoded here with
+// the same TOML library to read its currencies as well.
+type StellarToml struct {
+	Documentation TomlOrganization `toml:"DOCUMENTATION"`
+	Currencies    []TomlCurrency   `toml:"CURRENCIES"`
+}
+
+// TomlOrganization is the [DOCUMENTATION] table of a stellar.toml file
+type TomlOrganization struct {
+	OrgName string `toml:"ORG_NAME"`
+}
+
+// TomlCurrency is a [[CURRENCIES]] entry of a stellar.toml file
+type TomlCurrency struct {
+	Code            string `toml:"code"`
+	Issuer          string `toml:"issuer"`
+	Name            string `toml:"name"`
+	Desc            string `toml:"desc"`
+	Image           string `toml:"image"`
+	Status          string `toml:"status"`
+	DisplayDecimals *int   `toml:"display_decimals"` // Nil when not published
+	IsAssetAnchored bool   `toml:"is_asset_anchored"`
+	AnchorAssetType string `toml:"anchor_asset_type"`
+	AnchorAsset     string `toml:"anchor_asset"`
+}
+
+// StellarTomlClient fetches stellar.toml files from home domains. Home domains are read
+// from the network or given by users, so only public DNS names are accepted and requests
+// only connect to public addresses.
+type StellarTomlClient struct {
+	httpClient *http.Client
+}
+
+// NewStellarTomlClient creates a new stellar.toml client
+func NewStellarTomlClient(timeout time.Duration) *StellarTomlClient {
+	return &StellarTomlClient{
+		httpClient: safehttp.NewClient(timeout),
+	}
+}
+
+// Fetch downloads and decodes https://{domain}/.well-known/stellar.toml
+func (c *StellarTomlClient) Fetch(ctx context.Context, domain string) (*StellarToml, error) {
+	if err := safehttp.CheckHostname(domain); err != nil {
+		return nil, fmt.Errorf("invalid home domain: %w", err)
+	}
+
+	resp, err := c.http(ctx).Get("https://" + domain + stellartoml.WellKnownPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stellar.toml: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch stellar.toml: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, stellartoml.StellarTomlMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stellar.toml: %w", err)
+	}
+	if len(body) > stellartoml.StellarTomlMaxSize {
+		return nil, fmt.Errorf("stellar.toml exceeds %d bytes", stellartoml.StellarTomlMaxSize)
+	}
+
+	return parseStellarToml(string(body))
+}
+
+// parseStellarToml decodes a stellar.toml file
+func parseStellarToml(data string) (*StellarToml, error) {
+	var doc StellarToml
+	if _, err := toml.Decode(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid stellar.toml: %w", err)
+	}
+	return &doc, nil
+}
+
+// http returns the client as the SDK clients' HTTP interface, bound to ctx
+func (c *StellarTomlClient) http(ctx context.Context) *contextHTTP {
+	return &contextHTTP{ctx: ctx, client: c.httpClient}
+}
+
+// contextHTTP implements the Get-only HTTP interface of the SDK's stellartoml and
+// federation clients, which take no context, with requests bound to ctx
+type contextHTTP struct {
+	ctx    context.Context
+	client *http.Client
+}
+
+func (h *contextHTTP) Get(url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(h.ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return h.client.Do(req)
+}
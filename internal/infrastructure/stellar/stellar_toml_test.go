@@ -0,0 +1,89 @@
+package stellar
+
+import "testing"
+
+func TestParseStellarToml(t *testing.T) {
+	data := `
+# Issuer of USDX
+VERSION = "2.0.0"
+ACCOUNTS = [
+  "GAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", # treasury
+  "GBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB",
+]
+
+[DOCUMENTATION]
+ORG_NAME = "Example, Inc. # not a comment"
+
+[[CURRENCIES]]
+code = "USDX"
+issuer = "GCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC"
+name = "US Dollar, redeemable"
+desc = """Backed 1:1,
+held at "Bank, N.A.\""""
+display_decimals = 2
+is_asset_anchored = true
+anchor_asset_type = "fiat"
+
+[[CURRENCIES]]
+code = "GOLD"
+issuer = 'GDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDD'
+`
+
+	doc, err := parseStellarToml(data)
+	if err != nil {
+		t.Fatalf("parseStellarToml: %v", err)
+	}
+
+	if got, want := doc.Documentation.OrgName, "Example, Inc. # not a comment"; got != want {
+		t.Errorf("OrgName = %q, want %q", got, want)
+	}
+	if len(doc.Currencies) != 2 {
+		t.Fatalf("got %d currencies, want 2", len(doc.Currencies))
+	}
+
+	usdx := doc.Currencies[0]
+	tests := []struct {
+		field, got, want string
+	}{
+		{"code", usdx.Code, "USDX"},
+		{"name", usdx.Name, "US Dollar, redeemable"},
+		{"desc", usdx.Desc, "Backed 1:1,\nheld at \"Bank, N.A.\""},
+		{"anchor_asset_type", usdx.AnchorAssetType, "fiat"},
+		{"second issuer", doc.Currencies[1].Issuer, "GDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDD"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.field, tt.got, tt.want)
+		}
+	}
+
+	if usdx.DisplayDecimals == nil || *usdx.DisplayDecimals != 2 {
+		t.Errorf("display_decimals = %v, want 2", usdx.DisplayDecimals)
+	}
+	if !usdx.IsAssetAnchored {
+		t.Error("is_asset_anchored = false, want true")
+	}
+	if doc.Currencies[1].DisplayDecimals != nil {
+		t.Errorf("unset display_decimals = %d, want nil", *doc.Currencies[1].DisplayDecimals)
+	}
+}
+
+func TestParseStellarTomlInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"unterminated array", "ACCOUNTS = [\"GA\", \"GB\""},
+		{"unterminated string", "VERSION = \"2.0.0"},
+		{"missing value", "VERSION ="},
+		{"wrong type", "[[CURRENCIES]]\ncode = \"USDX\"\ndisplay_decimals = \"two\""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseStellarToml(tt.data); err == nil {
+				t.Error("parseStellarToml succeeded, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package stellar
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+// walletStreamsConfig controls how per-wallet streams are opened and resumed
+type walletStreamsConfig struct {
+	Network             string        // Only wallets on this network are streamed
+	RefreshInterval     time.Duration // How often newly created and closed wallets are picked up
+	ReconnectBackoff    time.Duration // First delay before reopening a failed stream
+	MaxReconnectBackoff time.Duration
+}
+
+// walletStreamFunc runs one stream for a wallet until it fails or ctx is done, and
+// reports whether it made progress, which resets the reconnect backoff
+type walletStreamFunc func(ctx context.Context, w *wallet.Wallet) (bool, error)
+
+// walletStreams keeps one stream open per active wallet, reopening failed streams
+// with exponential backoff and stopping streams of wallets that were closed
+type walletStreams struct {
+	name    string
+	wallets wallet.Repository
+	stream  walletStreamFunc
+	config  walletStreamsConfig
+	logger  logger.Logger
+
+	mu      sync.Mutex
+	streams map[uuid.UUID]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func newWalletStreams(name string, wallets wallet.Repository, stream walletStreamFunc, config walletStreamsConfig, logger logger.Logger) *walletStreams {
+	return &walletStreams{
+		name:    name,
+		wallets: wallets,
+		stream:  stream,
+		config:  config,
+		logger:  logger,
+		streams: make(map[uuid.UUID]context.CancelFunc),
+	}
+}
+
+// Run blocks, keeping one stream open per active wallet until ctx is done
+func (ws *walletStreams) Run(ctx context.Context) {
+	ws.logger.Info("starting "+ws.name,
+		logger.String("network", ws.config.Network),
+		logger.String("refresh_interval", ws.config.RefreshInterval.String()))
+
+	ticker := time.NewTicker(ws.config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := ws.refresh(ctx); err != nil {
+			ws.logger.Error("failed to refresh streamed wallets",
+				logger.String("streams", ws.name),
+				logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			ws.wg.Wait()
+			ws.logger.Info(ws.name + " stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh opens streams for new active wallets and stops streams of wallets that were closed
+func (ws *walletStreams) refresh(ctx context.Context) error {
+	wallets, err := ws.wallets.ListActive(ctx, ws.config.Network)
+	if err != nil {
+		return err
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	active := make(map[uuid.UUID]bool, len(wallets))
+	for _, w := range wallets {
+		active[w.ID] = true
+		if _, ok := ws.streams[w.ID]; ok {
+			continue
+		}
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		ws.streams[w.ID] = cancel
+		ws.wg.Add(1)
+		go func(w *wallet.Wallet) {
+			defer ws.wg.Done()
+			ws.watch(streamCtx, w)
+		}(w)
+	}
+
+	for id, cancel := range ws.streams {
+		if !active[id] {
+			cancel()
+			delete(ws.streams, id)
+		}
+	}
+
+	return nil
+}
+
+// watch runs a wallet's stream, reopening it after failures
+func (ws *walletStreams) watch(ctx context.Context, w *wallet.Wallet) {
+	backoff := ws.config.ReconnectBackoff
+
+	for ctx.Err() == nil {
+		processed, err := ws.stream(ctx, w)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if processed {
+			backoff = ws.config.ReconnectBackoff
+		}
+
+		if horizonclient.IsNotFoundError(err) {
+			// Unfunded accounts have no history yet
+			ws.logger.Debug("wallet account not found, waiting to stream",
+				logger.String("streams", ws.name),
+				logger.String("wallet_id", w.ID.String()))
+		} else if err != nil {
+			ws.logger.Warn("wallet stream interrupted",
+				logger.String("streams", ws.name),
+				logger.String("wallet_id", w.ID.String()),
+				logger.String("retry_in", backoff.String()),
+				logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > ws.config.MaxReconnectBackoff {
+			backoff = ws.config.MaxReconnectBackoff
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"quasarflow-api/internal/domain/webhook"
+	"quasarflow-api/pkg/safehttp"
+)
+
+// maxResponseBytes bounds how much of an endpoint's response is kept in the delivery log
+const maxResponseBytes = 2 * 1024
+
+// HTTPSender posts webhook deliveries as JSON. Responses are kept in the delivery log,
+// so requests only connect to public addresses: an endpoint cannot be pointed at internal
+// services, including through DNS. Redirects are not followed, so an endpoint cannot
+// bounce a signed payload to another host.
+type HTTPSender struct {
+	httpClient *http.Client
+	userAgent  string
+}
+
+// NewHTTPSender creates a sender whose requests time out after timeout
+func NewHTTPSender(timeout time.Duration, userAgent string) *HTTPSender {
+	httpClient := safehttp.NewClient(timeout)
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	return &HTTPSender{
+		httpClient: httpClient,
+		userAgent:  userAgent,
+	}
+}
+
+// Send posts body to url with the given headers; any response, including non-2xx, is returned
+func (s *HTTPSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (*webhook.Response, error) {
+	// Endpoints registered before https was required are not sent to
+	if !webhook.IsValidURL(url) {
+		return nil, fmt.Errorf("refusing to send webhook to %q: https is required", url)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", s.userAgent)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+
+	return &webhook.Response{
+		StatusCode: resp.StatusCode,
+		Body:       string(responseBody),
+	}, nil
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
-	"strconv"
 
 	"quasarflow-api/internal/interface/http/response"
 	"quasarflow-api/internal/usecase/wallet"
@@ -17,24 +16,24 @@ import (
 
 // AccountHandler handles account-related operations including ownership verification
 type AccountHandler struct {
-	verifyOwnership    *wallet.VerifyOwnershipUseCase
-	getBalance         *wallet.GetBalanceUseCase
-	getTransactionHist *wallet.GetTransactionHistoryUseCase
-	logger             logger.Logger
+	verifyOwnership   *wallet.VerifyOwnershipUseCase
+	getBalance        *wallet.GetBalanceUseCase
+	getAccountHistory *wallet.GetAccountHistoryUseCase
+	logger            logger.Logger
 }
 
 // NewAccountHandler creates a new account handler
 func NewAccountHandler(
 	verifyOwnership *wallet.VerifyOwnershipUseCase,
 	getBalance *wallet.GetBalanceUseCase,
-	getTransactionHist *wallet.GetTransactionHistoryUseCase,
+	getAccountHistory *wallet.GetAccountHistoryUseCase,
 	logger logger.Logger,
 ) *AccountHandler {
 	return &AccountHandler{
-		verifyOwnership:    verifyOwnership,
-		getBalance:         getBalance,
-		getTransactionHist: getTransactionHist,
-		logger:             logger,
+		verifyOwnership:   verifyOwnership,
+		getBalance:        getBalance,
+		getAccountHistory: getAccountHistory,
+		logger:            logger,
 	}
 }
 
@@ -237,25 +236,23 @@ func (h *AccountHandler) GetAccountTransactionHistory(w http.ResponseWriter, r *
 		return
 	}
 
-	// Parse query parameters
-	limit := h.parseQueryLimit(r, 10)
-	offset := h.parseQueryOffset(r, 0)
+	cursor, limit := parseCursorPagination(r)
+	input := wallet.GetAccountHistoryInput{
+		PublicKey: publicKey,
+		Limit:     limit,
+		Cursor:    cursor,
+	}
+	if order := r.URL.Query().Get(paramOrder); order == orderAsc || order == orderDesc {
+		input.Order = order
+	}
 
-	// For now, return a simple response indicating this endpoint needs implementation
-	// TODO: Implement direct transaction history fetching using stellar client
-	h.logger.Info("account transaction history requested (not implemented yet)",
-		zap.String("public_key", publicKey),
-		zap.Int("limit", limit),
-		zap.Int("offset", offset),
-		zap.String("ip", r.RemoteAddr))
+	output, err := h.getAccountHistory.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_account_transaction_history")
+		return
+	}
 
-	response.Success(w, http.StatusOK, map[string]interface{}{
-		"public_key": publicKey,
-		"limit":      limit,
-		"offset":     offset,
-		"message":    "Transaction history endpoint not yet implemented for external wallets",
-		"note":       "Use the wallet endpoints for registered wallets",
-	})
+	response.Success(w, http.StatusOK, output)
 }
 
 // Helper methods
@@ -265,26 +262,6 @@ func (h *AccountHandler) isValidStellarPublicKey(publicKey string) bool {
 	return len(publicKey) == 56 && publicKey[0] == 'G'
 }
 
-// parseQueryLimit parses the limit query parameter
-func (h *AccountHandler) parseQueryLimit(r *http.Request, defaultValue int) int {
-	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
-		if limit, err := strconv.Atoi(limitStr); err == nil && limit > 0 && limit <= 100 {
-			return limit
-		}
-	}
-	return defaultValue
-}
-
-// parseQueryOffset parses the offset query parameter
-func (h *AccountHandler) parseQueryOffset(r *http.Request, defaultValue int) int {
-	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
-		if offset, err := strconv.Atoi(offsetStr); err == nil && offset >= 0 {
-			return offset
-		}
-	}
-	return defaultValue
-}
-
 // handleUseCaseError handles errors from use cases with appropriate HTTP status codes
 func (h *AccountHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
 	var appErr *pkgErrors.AppError
@@ -294,7 +271,7 @@ func (h *AccountHandler) handleUseCaseError(w http.ResponseWriter, r *http.Reque
 			zap.String("error_type", string(appErr.Type)),
 			zap.String("ip", r.RemoteAddr),
 			zap.Error(err))
-		response.Error(w, appErr.StatusCode, appErr.Message)
+		response.AppError(w, appErr)
 		return
 	}
 
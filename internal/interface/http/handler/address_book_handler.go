@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/middleware"
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/addressbook"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// AddressBookHandler manages the address books of wallets and of the authenticated user.
+// Routes under /wallets/{id} act on the wallet's book, the others on the caller's.
+type AddressBookHandler struct {
+	addEntry    *addressbook.AddEntryUseCase
+	listEntries *addressbook.ListEntriesUseCase
+	deleteEntry *addressbook.DeleteEntryUseCase
+	getMode     *addressbook.GetModeUseCase
+	setMode     *addressbook.SetModeUseCase
+	logger      logger.Logger
+}
+
+// NewAddressBookHandler creates a new address book handler
+func NewAddressBookHandler(
+	addEntry *addressbook.AddEntryUseCase,
+	listEntries *addressbook.ListEntriesUseCase,
+	deleteEntry *addressbook.DeleteEntryUseCase,
+	getMode *addressbook.GetModeUseCase,
+	setMode *addressbook.SetModeUseCase,
+	logger logger.Logger,
+) *AddressBookHandler {
+	return &AddressBookHandler{
+		addEntry:    addEntry,
+		listEntries: listEntries,
+		deleteEntry: deleteEntry,
+		getMode:     getMode,
+		setMode:     setMode,
+		logger:      logger,
+	}
+}
+
+// setModeRequest represents a request to change an address book's mode
+type setModeRequest struct {
+	AllowlistOnly *bool `json:"allowlist_only"`
+}
+
+// List returns the address book's entries
+// GET /api/v1/wallets/{id}/address-book, GET /api/v1/address-book
+func (h *AddressBookHandler) List(w http.ResponseWriter, r *http.Request) {
+	scope, subjectID, ok := h.book(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listEntries.Execute(r.Context(), scope, subjectID)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_address_book")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Add adds a counterparty, usable in allow-list only mode after the cool-down
+// POST /api/v1/wallets/{id}/address-book, POST /api/v1/address-book
+func (h *AddressBookHandler) Add(w http.ResponseWriter, r *http.Request) {
+	scope, subjectID, ok := h.book(w, r)
+	if !ok {
+		return
+	}
+
+	var input addressbook.AddEntryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.Scope, input.SubjectID = scope, subjectID
+	input.CreatedBy, _ = middleware.GetUserIDFromContext(r.Context())
+
+	output, err := h.addEntry.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "add_address_book_entry")
+		return
+	}
+
+	response.Success(w, http.StatusCreated, output)
+}
+
+// Delete removes a counterparty
+// DELETE /api/v1/wallets/{id}/address-book/{entry_id}, DELETE /api/v1/address-book/{entry_id}
+func (h *AddressBookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	scope, subjectID, ok := h.book(w, r)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.Parse(mux.Vars(r)["entry_id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid entry ID")
+		return
+	}
+
+	if err := h.deleteEntry.Execute(r.Context(), id, scope, subjectID); err != nil {
+		h.handleUseCaseError(w, r, err, "delete_address_book_entry")
+		return
+	}
+
+	response.Success(w, http.StatusOK, map[string]string{"message": "Address book entry deleted"})
+}
+
+// GetMode returns whether the address book restricts payments to its entries
+// GET /api/v1/wallets/{id}/address-book/mode, GET /api/v1/address-book/mode
+func (h *AddressBookHandler) GetMode(w http.ResponseWriter, r *http.Request) {
+	scope, subjectID, ok := h.book(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getMode.Execute(r.Context(), scope, subjectID)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_address_book_mode")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// SetMode turns allow-list only mode on or off
+// PUT /api/v1/wallets/{id}/address-book/mode, PUT /api/v1/address-book/mode
+func (h *AddressBookHandler) SetMode(w http.ResponseWriter, r *http.Request) {
+	scope, subjectID, ok := h.book(w, r)
+	if !ok {
+		return
+	}
+
+	var req setModeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.AllowlistOnly == nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+
+	output, err := h.setMode.Execute(r.Context(), scope, subjectID, *req.AllowlistOnly)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "set_address_book_mode")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// book returns the address book a request acts on: the wallet's when the route has a
+// wallet ID, the authenticated user's otherwise
+func (h *AddressBookHandler) book(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	if value, ok := mux.Vars(r)["id"]; ok {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+			return "", "", false
+		}
+		return addressbook.ScopeWallet, id.String(), true
+	}
+
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return "", "", false
+	}
+	return addressbook.ScopeUser, userID, true
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *AddressBookHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
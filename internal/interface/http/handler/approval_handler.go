@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/approval"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const errMsgInvalidApprovalID = "Invalid approval request ID"
+
+// ApprovalHandler handles payments held for approval and, for admins, approval policies
+type ApprovalHandler struct {
+	listRequests *approval.ListRequestsUseCase
+	getRequest   *approval.GetRequestUseCase
+	decide       *approval.DecideUseCase
+	setPolicy    *approval.SetPolicyUseCase
+	listPolicies *approval.ListPoliciesUseCase
+	deletePolicy *approval.DeletePolicyUseCase
+	logger       logger.Logger
+}
+
+// NewApprovalHandler creates a new approval handler
+func NewApprovalHandler(
+	listRequests *approval.ListRequestsUseCase,
+	getRequest *approval.GetRequestUseCase,
+	decide *approval.DecideUseCase,
+	setPolicy *approval.SetPolicyUseCase,
+	listPolicies *approval.ListPoliciesUseCase,
+	deletePolicy *approval.DeletePolicyUseCase,
+	logger logger.Logger,
+) *ApprovalHandler {
+	return &ApprovalHandler{
+		listRequests: listRequests,
+		getRequest:   getRequest,
+		decide:       decide,
+		setPolicy:    setPolicy,
+		listPolicies: listPolicies,
+		deletePolicy: deletePolicy,
+		logger:       logger,
+	}
+}
+
+// List returns approval requests, newest first
+// GET /api/v1/approvals?wallet_id=...&status=pending
+func (h *ApprovalHandler) List(w http.ResponseWriter, r *http.Request) {
+	var walletID uuid.UUID
+	if value := r.URL.Query().Get("wallet_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+			return
+		}
+		walletID = id
+	}
+
+	limit, offset := parsePagination(r)
+	output, err := h.listRequests.Execute(r.Context(), approval.ListRequestsInput{
+		WalletID: walletID,
+		Status:   r.URL.Query().Get("status"),
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_approval_requests")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Get returns an approval request with its audit trail
+// GET /api/v1/approvals/{id}
+func (h *ApprovalHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidApprovalID)
+		return
+	}
+
+	output, err := h.getRequest.Execute(r.Context(), id)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_approval_request")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Approve approves a request; the approval that reaches the quorum sends the payment
+// POST /api/v1/approvals/{id}/approve
+func (h *ApprovalHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	h.handleDecision(w, r, true, "approve_payment")
+}
+
+// Reject rejects a request
+// POST /api/v1/approvals/{id}/reject
+func (h *ApprovalHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	h.handleDecision(w, r, false, "reject_payment")
+}
+
+func (h *ApprovalHandler) handleDecision(w http.ResponseWriter, r *http.Request, approve bool, operation string) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidApprovalID)
+		return
+	}
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	// The body, holding an optional comment, may be omitted
+	var input approval.DecideInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && !errors.Is(err, io.EOF) {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.RequestID = id
+	input.Approver = userID
+	input.Approve = approve
+
+	output, err := h.decide.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, operation)
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// SetPolicy creates or replaces the approval policy of a wallet for an asset
+// PUT /api/v1/admin/approval-policies
+func (h *ApprovalHandler) SetPolicy(w http.ResponseWriter, r *http.Request) {
+	var input approval.SetPolicyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+
+	output, err := h.setPolicy.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "set_approval_policy")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// ListPolicies returns approval policies, optionally of one wallet
+// GET /api/v1/admin/approval-policies?wallet_id=...
+func (h *ApprovalHandler) ListPolicies(w http.ResponseWriter, r *http.Request) {
+	var walletID uuid.UUID
+	if value := r.URL.Query().Get("wallet_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+			return
+		}
+		walletID = id
+	}
+
+	output, err := h.listPolicies.Execute(r.Context(), walletID)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_approval_policies")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// DeletePolicy removes an approval policy
+// DELETE /api/v1/admin/approval-policies/{id}
+func (h *ApprovalHandler) DeletePolicy(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid policy ID")
+		return
+	}
+
+	if err := h.deletePolicy.Execute(r.Context(), id); err != nil {
+		h.handleUseCaseError(w, r, err, "delete_approval_policy")
+		return
+	}
+
+	response.Success(w, http.StatusOK, map[string]string{"message": "Approval policy deleted"})
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *ApprovalHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,289 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/asset"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const errMsgInvalidAssetID = "invalid asset id"
+
+// AssetHandler manages assets issued from managed wallets
+type AssetHandler struct {
+	issueAsset         *asset.IssueAssetUseCase
+	mintAsset          *asset.MintAssetUseCase
+	setAssetFlags      *asset.SetAssetFlagsUseCase
+	authorizeTrustline *asset.AuthorizeTrustlineUseCase
+	clawback           *asset.ClawbackUseCase
+	lockIssuer         *asset.LockIssuerUseCase
+	getAsset           *asset.GetAssetUseCase
+	listAssets         *asset.ListAssetsUseCase
+	logger             logger.Logger
+}
+
+// NewAssetHandler creates a new asset handler
+func NewAssetHandler(
+	issueAsset *asset.IssueAssetUseCase,
+	mintAsset *asset.MintAssetUseCase,
+	setAssetFlags *asset.SetAssetFlagsUseCase,
+	authorizeTrustline *asset.AuthorizeTrustlineUseCase,
+	clawback *asset.ClawbackUseCase,
+	lockIssuer *asset.LockIssuerUseCase,
+	getAsset *asset.GetAssetUseCase,
+	listAssets *asset.ListAssetsUseCase,
+	logger logger.Logger,
+) *AssetHandler {
+	return &AssetHandler{
+		issueAsset:         issueAsset,
+		mintAsset:          mintAsset,
+		setAssetFlags:      setAssetFlags,
+		authorizeTrustline: authorizeTrustline,
+		clawback:           clawback,
+		lockIssuer:         lockIssuer,
+		getAsset:           getAsset,
+		listAssets:         listAssets,
+		logger:             logger,
+	}
+}
+
+// Issue sets up a new asset on an issuer and distribution wallet pair
+// POST /api/v1/assets
+func (h *AssetHandler) Issue(w http.ResponseWriter, r *http.Request) {
+	var input asset.IssueAssetInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+
+	if input.Code == "" || input.IssuerWalletID == uuid.Nil || input.DistributionWalletID == uuid.Nil {
+		response.Error(w, http.StatusBadRequest, "code, issuer_wallet_id and distribution_wallet_id are required")
+		return
+	}
+
+	output, err := h.issueAsset.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "issue_asset")
+		return
+	}
+
+	h.logger.Info("asset issued successfully",
+		zap.String("asset_id", output.Asset.ID),
+		zap.String("code", output.Asset.Code),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusCreated, output)
+}
+
+// List returns issued assets
+// GET /api/v1/assets
+func (h *AssetHandler) List(w http.ResponseWriter, r *http.Request) {
+	limit, offset := parsePagination(r)
+
+	output, err := h.listAssets.Execute(r.Context(), limit, offset)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_assets")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// GetByID returns a single issued asset with its supply
+// GET /api/v1/assets/{id}
+func (h *AssetHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAssetID(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getAsset.Execute(r.Context(), id)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_asset")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Mint issues new units to the distribution wallet
+// POST /api/v1/assets/{id}/mint
+func (h *AssetHandler) Mint(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAssetID(w, r)
+	if !ok {
+		return
+	}
+
+	var input asset.MintAssetInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.AssetID = id
+
+	if input.Amount == "" {
+		response.Error(w, http.StatusBadRequest, errMsgAmountRequired)
+		return
+	}
+
+	output, err := h.mintAsset.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "mint_asset")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// SetFlags sets or clears the issuer's authorization flags
+// PUT /api/v1/assets/{id}/flags
+func (h *AssetHandler) SetFlags(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAssetID(w, r)
+	if !ok {
+		return
+	}
+
+	var input asset.SetAssetFlagsInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.AssetID = id
+
+	output, err := h.setAssetFlags.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "set_asset_flags")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Authorize authorizes or revokes a holder's trustline
+// POST /api/v1/assets/{id}/authorize
+func (h *AssetHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAssetID(w, r)
+	if !ok {
+		return
+	}
+
+	var input asset.AuthorizeTrustlineInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.AssetID = id
+
+	if input.Trustor == "" {
+		response.Error(w, http.StatusBadRequest, "trustor is required")
+		return
+	}
+
+	output, err := h.authorizeTrustline.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "authorize_trustline")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Clawback claws back part of a holder's balance
+// POST /api/v1/assets/{id}/clawback
+func (h *AssetHandler) Clawback(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAssetID(w, r)
+	if !ok {
+		return
+	}
+
+	var input asset.ClawbackInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.AssetID = id
+
+	if input.From == "" || input.Amount == "" {
+		response.Error(w, http.StatusBadRequest, "from and amount are required")
+		return
+	}
+
+	output, err := h.clawback.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "clawback")
+		return
+	}
+
+	h.logger.Info("asset clawed back successfully",
+		zap.String("asset_id", id.String()),
+		zap.String("from", input.From),
+		zap.String("amount", input.Amount),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// Lock permanently locks the asset's issuer
+// POST /api/v1/assets/{id}/lock
+func (h *AssetHandler) Lock(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAssetID(w, r)
+	if !ok {
+		return
+	}
+
+	input := asset.LockIssuerInput{AssetID: id}
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+			return
+		}
+		input.AssetID = id
+	}
+
+	output, err := h.lockIssuer.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "lock_issuer")
+		return
+	}
+
+	h.logger.Warn("asset issuer locked",
+		zap.String("asset_id", id.String()),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// parseAssetID extracts the asset ID path parameter, writing a 400 response if invalid
+func parseAssetID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidAssetID)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *AssetHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
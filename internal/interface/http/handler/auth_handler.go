@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"quasarflow-api/internal/interface/http/middleware"
 	"quasarflow-api/internal/interface/http/response"
@@ -14,13 +15,15 @@ import (
 // AuthHandler handles authentication requests
 type AuthHandler struct {
 	authMiddleware *middleware.AuthMiddleware
+	users          *middleware.Users
 	logger         logger.Logger
 }
 
 // NewAuthHandler creates a new authentication handler
-func NewAuthHandler(authMiddleware *middleware.AuthMiddleware, logger logger.Logger) *AuthHandler {
+func NewAuthHandler(authMiddleware *middleware.AuthMiddleware, users *middleware.Users, logger logger.Logger) *AuthHandler {
 	return &AuthHandler{
 		authMiddleware: authMiddleware,
+		users:          users,
 		logger:         logger,
 	}
 }
@@ -55,13 +58,9 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// TODO: Implement proper user authentication against database
-	// For now, we'll use a simple demo authentication
-	// In production, you should:
-	// 1. Hash passwords using bcrypt
-	// 2. Verify credentials against database
-	// 3. Implement proper user management
-	if !h.authenticateUser(req.Username, req.Password) {
+	// Users are configured with AUTH_USERS; each gets its own user ID and role
+	user, ok := h.users.Authenticate(req.Username, req.Password)
+	if !ok {
 		h.logger.Warn("authentication failed",
 			zap.String("username", req.Username),
 			zap.String("ip", r.RemoteAddr))
@@ -70,9 +69,8 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Generate JWT token
-	// TODO: Get actual user ID and role from database
-	userID := "demo-user-id"
-	role := "user"
+	userID := user.ID
+	role := user.Role
 
 	token, err := h.authMiddleware.GenerateToken(userID, role)
 	if err != nil {
@@ -84,6 +82,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	h.logger.Info("user logged in successfully",
 		zap.String("username", req.Username),
 		zap.String("user_id", userID),
+		zap.String("role", role),
 		zap.String("ip", r.RemoteAddr))
 
 	// Return token response
@@ -108,8 +107,16 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, http.StatusOK, map[string]string{"message": "Logged out successfully"})
 }
 
-// Me returns information about the current authenticated user
-func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
+// StreamTokenResponse represents a stream token response
+type StreamTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// StreamToken issues a short-lived token for opening wallet streams from browsers, which
+// pass it as the access_token query parameter because EventSource and WebSocket cannot
+// send the Authorization header
+func (h *AuthHandler) StreamToken(w http.ResponseWriter, r *http.Request) {
 	userID, ok := middleware.GetUserIDFromContext(r.Context())
 	if !ok {
 		response.Error(w, http.StatusUnauthorized, "User not authenticated")
@@ -118,26 +125,33 @@ func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
 
 	role, _ := middleware.GetUserRoleFromContext(r.Context())
 
-	userInfo := map[string]interface{}{
-		"user_id": userID,
-		"role":    role,
+	token, expiresAt, err := h.authMiddleware.GenerateStreamToken(userID, role)
+	if err != nil {
+		h.logger.Error("failed to generate stream token", zap.Error(err))
+		response.Error(w, http.StatusInternalServerError, "Failed to generate token")
+		return
 	}
 
-	response.Success(w, http.StatusOK, userInfo)
+	response.Success(w, http.StatusOK, StreamTokenResponse{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	})
 }
 
-// authenticateUser performs basic authentication
-// TODO: Replace with proper database authentication
-func (h *AuthHandler) authenticateUser(username, password string) bool {
-	// Demo credentials - replace with proper authentication logic
-	demoCredentials := map[string]string{
-		"admin": "admin123",
-		"user":  "user123",
+// Me returns information about the current authenticated user
+func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "User not authenticated")
+		return
 	}
 
-	if storedPassword, exists := demoCredentials[username]; exists {
-		return storedPassword == password
+	role, _ := middleware.GetUserRoleFromContext(r.Context())
+
+	userInfo := map[string]interface{}{
+		"user_id": userID,
+		"role":    role,
 	}
 
-	return false
+	response.Success(w, http.StatusOK, userInfo)
 }
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/balance"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// BalanceHistoryHandler serves wallets' past balances
+type BalanceHistoryHandler struct {
+	getBalanceAt     *balance.GetBalanceAtUseCase
+	getBalanceSeries *balance.GetBalanceSeriesUseCase
+	logger           logger.Logger
+}
+
+// NewBalanceHistoryHandler creates a new balance history handler
+func NewBalanceHistoryHandler(
+	getBalanceAt *balance.GetBalanceAtUseCase,
+	getBalanceSeries *balance.GetBalanceSeriesUseCase,
+	logger logger.Logger,
+) *BalanceHistoryHandler {
+	return &BalanceHistoryHandler{
+		getBalanceAt:     getBalanceAt,
+		getBalanceSeries: getBalanceSeries,
+		logger:           logger,
+	}
+}
+
+// GetBalanceAt reports the wallet's balances at a past time
+// GET /api/v1/wallets/{id}/balance?at=2025-03-31T23:59:59Z
+func (h *BalanceHistoryHandler) GetBalanceAt(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	// A date asks for the balance at the end of that day, or now for the current day
+	value := r.URL.Query().Get("at")
+	at, err := parseHistoryTime(value, true)
+	if err != nil || at == nil {
+		response.Error(w, http.StatusBadRequest, "invalid at: use RFC 3339 or YYYY-MM-DD")
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		endOfDay := at.Add(-time.Microsecond)
+		if now := time.Now(); endOfDay.After(now) {
+			endOfDay = now
+		}
+		at = &endOfDay
+	}
+
+	output, err := h.getBalanceAt.Execute(r.Context(), balance.GetBalanceAtInput{
+		WalletID: id,
+		At:       *at,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_balance_at")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Series reports the wallet's end-of-day balances over a range of days
+// GET /api/v1/wallets/{id}/balance/history?from=2025-01-01&to=2025-03-31&asset=native
+func (h *BalanceHistoryHandler) Series(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	from, err := parseHistoryTime(r.URL.Query().Get("from"), false)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid from: use RFC 3339 or YYYY-MM-DD")
+		return
+	}
+	to, err := parseHistoryTime(r.URL.Query().Get("to"), false)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid to: use RFC 3339 or YYYY-MM-DD")
+		return
+	}
+
+	output, err := h.getBalanceSeries.Execute(r.Context(), balance.GetBalanceSeriesInput{
+		WalletID: id,
+		From:     from,
+		To:       to,
+		Asset:    r.URL.Query().Get("asset"),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_balance_series")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *BalanceHistoryHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
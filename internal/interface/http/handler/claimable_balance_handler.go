@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/middleware"
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/claimable"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// ClaimableBalanceHandler creates, lists and claims claimable balances for managed wallets
+type ClaimableBalanceHandler struct {
+	createBalance *claimable.CreateClaimableBalanceUseCase
+	listBalances  *claimable.ListClaimableBalancesUseCase
+	claim         *claimable.ClaimUseCase
+	logger        logger.Logger
+}
+
+// NewClaimableBalanceHandler creates a new claimable balance handler
+func NewClaimableBalanceHandler(
+	createBalance *claimable.CreateClaimableBalanceUseCase,
+	listBalances *claimable.ListClaimableBalancesUseCase,
+	claim *claimable.ClaimUseCase,
+	logger logger.Logger,
+) *ClaimableBalanceHandler {
+	return &ClaimableBalanceHandler{
+		createBalance: createBalance,
+		listBalances:  listBalances,
+		claim:         claim,
+		logger:        logger,
+	}
+}
+
+// Create locks funds from the wallet in a new claimable balance
+// POST /api/v1/wallets/{id}/claimable-balances
+func (h *ClaimableBalanceHandler) Create(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input claimable.CreateClaimableBalanceInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.WalletID = walletID
+	input.UserID, _ = middleware.GetUserIDFromContext(r.Context())
+
+	if input.Amount == "" {
+		response.Error(w, http.StatusBadRequest, errMsgAmountRequired)
+		return
+	}
+
+	output, err := h.createBalance.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "create_claimable_balance")
+		return
+	}
+
+	h.logger.Info("claimable balance created successfully",
+		zap.String("wallet_id", walletID.String()),
+		zap.String("balance_id", output.BalanceID),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusCreated, output)
+}
+
+// List returns claimable balances the wallet can claim, or has created with role=sponsor
+// GET /api/v1/wallets/{id}/claimable-balances?role=claimant&cursor=&limit=10
+func (h *ClaimableBalanceHandler) List(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	cursor, limit := parseCursorPagination(r)
+	output, err := h.listBalances.Execute(r.Context(), claimable.ListClaimableBalancesInput{
+		WalletID: walletID,
+		Role:     r.URL.Query().Get("role"),
+		Cursor:   cursor,
+		Limit:    limit,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_claimable_balances")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Claim moves a claimable balance into the wallet
+// POST /api/v1/wallets/{id}/claimable-balances/{balance_id}/claim
+func (h *ClaimableBalanceHandler) Claim(w http.ResponseWriter, r *http.Request) {
+	h.handleClaim(w, r, false)
+}
+
+// Reclaim returns an expired claimable balance to the wallet that created it
+// POST /api/v1/wallets/{id}/claimable-balances/{balance_id}/reclaim
+func (h *ClaimableBalanceHandler) Reclaim(w http.ResponseWriter, r *http.Request) {
+	h.handleClaim(w, r, true)
+}
+
+func (h *ClaimableBalanceHandler) handleClaim(w http.ResponseWriter, r *http.Request, reclaim bool) {
+	vars := mux.Vars(r)
+	walletID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input claimable.ClaimInput
+	// The body is optional and only carries max_fee
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+			return
+		}
+	}
+	input.WalletID = walletID
+	input.BalanceID = vars["balance_id"]
+	input.Reclaim = reclaim
+
+	operation := "claim_claimable_balance"
+	if reclaim {
+		operation = "reclaim_claimable_balance"
+	}
+
+	output, err := h.claim.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, operation)
+		return
+	}
+
+	h.logger.Info("claimable balance claimed successfully",
+		zap.String("wallet_id", walletID.String()),
+		zap.String("balance_id", output.BalanceID),
+		zap.Bool("reclaim", reclaim),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *ClaimableBalanceHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
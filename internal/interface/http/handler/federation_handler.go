@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/federation"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// FederationHandler resolves SEP-2 federation addresses and serves the federation
+// protocol for managed wallets on our own domain
+type FederationHandler struct {
+	resolveAddress *federation.ResolveAddressUseCase
+	lookup         *federation.LookupUseCase
+	assignName     *federation.AssignNameUseCase
+	logger         logger.Logger
+}
+
+// NewFederationHandler creates a new federation handler
+func NewFederationHandler(
+	resolveAddress *federation.ResolveAddressUseCase,
+	lookup *federation.LookupUseCase,
+	assignName *federation.AssignNameUseCase,
+	logger logger.Logger,
+) *FederationHandler {
+	return &FederationHandler{
+		resolveAddress: resolveAddress,
+		lookup:         lookup,
+		assignName:     assignName,
+		logger:         logger,
+	}
+}
+
+// Resolve resolves a name*domain address to an account ID
+// GET /api/v1/federation/resolve?q=
+func (h *FederationHandler) Resolve(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query().Get("q")
+	if q == "" {
+		response.Error(w, http.StatusBadRequest, "q is required")
+		return
+	}
+
+	output, err := h.resolveAddress.Execute(r.Context(), q)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "resolve_federation_address")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Lookup is the public SEP-2 federation server endpoint. Responses use the
+// protocol's plain JSON format rather than the API envelope.
+// GET /federation?q=&type=
+func (h *FederationHandler) Lookup(w http.ResponseWriter, r *http.Request) {
+	// SEP-2 requires federation servers to be reachable from any origin
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	q := r.URL.Query().Get("q")
+	lookupType := r.URL.Query().Get("type")
+	if q == "" || lookupType == "" {
+		writeFederationError(w, http.StatusBadRequest, "q and type are required")
+		return
+	}
+
+	output, err := h.lookup.Execute(r.Context(), q, lookupType)
+	if err != nil {
+		var appErr *pkgErrors.AppError
+		if errors.As(err, &appErr) {
+			writeFederationError(w, appErr.StatusCode, appErr.Message)
+			return
+		}
+
+		h.logger.Error("federation lookup failed",
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		writeFederationError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(output); err != nil {
+		h.logger.Error("failed to encode federation response", zap.Error(err))
+	}
+}
+
+// AssignName gives a managed wallet a federation name on our domain
+// POST /api/v1/wallets/{id}/federation-name
+func (h *FederationHandler) AssignName(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input federation.AssignNameInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.WalletID = walletID
+
+	if input.Name == "" {
+		response.Error(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	output, err := h.assignName.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "assign_federation_name")
+		return
+	}
+
+	response.Success(w, http.StatusCreated, output)
+}
+
+// writeFederationError writes a SEP-2 error body
+func writeFederationError(w http.ResponseWriter, statusCode int, detail string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]string{"detail": detail})
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *FederationHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
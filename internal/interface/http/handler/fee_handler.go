@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/fee"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// FeeHandler exposes network fee estimates
+type FeeHandler struct {
+	getFeeEstimate *fee.GetFeeEstimateUseCase
+	logger         logger.Logger
+}
+
+// NewFeeHandler creates a new fee handler
+func NewFeeHandler(getFeeEstimate *fee.GetFeeEstimateUseCase, logger logger.Logger) *FeeHandler {
+	return &FeeHandler{
+		getFeeEstimate: getFeeEstimate,
+		logger:         logger,
+	}
+}
+
+// GetEstimate returns the base fee currently used for new transactions
+// GET /api/v1/fees
+func (h *FeeHandler) GetEstimate(w http.ResponseWriter, r *http.Request) {
+	var maxFee int64
+	if maxFeeStr := r.URL.Query().Get("max_fee"); maxFeeStr != "" {
+		parsed, err := strconv.ParseInt(maxFeeStr, 10, 64)
+		if err != nil || parsed <= 0 {
+			response.Error(w, http.StatusBadRequest, "max_fee must be a positive integer (stroops)")
+			return
+		}
+		maxFee = parsed
+	}
+
+	output, err := h.getFeeEstimate.Execute(r.Context(), maxFee)
+	if err != nil {
+		var appErr *pkgErrors.AppError
+		if errors.As(err, &appErr) {
+			response.AppError(w, appErr)
+			return
+		}
+
+		h.logger.Error("failed to get fee estimate",
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.Error(w, http.StatusBadGateway, "Failed to fetch fee stats from Horizon")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/liquiditypool"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// LiquidityPoolHandler manages AMM liquidity pool positions of managed wallets
+type LiquidityPoolHandler struct {
+	getPool  *liquiditypool.GetPoolUseCase
+	deposit  *liquiditypool.DepositUseCase
+	withdraw *liquiditypool.WithdrawUseCase
+	logger   logger.Logger
+}
+
+// NewLiquidityPoolHandler creates a new liquidity pool handler
+func NewLiquidityPoolHandler(
+	getPool *liquiditypool.GetPoolUseCase,
+	deposit *liquiditypool.DepositUseCase,
+	withdraw *liquiditypool.WithdrawUseCase,
+	logger logger.Logger,
+) *LiquidityPoolHandler {
+	return &LiquidityPoolHandler{
+		getPool:  getPool,
+		deposit:  deposit,
+		withdraw: withdraw,
+		logger:   logger,
+	}
+}
+
+// Get returns a liquidity pool and its reserves
+// GET /api/v1/liquidity-pools/{pool_id}
+func (h *LiquidityPoolHandler) Get(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getPool.Execute(r.Context(), mux.Vars(r)["pool_id"])
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_liquidity_pool")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Deposit adds liquidity to a pool, creating the pool share trustline if needed
+// POST /api/v1/wallets/{id}/liquidity-pools/deposit
+func (h *LiquidityPoolHandler) Deposit(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input liquiditypool.DepositInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.WalletID = walletID
+
+	if input.MaxAmountA == "" || input.MaxAmountB == "" || input.MinPrice == "" || input.MaxPrice == "" {
+		response.Error(w, http.StatusBadRequest, "max_amount_a, max_amount_b, min_price and max_price are required")
+		return
+	}
+
+	output, err := h.deposit.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "liquidity_pool_deposit")
+		return
+	}
+
+	h.logger.Info("liquidity deposited successfully",
+		zap.String("wallet_id", walletID.String()),
+		zap.String("pool_id", output.PoolID),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// Withdraw redeems pool shares for the underlying assets
+// POST /api/v1/wallets/{id}/liquidity-pools/{pool_id}/withdraw
+func (h *LiquidityPoolHandler) Withdraw(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	walletID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input liquiditypool.WithdrawInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.WalletID = walletID
+	input.PoolID = vars["pool_id"]
+
+	if input.Amount == "" {
+		response.Error(w, http.StatusBadRequest, errMsgAmountRequired)
+		return
+	}
+
+	output, err := h.withdraw.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "liquidity_pool_withdraw")
+		return
+	}
+
+	h.logger.Info("liquidity withdrawn successfully",
+		zap.String("wallet_id", walletID.String()),
+		zap.String("pool_id", output.PoolID),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *LiquidityPoolHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
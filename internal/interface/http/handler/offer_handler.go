@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/middleware"
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/offer"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const errMsgOfferTermsRequired = "amount and price are required"
+
+// OfferHandler manages DEX offers of managed wallets
+type OfferHandler struct {
+	placeOffer  *offer.PlaceOfferUseCase
+	updateOffer *offer.UpdateOfferUseCase
+	cancelOffer *offer.CancelOfferUseCase
+	listOffers  *offer.ListOffersUseCase
+	getOffer    *offer.GetOfferUseCase
+	listTrades  *offer.ListTradesUseCase
+	logger      logger.Logger
+}
+
+// NewOfferHandler creates a new offer handler
+func NewOfferHandler(
+	placeOffer *offer.PlaceOfferUseCase,
+	updateOffer *offer.UpdateOfferUseCase,
+	cancelOffer *offer.CancelOfferUseCase,
+	listOffers *offer.ListOffersUseCase,
+	getOffer *offer.GetOfferUseCase,
+	listTrades *offer.ListTradesUseCase,
+	logger logger.Logger,
+) *OfferHandler {
+	return &OfferHandler{
+		placeOffer:  placeOffer,
+		updateOffer: updateOffer,
+		cancelOffer: cancelOffer,
+		listOffers:  listOffers,
+		getOffer:    getOffer,
+		listTrades:  listTrades,
+		logger:      logger,
+	}
+}
+
+// List returns the wallet's open offers
+// GET /api/v1/wallets/{id}/offers
+func (h *OfferHandler) List(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	cursor, limit := parseCursorPagination(r)
+	output, err := h.listOffers.Execute(r.Context(), offer.ListOffersInput{
+		WalletID: walletID,
+		Cursor:   cursor,
+		Limit:    limit,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_offers")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Place places a new sell, buy or passive sell offer
+// POST /api/v1/wallets/{id}/offers
+func (h *OfferHandler) Place(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input offer.PlaceOfferInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.WalletID = walletID
+	input.UserID, _ = middleware.GetUserIDFromContext(r.Context())
+
+	if input.Amount == "" || input.Price == "" {
+		response.Error(w, http.StatusBadRequest, errMsgOfferTermsRequired)
+		return
+	}
+
+	output, err := h.placeOffer.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "place_offer")
+		return
+	}
+
+	h.logger.Info("offer placed successfully",
+		zap.String("wallet_id", walletID.String()),
+		zap.Int64("offer_id", output.OfferID),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusCreated, output)
+}
+
+// Get returns an offer's status, tracked submissions and fills
+// GET /api/v1/wallets/{id}/offers/{offer_id}
+func (h *OfferHandler) Get(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	walletID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	output, err := h.getOffer.Execute(r.Context(), walletID, vars["offer_id"])
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_offer")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Update changes the amount and price of an open offer
+// PUT /api/v1/wallets/{id}/offers/{offer_id}
+func (h *OfferHandler) Update(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	walletID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input offer.UpdateOfferInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.WalletID = walletID
+	input.OfferID = vars["offer_id"]
+	input.UserID, _ = middleware.GetUserIDFromContext(r.Context())
+
+	if input.Amount == "" || input.Price == "" {
+		response.Error(w, http.StatusBadRequest, errMsgOfferTermsRequired)
+		return
+	}
+
+	output, err := h.updateOffer.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "update_offer")
+		return
+	}
+
+	h.logger.Info("offer updated successfully",
+		zap.String("wallet_id", walletID.String()),
+		zap.String("offer_id", input.OfferID),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// Cancel deletes an open offer
+// DELETE /api/v1/wallets/{id}/offers/{offer_id}
+func (h *OfferHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	walletID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	input := offer.CancelOfferInput{
+		WalletID: walletID,
+		OfferID:  vars["offer_id"],
+	}
+	// The body is optional and only carries max_fee
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+			return
+		}
+		input.WalletID = walletID
+		input.OfferID = vars["offer_id"]
+	}
+
+	output, err := h.cancelOffer.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "cancel_offer")
+		return
+	}
+
+	h.logger.Info("offer cancelled successfully",
+		zap.String("wallet_id", walletID.String()),
+		zap.String("offer_id", input.OfferID),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// Trades returns the wallet's fills, optionally for a single offer
+// GET /api/v1/wallets/{id}/trades
+func (h *OfferHandler) Trades(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	cursor, limit := parseCursorPagination(r)
+	output, err := h.listTrades.Execute(r.Context(), offer.ListTradesInput{
+		WalletID: walletID,
+		OfferID:  r.URL.Query().Get("offer_id"),
+		Cursor:   cursor,
+		Limit:    limit,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_trades")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *OfferHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
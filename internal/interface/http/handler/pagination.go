@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// parsePagination parses limit and offset query parameters, falling back to the defaults
+func parsePagination(r *http.Request) (limit, offset int) {
+	limit = defaultLimit
+	if limitStr := r.URL.Query().Get(paramLimit); limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset = defaultOffset
+	if offsetStr := r.URL.Query().Get(paramOffset); offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	return limit, offset
+}
+
+// maxHorizonLimit is the largest page Horizon serves
+const maxHorizonLimit = 200
+
+// parseCursorPagination parses cursor and limit query parameters for Horizon-backed lists
+func parseCursorPagination(r *http.Request) (cursor string, limit uint) {
+	limit = defaultLimit
+	if limitStr := r.URL.Query().Get(paramLimit); limitStr != "" {
+		if parsed, err := strconv.ParseUint(limitStr, 10, 32); err == nil && parsed > 0 {
+			limit = uint(parsed)
+		}
+	}
+	if limit > maxHorizonLimit {
+		limit = maxHorizonLimit
+	}
+
+	return r.URL.Query().Get(paramCursor), limit
+}
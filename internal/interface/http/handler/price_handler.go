@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/pricing"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// PriceHandler serves asset prices in reference currencies
+type PriceHandler struct {
+	getPrice *pricing.GetPriceUseCase
+	logger   logger.Logger
+}
+
+// NewPriceHandler creates a new price handler
+func NewPriceHandler(getPrice *pricing.GetPriceUseCase, logger logger.Logger) *PriceHandler {
+	return &PriceHandler{
+		getPrice: getPrice,
+		logger:   logger,
+	}
+}
+
+// Get returns the price of an asset
+// GET /api/v1/prices?asset=CODE:ISSUER&currency=USD
+func (h *PriceHandler) Get(w http.ResponseWriter, r *http.Request) {
+	asset := r.URL.Query().Get("asset")
+	if asset == "" {
+		response.Error(w, http.StatusBadRequest, "asset is required")
+		return
+	}
+
+	currency := r.URL.Query().Get("currency")
+	if currency == "" {
+		currency = "USD"
+	}
+
+	output, err := h.getPrice.Execute(r.Context(), asset, currency)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_price")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *PriceHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
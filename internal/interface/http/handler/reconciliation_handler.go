@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/reconciliation"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// ReconciliationHandler exposes reconciliation reports to admins and runs reconciliations on demand
+type ReconciliationHandler struct {
+	reconcile   *reconciliation.ReconcileUseCase
+	listReports *reconciliation.ListReportsUseCase
+	getReport   *reconciliation.GetReportUseCase
+	logger      logger.Logger
+}
+
+// NewReconciliationHandler creates a new reconciliation handler
+func NewReconciliationHandler(
+	reconcile *reconciliation.ReconcileUseCase,
+	listReports *reconciliation.ListReportsUseCase,
+	getReport *reconciliation.GetReportUseCase,
+	logger logger.Logger,
+) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		reconcile:   reconcile,
+		listReports: listReports,
+		getReport:   getReport,
+		logger:      logger,
+	}
+}
+
+// ListReports returns stored reports, newest first
+// GET /api/v1/admin/reconciliation/reports?wallet_id=...&status=discrepancies
+func (h *ReconciliationHandler) ListReports(w http.ResponseWriter, r *http.Request) {
+	var walletID uuid.UUID
+	if value := r.URL.Query().Get("wallet_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+			return
+		}
+		walletID = id
+	}
+
+	limit, offset := parsePagination(r)
+	output, err := h.listReports.Execute(r.Context(), reconciliation.ListReportsInput{
+		WalletID: walletID,
+		Status:   r.URL.Query().Get("status"),
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_reconciliation_reports")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// GetReport returns a report with its discrepancies
+// GET /api/v1/admin/reconciliation/reports/{id}
+func (h *ReconciliationHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid report ID")
+		return
+	}
+
+	output, err := h.getReport.Execute(r.Context(), id)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_reconciliation_report")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Run reconciles a wallet now and returns the new report
+// POST /api/v1/admin/reconciliation/wallets/{id}
+func (h *ReconciliationHandler) Run(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	output, err := h.reconcile.ReconcileWallet(r.Context(), id)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "reconcile_wallet")
+		return
+	}
+
+	response.Success(w, http.StatusCreated, output)
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *ReconciliationHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
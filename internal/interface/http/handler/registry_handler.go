@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/registry"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// RegistryHandler exposes asset metadata and the admin allow-list of verified assets
+type RegistryHandler struct {
+	getAssetInfo   *registry.GetAssetInfoUseCase
+	verifiedAssets *registry.ManageVerifiedAssetsUseCase
+	logger         logger.Logger
+}
+
+// NewRegistryHandler creates a new registry handler
+func NewRegistryHandler(
+	getAssetInfo *registry.GetAssetInfoUseCase,
+	verifiedAssets *registry.ManageVerifiedAssetsUseCase,
+	logger logger.Logger,
+) *RegistryHandler {
+	return &RegistryHandler{
+		getAssetInfo:   getAssetInfo,
+		verifiedAssets: verifiedAssets,
+		logger:         logger,
+	}
+}
+
+// GetAssetInfo returns SEP-1 metadata and verification status for an asset
+// GET /api/v1/registry/assets/{code}/{issuer}
+func (h *RegistryHandler) GetAssetInfo(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	output, err := h.getAssetInfo.Execute(r.Context(), vars["code"], vars["issuer"])
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_asset_info")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// ListVerified returns the allow-list
+// GET /api/v1/admin/registry/verified
+func (h *RegistryHandler) ListVerified(w http.ResponseWriter, r *http.Request) {
+	output, err := h.verifiedAssets.List(r.Context())
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_verified_assets")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// AddVerified adds an asset to the allow-list
+// POST /api/v1/admin/registry/verified
+func (h *RegistryHandler) AddVerified(w http.ResponseWriter, r *http.Request) {
+	var input registry.VerifyAssetInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+
+	if input.Code == "" || input.Issuer == "" {
+		response.Error(w, http.StatusBadRequest, "code and issuer are required")
+		return
+	}
+
+	output, err := h.verifiedAssets.Add(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "add_verified_asset")
+		return
+	}
+
+	h.logger.Info("verified asset added",
+		zap.String("code", output.Code),
+		zap.String("issuer", output.Issuer),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusCreated, output)
+}
+
+// RemoveVerified removes an asset from the allow-list
+// DELETE /api/v1/admin/registry/verified/{code}/{issuer}
+func (h *RegistryHandler) RemoveVerified(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	if err := h.verifiedAssets.Remove(r.Context(), vars["code"], vars["issuer"]); err != nil {
+		h.handleUseCaseError(w, r, err, "remove_verified_asset")
+		return
+	}
+
+	h.logger.Info("verified asset removed",
+		zap.String("code", vars["code"]),
+		zap.String("issuer", vars["issuer"]),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, map[string]string{"message": "Verified asset removed"})
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *RegistryHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
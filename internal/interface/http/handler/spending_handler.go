@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/middleware"
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/spending"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// SpendingHandler manages spending policies for admins and reports remaining allowances
+type SpendingHandler struct {
+	setPolicy    *spending.SetPolicyUseCase
+	listPolicies *spending.ListPoliciesUseCase
+	deletePolicy *spending.DeletePolicyUseCase
+	getLimits    *spending.GetLimitsUseCase
+	logger       logger.Logger
+}
+
+// NewSpendingHandler creates a new spending handler
+func NewSpendingHandler(
+	setPolicy *spending.SetPolicyUseCase,
+	listPolicies *spending.ListPoliciesUseCase,
+	deletePolicy *spending.DeletePolicyUseCase,
+	getLimits *spending.GetLimitsUseCase,
+	logger logger.Logger,
+) *SpendingHandler {
+	return &SpendingHandler{
+		setPolicy:    setPolicy,
+		listPolicies: listPolicies,
+		deletePolicy: deletePolicy,
+		getLimits:    getLimits,
+		logger:       logger,
+	}
+}
+
+// GetLimits returns the limits on payments from a wallet by the caller and what remains of them
+// GET /api/v1/wallets/{id}/limits
+func (h *SpendingHandler) GetLimits(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+	userID, _ := middleware.GetUserIDFromContext(r.Context())
+
+	output, err := h.getLimits.Execute(r.Context(), id, userID)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_spending_limits")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// SetPolicy creates or replaces the policy of a wallet or user for an asset
+// PUT /api/v1/admin/spending-policies
+func (h *SpendingHandler) SetPolicy(w http.ResponseWriter, r *http.Request) {
+	var input spending.SetPolicyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+
+	output, err := h.setPolicy.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "set_spending_policy")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// ListPolicies returns the policies of a scope
+// GET /api/v1/admin/spending-policies?scope=wallet&subject_id=...
+func (h *SpendingHandler) ListPolicies(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listPolicies.Execute(r.Context(), r.URL.Query().Get("scope"), r.URL.Query().Get("subject_id"))
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_spending_policies")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// DeletePolicy removes a policy
+// DELETE /api/v1/admin/spending-policies/{id}
+func (h *SpendingHandler) DeletePolicy(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid policy ID")
+		return
+	}
+
+	if err := h.deletePolicy.Execute(r.Context(), id); err != nil {
+		h.handleUseCaseError(w, r, err, "delete_spending_policy")
+		return
+	}
+
+	response.Success(w, http.StatusOK, map[string]string{"message": "Spending policy deleted"})
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *SpendingHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/middleware"
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/sponsorship"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const errMsgInvalidSponsorshipID = "invalid sponsorship id"
+
+// SponsorshipHandler manages reserves paid by sponsor wallets for other managed wallets
+type SponsorshipHandler struct {
+	sponsor          *sponsorship.SponsorUseCase
+	revoke           *sponsorship.RevokeUseCase
+	transfer         *sponsorship.TransferUseCase
+	getSponsorship   *sponsorship.GetSponsorshipUseCase
+	listSponsorships *sponsorship.ListSponsorshipsUseCase
+	logger           logger.Logger
+}
+
+// NewSponsorshipHandler creates a new sponsorship handler
+func NewSponsorshipHandler(
+	sponsor *sponsorship.SponsorUseCase,
+	revoke *sponsorship.RevokeUseCase,
+	transfer *sponsorship.TransferUseCase,
+	getSponsorship *sponsorship.GetSponsorshipUseCase,
+	listSponsorships *sponsorship.ListSponsorshipsUseCase,
+	logger logger.Logger,
+) *SponsorshipHandler {
+	return &SponsorshipHandler{
+		sponsor:          sponsor,
+		revoke:           revoke,
+		transfer:         transfer,
+		getSponsorship:   getSponsorship,
+		listSponsorships: listSponsorships,
+		logger:           logger,
+	}
+}
+
+// Sponsor creates a sponsored account or trustline paid for by the wallet
+// POST /api/v1/wallets/{id}/sponsorships
+func (h *SponsorshipHandler) Sponsor(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input sponsorship.SponsorInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.SponsorWalletID = walletID
+	input.UserID, _ = middleware.GetUserIDFromContext(r.Context())
+
+	if input.Type == "" || input.SponsoredWalletID == uuid.Nil {
+		response.Error(w, http.StatusBadRequest, "type and sponsored_wallet_id are required")
+		return
+	}
+
+	output, err := h.sponsor.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "sponsor_reserve")
+		return
+	}
+
+	h.logger.Info("reserve sponsored successfully",
+		zap.String("sponsorship_id", output.Sponsorship.ID),
+		zap.String("type", output.Sponsorship.Type),
+		zap.String("sponsor_wallet_id", walletID.String()),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusCreated, output)
+}
+
+// List returns sponsorships the wallet pays for, or benefits from with role=sponsored
+// GET /api/v1/wallets/{id}/sponsorships?role=sponsor&status=active&limit=10&offset=0
+func (h *SponsorshipHandler) List(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	limit, offset := parsePagination(r)
+	output, err := h.listSponsorships.Execute(r.Context(), sponsorship.ListSponsorshipsInput{
+		WalletID: walletID,
+		Role:     r.URL.Query().Get("role"),
+		Status:   r.URL.Query().Get("status"),
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_sponsorships")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// GetByID returns a single sponsorship
+// GET /api/v1/sponsorships/{id}
+func (h *SponsorshipHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseSponsorshipID(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getSponsorship.Execute(r.Context(), id)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_sponsorship")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Revoke ends a sponsorship; the sponsored account pays the reserve from then on
+// POST /api/v1/sponsorships/{id}/revoke
+func (h *SponsorshipHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseSponsorshipID(w, r)
+	if !ok {
+		return
+	}
+
+	var input sponsorship.RevokeInput
+	// The body is optional and only carries max_fee
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+			return
+		}
+	}
+	input.SponsorshipID = id
+
+	output, err := h.revoke.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "revoke_sponsorship")
+		return
+	}
+
+	h.logger.Info("sponsorship revoked successfully",
+		zap.String("sponsorship_id", id.String()),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// Transfer moves a sponsorship to another sponsor wallet
+// POST /api/v1/sponsorships/{id}/transfer
+func (h *SponsorshipHandler) Transfer(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseSponsorshipID(w, r)
+	if !ok {
+		return
+	}
+
+	var input sponsorship.TransferInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.SponsorshipID = id
+
+	if input.NewSponsorWalletID == uuid.Nil {
+		response.Error(w, http.StatusBadRequest, "new_sponsor_wallet_id is required")
+		return
+	}
+
+	output, err := h.transfer.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "transfer_sponsorship")
+		return
+	}
+
+	h.logger.Info("sponsorship transferred successfully",
+		zap.String("sponsorship_id", id.String()),
+		zap.String("replacement_id", output.Sponsorship.ID),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+func parseSponsorshipID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidSponsorshipID)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *SponsorshipHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/balance"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// Headers carrying a statement's checksum. The digest is only known once the whole
+// file is written, so it is sent as a trailer.
+const (
+	headerStatementSHA256    = "X-Statement-SHA256"
+	headerStatementSignature = "X-Statement-Signature"
+	headerStatementSigner    = "X-Statement-Signer"
+)
+
+// maxStatementUploadSize bounds files sent for verification
+const maxStatementUploadSize = 256 << 20
+
+// StatementHandler exports wallet statements and verifies exported files
+type StatementHandler struct {
+	export *balance.ExportStatementUseCase
+	verify *balance.VerifyStatementUseCase
+	logger logger.Logger
+}
+
+// NewStatementHandler creates a new statement handler
+func NewStatementHandler(
+	export *balance.ExportStatementUseCase,
+	verify *balance.VerifyStatementUseCase,
+	logger logger.Logger,
+) *StatementHandler {
+	return &StatementHandler{
+		export: export,
+		verify: verify,
+		logger: logger,
+	}
+}
+
+// Export streams the wallet's statement for a period
+// GET /api/v1/wallets/{id}/statement?from=2025-03-01&to=2025-03-31&format=csv
+func (h *StatementHandler) Export(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	from, err := parseHistoryTime(r.URL.Query().Get("from"), false)
+	if err != nil || from == nil {
+		response.Error(w, http.StatusBadRequest, "invalid from: use RFC 3339 or YYYY-MM-DD")
+		return
+	}
+	to, err := parseHistoryTime(r.URL.Query().Get("to"), true)
+	if err != nil || to == nil {
+		response.Error(w, http.StatusBadRequest, "invalid to: use RFC 3339 or YYYY-MM-DD")
+		return
+	}
+
+	statement, err := h.export.Prepare(r.Context(), balance.ExportStatementInput{
+		WalletID: id,
+		From:     *from,
+		To:       *to,
+		Format:   r.URL.Query().Get("format"),
+		Asset:    r.URL.Query().Get("asset"),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "export_statement")
+		return
+	}
+
+	w.Header().Set("Content-Type", statement.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="`+statement.Filename()+`"`)
+	w.Header().Set("Trailer", headerStatementSHA256+", "+headerStatementSignature)
+	if signer := h.export.Signer(); signer != "" {
+		w.Header().Set(headerStatementSigner, signer)
+	}
+	w.WriteHeader(http.StatusOK)
+
+	// Large periods take longer than the server's WriteTimeout; every write extends the deadline
+	checksum, err := statement.WriteTo(r.Context(), &deadlineWriter{w: w, rc: http.NewResponseController(w)})
+	if err != nil {
+		// The status is sent already; the missing trailer marks the file incomplete
+		h.logger.Warn("statement export failed",
+			zap.String("wallet_id", id.String()),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		return
+	}
+
+	w.Header().Set(headerStatementSHA256, checksum.SHA256)
+	w.Header().Set(headerStatementSignature, checksum.Signature)
+}
+
+// Verify checks an exported file, sent as the request body, against its signature
+// POST /api/v1/statements/verify?signature=...&signer=G...
+func (h *StatementHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	signature := r.URL.Query().Get("signature")
+	if signature == "" {
+		signature = r.Header.Get(headerStatementSignature)
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxStatementUploadSize)
+	output, err := h.verify.Execute(r.Context(), body, signature, r.URL.Query().Get("signer"))
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "verify_statement")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// deadlineWriter extends the response's write deadline before each write
+type deadlineWriter struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+func (d *deadlineWriter) Write(p []byte) (int, error) {
+	if err := d.rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return 0, err
+	}
+	return d.w.Write(p)
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *StatementHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
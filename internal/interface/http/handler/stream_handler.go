@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"quasarflow-api/internal/interface/http/middleware"
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/event"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// streamWriteTimeout bounds each write of a stream; the deadline is extended before
+	// every write, so long-lived streams outlive the server's WriteTimeout
+	streamWriteTimeout = 10 * time.Second
+
+	// sseRetry is how long EventSource clients wait before reconnecting, in milliseconds
+	sseRetry = 3000
+)
+
+// StreamHandler streams wallet activity over Server-Sent Events or WebSocket
+type StreamHandler struct {
+	stream         *event.StreamUseCase
+	allowedOrigins []string
+	logger         logger.Logger
+}
+
+// NewStreamHandler creates a new stream handler. Browser clients are only served from
+// allowedOrigins, the origins allowed by CORS.
+func NewStreamHandler(stream *event.StreamUseCase, allowedOrigins []string, logger logger.Logger) *StreamHandler {
+	return &StreamHandler{
+		stream:         stream,
+		allowedOrigins: allowedOrigins,
+		logger:         logger,
+	}
+}
+
+// Stream sends the wallet's events and balance changes. Requests with a WebSocket
+// upgrade get a WebSocket, all others Server-Sent Events.
+// GET /api/v1/wallets/{id}/stream?currency=USD&last_event_id=...
+func (h *StreamHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	if err := h.checkOrigin(r); err != nil {
+		h.logger.Warn("stream origin rejected",
+			zap.String("wallet_id", id.String()),
+			zap.String("origin", r.Header.Get("Origin")),
+			zap.String("ip", r.RemoteAddr))
+		response.Error(w, http.StatusForbidden, "Origin not allowed")
+		return
+	}
+
+	// EventSource sends the header on reconnect; the query parameter serves WebSocket
+	// clients and first connections that resume from a stored ID
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+
+	stream, err := h.stream.Open(r.Context(), event.OpenStreamInput{
+		WalletID:    id,
+		LastEventID: lastEventID,
+		Currency:    r.URL.Query().Get("currency"),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "open_wallet_stream")
+		return
+	}
+
+	h.logger.Info("wallet stream opened",
+		zap.String("wallet_id", id.String()),
+		zap.Bool("websocket", isWebSocketUpgrade(r)),
+		zap.Bool("resumed", lastEventID != ""),
+		zap.String("ip", r.RemoteAddr))
+
+	if isWebSocketUpgrade(r) {
+		h.serveWebSocket(w, r, id, stream)
+		return
+	}
+	h.serveSSE(w, r, id, stream)
+}
+
+func (h *StreamHandler) serveSSE(w http.ResponseWriter, r *http.Request, walletID uuid.UUID, stream *event.Stream) {
+	rc := http.NewResponseController(w)
+	write := func(frame string) error {
+		if err := rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, frame); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable proxy buffering
+	w.WriteHeader(http.StatusOK)
+
+	if err := write(fmt.Sprintf("retry: %d\n\n", sseRetry)); err != nil {
+		stream.Close()
+		h.logStreamClosed(walletID, r, err)
+		return
+	}
+
+	err := stream.Run(r.Context(), func(m event.StreamMessage) error {
+		if m.Type == event.MessageHeartbeat {
+			return write(": heartbeat\n\n")
+		}
+
+		data, err := json.Marshal(m.Data)
+		if err != nil {
+			return err
+		}
+
+		var frame strings.Builder
+		if m.ID != "" {
+			fmt.Fprintf(&frame, "id: %s\n", m.ID)
+		}
+		fmt.Fprintf(&frame, "event: %s\ndata: %s\n\n", m.Type, data)
+		return write(frame.String())
+	})
+	h.logStreamClosed(walletID, r, err)
+}
+
+func (h *StreamHandler) serveWebSocket(w http.ResponseWriter, r *http.Request, walletID uuid.UUID, stream *event.Stream) {
+	// The handler does not run if the handshake fails
+	defer stream.Close()
+
+	websocket.Server{
+		// WebSocket is not covered by CORS, so the handshake repeats the origin check
+		Handshake: func(_ *websocket.Config, req *http.Request) error {
+			return h.checkOrigin(req)
+		},
+		Handler: func(ws *websocket.Conn) {
+			defer ws.Close()
+
+			ctx, cancel := context.WithCancel(r.Context())
+			defer cancel()
+
+			// The hijacked connection keeps the server's read deadline. Clear it and
+			// discard client messages, ending the stream when the client goes away.
+			if err := ws.SetReadDeadline(time.Time{}); err != nil {
+				stream.Close()
+				h.logStreamClosed(walletID, r, err)
+				return
+			}
+			go func() {
+				defer cancel()
+				_, _ = io.Copy(io.Discard, ws)
+			}()
+
+			err := stream.Run(ctx, func(m event.StreamMessage) error {
+				if err := ws.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil {
+					return err
+				}
+				return websocket.JSON.Send(ws, m)
+			})
+			h.logStreamClosed(walletID, r, err)
+		},
+	}.ServeHTTP(w, r)
+}
+
+func (h *StreamHandler) logStreamClosed(walletID uuid.UUID, r *http.Request, err error) {
+	fields := []zap.Field{
+		zap.String("wallet_id", walletID.String()),
+		zap.String("ip", r.RemoteAddr),
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+	h.logger.Info("wallet stream closed", fields...)
+}
+
+// checkOrigin rejects browser requests from origins that CORS does not allow. Requests
+// without an Origin header come from non-browser clients and are accepted.
+func (h *StreamHandler) checkOrigin(r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" || middleware.IsOriginAllowed(origin, h.allowedOrigins) {
+		return nil
+	}
+	return fmt.Errorf("origin %s is not allowed", origin)
+}
+
+func isWebSocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *StreamHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/submission"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// SubmissionHandler exposes the status of transactions submitted for managed wallets
+type SubmissionHandler struct {
+	getSubmission   *submission.GetSubmissionUseCase
+	listSubmissions *submission.ListSubmissionsUseCase
+	logger          logger.Logger
+}
+
+// NewSubmissionHandler creates a new submission handler
+func NewSubmissionHandler(
+	getSubmission *submission.GetSubmissionUseCase,
+	listSubmissions *submission.ListSubmissionsUseCase,
+	logger logger.Logger,
+) *SubmissionHandler {
+	return &SubmissionHandler{
+		getSubmission:   getSubmission,
+		listSubmissions: listSubmissions,
+		logger:          logger,
+	}
+}
+
+// GetByID returns a single submission
+// GET /api/v1/submissions/{id}
+func (h *SubmissionHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid submission id")
+		return
+	}
+
+	output, err := h.getSubmission.Execute(r.Context(), id)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_submission")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// ListByWallet returns a wallet's submissions, newest first
+// GET /api/v1/wallets/{id}/submissions
+func (h *SubmissionHandler) ListByWallet(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	limit, offset := parsePagination(r)
+
+	output, err := h.listSubmissions.Execute(r.Context(), walletID, limit, offset)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_submissions")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *SubmissionHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
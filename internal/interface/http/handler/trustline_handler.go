@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/wallet"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const errMsgAssetRequired = "asset_code and asset_issuer are required"
+
+// TrustlineHandler manages trustlines of managed wallets
+type TrustlineHandler struct {
+	addTrustline    *wallet.AddTrustlineUseCase
+	removeTrustline *wallet.RemoveTrustlineUseCase
+	listTrustlines  *wallet.ListTrustlinesUseCase
+	logger          logger.Logger
+}
+
+// NewTrustlineHandler creates a new trustline handler
+func NewTrustlineHandler(
+	addTrustline *wallet.AddTrustlineUseCase,
+	removeTrustline *wallet.RemoveTrustlineUseCase,
+	listTrustlines *wallet.ListTrustlinesUseCase,
+	logger logger.Logger,
+) *TrustlineHandler {
+	return &TrustlineHandler{
+		addTrustline:    addTrustline,
+		removeTrustline: removeTrustline,
+		listTrustlines:  listTrustlines,
+		logger:          logger,
+	}
+}
+
+// List returns the wallet's trustlines
+// GET /api/v1/wallets/{id}/trustlines
+func (h *TrustlineHandler) List(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	output, err := h.listTrustlines.Execute(r.Context(), walletID)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_trustlines")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Add creates a trustline or changes its limit
+// POST /api/v1/wallets/{id}/trustlines
+func (h *TrustlineHandler) Add(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input wallet.AddTrustlineInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.WalletID = walletID
+
+	if input.AssetCode == "" || input.AssetIssuer == "" {
+		response.Error(w, http.StatusBadRequest, errMsgAssetRequired)
+		return
+	}
+
+	output, err := h.addTrustline.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "add_trustline")
+		return
+	}
+
+	h.logger.Info("trustline changed successfully",
+		zap.String("wallet_id", walletID.String()),
+		zap.String("asset_code", input.AssetCode),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// Remove removes an empty trustline
+// DELETE /api/v1/wallets/{id}/trustlines
+func (h *TrustlineHandler) Remove(w http.ResponseWriter, r *http.Request) {
+	walletID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWalletID)
+		return
+	}
+
+	var input wallet.RemoveTrustlineInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.WalletID = walletID
+
+	if input.AssetCode == "" || input.AssetIssuer == "" {
+		response.Error(w, http.StatusBadRequest, errMsgAssetRequired)
+		return
+	}
+
+	output, err := h.removeTrustline.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "remove_trustline")
+		return
+	}
+
+	h.logger.Info("trustline removed successfully",
+		zap.String("wallet_id", walletID.String()),
+		zap.String("asset_code", input.AssetCode),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *TrustlineHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"quasarflow-api/internal/interface/http/middleware"
 	"quasarflow-api/internal/interface/http/response"
 	"quasarflow-api/internal/usecase/wallet"
 	pkgErrors "quasarflow-api/pkg/errors"
@@ -46,6 +49,7 @@ type WalletHandler struct {
 	fundWallet         *wallet.FundWalletUseCase
 	sendPayment        *wallet.SendPaymentUseCase
 	getTransactionHist *wallet.GetTransactionHistoryUseCase
+	closeWallet        *wallet.CloseWalletUseCase
 	logger             logger.Logger
 }
 
@@ -57,6 +61,7 @@ func NewWalletHandler(
 	fundWallet *wallet.FundWalletUseCase,
 	sendPayment *wallet.SendPaymentUseCase,
 	getTransactionHist *wallet.GetTransactionHistoryUseCase,
+	closeWallet *wallet.CloseWalletUseCase,
 	logger logger.Logger,
 ) *WalletHandler {
 	return &WalletHandler{
@@ -67,6 +72,7 @@ func NewWalletHandler(
 		fundWallet:         fundWallet,
 		sendPayment:        sendPayment,
 		getTransactionHist: getTransactionHist,
+		closeWallet:        closeWallet,
 		logger:             logger,
 	}
 }
@@ -115,7 +121,7 @@ func (h *WalletHandler) handleUseCaseError(w http.ResponseWriter, r *http.Reques
 			zap.String("error_type", string(appErr.Type)),
 			zap.String("ip", r.RemoteAddr),
 			zap.Error(err))
-		response.Error(w, appErr.StatusCode, appErr.Message)
+		response.AppError(w, appErr)
 		return
 	}
 
@@ -170,7 +176,10 @@ func (h *WalletHandler) GetBalance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	output, err := h.getBalance.Execute(r.Context(), id)
+	output, err := h.getBalance.Execute(r.Context(), wallet.GetBalanceInput{
+		WalletID: id,
+		Currency: r.URL.Query().Get("currency"),
+	})
 	if err != nil {
 		h.handleUseCaseError(w, r, err, "get_balance")
 		return
@@ -238,8 +247,9 @@ func (h *WalletHandler) SendPayment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Set the from wallet ID from the URL parameter
+	// Set the from wallet ID from the URL parameter, and the caller for user spending limits
 	input.FromWalletID = id
+	input.UserID, _ = middleware.GetUserIDFromContext(r.Context())
 
 	// Validate required fields
 	if input.ToAddress == "" {
@@ -263,6 +273,15 @@ func (h *WalletHandler) SendPayment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if output.PendingApproval {
+		h.logger.Info("payment held for approval",
+			zap.String("from_wallet_id", id.String()),
+			zap.String("approval_request_id", output.ApprovalRequestID),
+			zap.String("ip", r.RemoteAddr))
+		response.Success(w, http.StatusAccepted, output)
+		return
+	}
+
 	h.logger.Info("payment sent successfully",
 		zap.String("from_wallet_id", id.String()),
 		zap.String("to_address", input.ToAddress),
@@ -271,6 +290,40 @@ func (h *WalletHandler) SendPayment(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, http.StatusOK, output)
 }
 
+// Close empties and merges the wallet's account, then archives the wallet
+// POST /api/v1/wallets/{id}/close
+func (h *WalletHandler) Close(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.validateWalletID(w, r)
+	if !ok {
+		return
+	}
+
+	var input wallet.CloseWalletInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.logger.Warn("invalid request body for close wallet",
+			zap.String("wallet_id", id.String()),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.WalletID = id
+	input.UserID, _ = middleware.GetUserIDFromContext(r.Context())
+
+	output, err := h.closeWallet.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "close_wallet")
+		return
+	}
+
+	h.logger.Info("wallet closed successfully",
+		zap.String("wallet_id", id.String()),
+		zap.String("merged_into", output.MergedInto),
+		zap.Int("transactions", len(output.Transactions)),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
 func (h *WalletHandler) GetTransactionHistory(w http.ResponseWriter, r *http.Request) {
 	id, ok := h.validateWalletID(w, r)
 	if !ok {
@@ -296,6 +349,25 @@ func (h *WalletHandler) GetTransactionHistory(w http.ResponseWriter, r *http.Req
 		input.Cursor = cursor
 	}
 
+	// Filters
+	from, err := parseHistoryTime(r.URL.Query().Get("from"), false)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid from: use RFC 3339 or YYYY-MM-DD")
+		return
+	}
+	to, err := parseHistoryTime(r.URL.Query().Get("to"), true)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid to: use RFC 3339 or YYYY-MM-DD")
+		return
+	}
+	input.From = from
+	input.To = to
+	input.Asset = r.URL.Query().Get("asset")
+	input.Counterparty = r.URL.Query().Get("counterparty")
+	if types := r.URL.Query().Get("type"); types != "" {
+		input.Types = strings.Split(types, ",")
+	}
+
 	output, err := h.getTransactionHist.Execute(r.Context(), input)
 	if err != nil {
 		h.handleUseCaseError(w, r, err, "get_transaction_history")
@@ -322,3 +394,24 @@ func (h *WalletHandler) List(w http.ResponseWriter, r *http.Request) {
 		zap.String("ip", r.RemoteAddr))
 	response.Success(w, http.StatusOK, output)
 }
+
+// parseHistoryTime parses an RFC 3339 time or a date. A date used as the end of a
+// range includes the whole day.
+func parseHistoryTime(value string, endOfRange bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	if endOfRange {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
@@ -0,0 +1,318 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"quasarflow-api/internal/interface/http/middleware"
+	"quasarflow-api/internal/interface/http/response"
+	"quasarflow-api/internal/usecase/webhook"
+	pkgErrors "quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	errMsgInvalidWebhookID  = "invalid webhook id"
+	errMsgInvalidDeliveryID = "invalid delivery id"
+)
+
+// WebhookHandler manages the user's webhook endpoints and their delivery logs
+type WebhookHandler struct {
+	createEndpoint *webhook.CreateEndpointUseCase
+	listEndpoints  *webhook.ListEndpointsUseCase
+	getEndpoint    *webhook.GetEndpointUseCase
+	updateEndpoint *webhook.UpdateEndpointUseCase
+	deleteEndpoint *webhook.DeleteEndpointUseCase
+	listDeliveries *webhook.ListDeliveriesUseCase
+	getDelivery    *webhook.GetDeliveryUseCase
+	redeliver      *webhook.RedeliverUseCase
+	logger         logger.Logger
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(
+	createEndpoint *webhook.CreateEndpointUseCase,
+	listEndpoints *webhook.ListEndpointsUseCase,
+	getEndpoint *webhook.GetEndpointUseCase,
+	updateEndpoint *webhook.UpdateEndpointUseCase,
+	deleteEndpoint *webhook.DeleteEndpointUseCase,
+	listDeliveries *webhook.ListDeliveriesUseCase,
+	getDelivery *webhook.GetDeliveryUseCase,
+	redeliver *webhook.RedeliverUseCase,
+	logger logger.Logger,
+) *WebhookHandler {
+	return &WebhookHandler{
+		createEndpoint: createEndpoint,
+		listEndpoints:  listEndpoints,
+		getEndpoint:    getEndpoint,
+		updateEndpoint: updateEndpoint,
+		deleteEndpoint: deleteEndpoint,
+		listDeliveries: listDeliveries,
+		getDelivery:    getDelivery,
+		redeliver:      redeliver,
+		logger:         logger,
+	}
+}
+
+// Create registers a webhook endpoint; the signing secret is only returned here
+// POST /api/v1/webhooks
+func (h *WebhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var input webhook.CreateEndpointInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.OwnerID = ownerID
+
+	if input.URL == "" || len(input.EventTypes) == 0 {
+		response.Error(w, http.StatusBadRequest, "url and event_types are required")
+		return
+	}
+
+	output, err := h.createEndpoint.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "create_webhook")
+		return
+	}
+
+	h.logger.Info("webhook created successfully",
+		zap.String("webhook_id", output.ID),
+		zap.String("owner_id", ownerID),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusCreated, output)
+}
+
+// List returns the user's webhook endpoints
+// GET /api/v1/webhooks?limit=10&offset=0
+func (h *WebhookHandler) List(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(r)
+	output, err := h.listEndpoints.Execute(r.Context(), webhook.ListEndpointsInput{
+		OwnerID: ownerID,
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_webhooks")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// GetByID returns one of the user's webhook endpoints
+// GET /api/v1/webhooks/{id}
+func (h *WebhookHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getEndpoint.Execute(r.Context(), id, ownerID)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_webhook")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Update changes a webhook's URL, subscription or state, or rotates its secret
+// PATCH /api/v1/webhooks/{id}
+func (h *WebhookHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	var input webhook.UpdateEndpointInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidRequestBody)
+		return
+	}
+	input.ID = id
+	input.OwnerID = ownerID
+
+	output, err := h.updateEndpoint.Execute(r.Context(), input)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "update_webhook")
+		return
+	}
+
+	h.logger.Info("webhook updated successfully",
+		zap.String("webhook_id", id.String()),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, output)
+}
+
+// Delete removes a webhook endpoint and its delivery log
+// DELETE /api/v1/webhooks/{id}
+func (h *WebhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteEndpoint.Execute(r.Context(), id, ownerID); err != nil {
+		h.handleUseCaseError(w, r, err, "delete_webhook")
+		return
+	}
+
+	h.logger.Info("webhook deleted successfully",
+		zap.String("webhook_id", id.String()),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusOK, map[string]string{"message": "Webhook deleted"})
+}
+
+// ListDeliveries returns the webhook's delivery log; status=dead lists the dead-letter queue
+// GET /api/v1/webhooks/{id}/deliveries?status=dead&limit=10&offset=0
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(r)
+	output, err := h.listDeliveries.Execute(r.Context(), webhook.ListDeliveriesInput{
+		WebhookID: id,
+		OwnerID:   ownerID,
+		Status:    r.URL.Query().Get("status"),
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "list_webhook_deliveries")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// GetDelivery returns a delivery with its payload and attempt log
+// GET /api/v1/webhooks/{id}/deliveries/{delivery_id}
+func (h *WebhookHandler) GetDelivery(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+	id, deliveryID, ok := parseDeliveryIDs(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getDelivery.Execute(r.Context(), id, deliveryID, ownerID)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "get_webhook_delivery")
+		return
+	}
+
+	response.Success(w, http.StatusOK, output)
+}
+
+// Redeliver queues a delivered or dead delivery again
+// POST /api/v1/webhooks/{id}/deliveries/{delivery_id}/redeliver
+func (h *WebhookHandler) Redeliver(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+	id, deliveryID, ok := parseDeliveryIDs(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.redeliver.Execute(r.Context(), id, deliveryID, ownerID)
+	if err != nil {
+		h.handleUseCaseError(w, r, err, "redeliver_webhook")
+		return
+	}
+
+	h.logger.Info("webhook delivery requeued",
+		zap.String("webhook_id", id.String()),
+		zap.String("delivery_id", deliveryID.String()),
+		zap.String("ip", r.RemoteAddr))
+	response.Success(w, http.StatusAccepted, output)
+}
+
+// requireUserID returns the authenticated user that owns the webhooks being managed
+func requireUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok || userID == "" {
+		response.Error(w, http.StatusUnauthorized, "User not authenticated")
+		return "", false
+	}
+	return userID, true
+}
+
+func parseWebhookID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidWebhookID)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func parseDeliveryIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	deliveryID, err := uuid.Parse(mux.Vars(r)["delivery_id"])
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, errMsgInvalidDeliveryID)
+		return uuid.Nil, uuid.Nil, false
+	}
+	return id, deliveryID, true
+}
+
+// handleUseCaseError handles errors from use cases with appropriate HTTP status codes
+func (h *WebhookHandler) handleUseCaseError(w http.ResponseWriter, r *http.Request, err error, operation string) {
+	var appErr *pkgErrors.AppError
+	if errors.As(err, &appErr) {
+		h.logger.Warn("use case error",
+			zap.String("operation", operation),
+			zap.String("error_type", string(appErr.Type)),
+			zap.String("ip", r.RemoteAddr),
+			zap.Error(err))
+		response.AppError(w, appErr)
+		return
+	}
+
+	h.logger.Error("unexpected error",
+		zap.String("operation", operation),
+		zap.String("ip", r.RemoteAddr),
+		zap.Error(err))
+	response.Error(w, http.StatusInternalServerError, "Internal server error")
+}
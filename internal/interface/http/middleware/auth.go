@@ -26,6 +26,12 @@ const (
 	// JWT constants
 	BearerPrefix = "Bearer "
 	HMACMethod   = "HS256"
+
+	// StreamTokenAudience marks short-lived tokens that only open wallet streams
+	StreamTokenAudience = "stream"
+	// StreamTokenParam is the query parameter carrying a stream token, for browser
+	// EventSource and WebSocket clients that cannot send the Authorization header
+	StreamTokenParam = "access_token"
 )
 
 // JWTClaims represents the JWT claims structure
@@ -37,9 +43,10 @@ type JWTClaims struct {
 
 // AuthConfig holds authentication configuration
 type AuthConfig struct {
-	SecretKey     string
-	TokenDuration time.Duration
-	Issuer        string
+	SecretKey           string
+	TokenDuration       time.Duration
+	StreamTokenDuration time.Duration
+	Issuer              string
 }
 
 // AuthMiddleware handles JWT authentication
@@ -93,21 +100,76 @@ func (am *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 			return
 		}
 
-		// Add user information to context
-		ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
+		// Stream tokens travel in URLs, so they are not accepted for the rest of the API
+		if isStreamToken(claims) {
+			am.logger.Warn("stream token used outside a stream",
+				zap.String("user_id", claims.UserID),
+				zap.String("ip", r.RemoteAddr),
+				zap.String("path", r.URL.Path))
+			response.Error(w, errors.ErrInvalidToken.StatusCode, errors.ErrInvalidToken.Message)
+			return
+		}
+
+		am.serveAuthenticated(next, w, r, claims)
+	})
+}
+
+// RequireStreamAuth authenticates wallet streams. It accepts the Authorization header like
+// RequireAuth, or a stream token in the access_token query parameter for browser clients.
+func (am *AuthMiddleware) RequireStreamAuth(next http.Handler) http.Handler {
+	headerAuth := am.RequireAuth(next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenString := r.URL.Query().Get(StreamTokenParam)
+		if tokenString == "" || r.Header.Get("Authorization") != "" {
+			headerAuth.ServeHTTP(w, r)
+			return
+		}
 
-		// Log successful authentication
-		am.logger.Info("user authenticated",
-			zap.String("user_id", claims.UserID),
-			zap.String("role", claims.Role),
-			zap.String("ip", r.RemoteAddr))
+		// Only stream tokens are accepted in the URL, where they may end up in logs and history
+		claims, err := am.validateToken(tokenString)
+		if err == nil && !isStreamToken(claims) {
+			err = fmt.Errorf("token is not a stream token")
+		}
+		if err != nil {
+			am.logger.Warn("invalid stream token",
+				zap.Error(err),
+				zap.String("ip", r.RemoteAddr),
+				zap.String("path", r.URL.Path))
+			response.Error(w, errors.ErrInvalidToken.StatusCode, errors.ErrInvalidToken.Message)
+			return
+		}
 
-		// Call next handler with updated context
-		next.ServeHTTP(w, r.WithContext(ctx))
+		am.serveAuthenticated(next, w, r, claims)
 	})
 }
 
+// serveAuthenticated calls next with the user information of claims in the context
+func (am *AuthMiddleware) serveAuthenticated(next http.Handler, w http.ResponseWriter, r *http.Request, claims *JWTClaims) {
+	// Add user information to context
+	ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
+
+	// Log successful authentication
+	am.logger.Info("user authenticated",
+		zap.String("user_id", claims.UserID),
+		zap.String("role", claims.Role),
+		zap.String("ip", r.RemoteAddr))
+
+	// Call next handler with updated context
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// isStreamToken reports whether claims belong to a stream token
+func isStreamToken(claims *JWTClaims) bool {
+	for _, audience := range claims.Audience {
+		if audience == StreamTokenAudience {
+			return true
+		}
+	}
+	return false
+}
+
 // validateToken validates a JWT token and returns the claims
 func (am *AuthMiddleware) validateToken(tokenString string) (*JWTClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
@@ -159,6 +221,30 @@ func (am *AuthMiddleware) GenerateToken(userID, role string) (string, error) {
 	return token.SignedString([]byte(am.config.SecretKey))
 }
 
+// GenerateStreamToken generates a short-lived token that only opens wallet streams
+func (am *AuthMiddleware) GenerateStreamToken(userID, role string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(am.config.StreamTokenDuration)
+	claims := &JWTClaims{
+		UserID: userID,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    am.config.Issuer,
+			Subject:   userID,
+			Audience:  jwt.ClaimStrings{StreamTokenAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(am.config.SecretKey))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
 // RequireRole is a middleware that validates user roles
 func (am *AuthMiddleware) RequireRole(requiredRole string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -46,7 +46,7 @@ func CORS(config CORSConfig) func(http.Handler) http.Handler {
 			origin := r.Header.Get("Origin")
 
 			// Check if origin is allowed
-			if origin != "" && isOriginAllowed(origin, config.AllowedOrigins) {
+			if origin != "" && IsOriginAllowed(origin, config.AllowedOrigins) {
 				w.Header().Set("Access-Control-Allow-Origin", origin)
 			}
 
@@ -76,8 +76,8 @@ func CORS(config CORSConfig) func(http.Handler) http.Handler {
 	}
 }
 
-// isOriginAllowed checks if the origin is in the allowed list
-func isOriginAllowed(origin string, allowedOrigins []string) bool {
+// IsOriginAllowed checks if the origin is in the allowed list
+func IsOriginAllowed(origin string, allowedOrigins []string) bool {
 	for _, allowedOrigin := range allowedOrigins {
 		if origin == allowedOrigin {
 			return true
@@ -1,7 +1,11 @@
 package middleware
 
 import (
+	"bufio"
+	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"time"
 
 	"go.uber.org/zap"
@@ -28,6 +32,24 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 	return n, err
 }
 
+// Unwrap exposes the underlying writer to http.ResponseController, which streaming
+// handlers use to flush and extend write deadlines
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+// Hijack lets WebSocket handlers take over the connection
+func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := rw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer does not support hijacking")
+	}
+	if rw.statusCode == 0 {
+		rw.statusCode = http.StatusSwitchingProtocols
+	}
+	return hijacker.Hijack()
+}
+
 // Logger is a middleware that logs HTTP requests
 func Logger(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -51,7 +73,7 @@ func Logger(next http.Handler) http.Handler {
 		logger.Info("HTTP Request",
 			zap.String("method", r.Method),
 			zap.String("path", r.URL.Path),
-			zap.String("query", r.URL.RawQuery),
+			zap.String("query", redactQuery(r.URL)),
 			zap.Int("status", wrapped.statusCode),
 			zap.Duration("duration", duration),
 			zap.Int64("bytes", wrapped.written),
@@ -60,3 +82,13 @@ func Logger(next http.Handler) http.Handler {
 		)
 	})
 }
+
+// redactQuery returns the query with stream tokens hidden
+func redactQuery(u *url.URL) string {
+	query := u.Query()
+	if !query.Has(StreamTokenParam) {
+		return u.RawQuery
+	}
+	query.Set(StreamTokenParam, "REDACTED")
+	return query.Encode()
+}
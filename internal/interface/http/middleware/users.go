@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Demo roles issued to the built-in development users
+const (
+	RoleAdmin = "admin"
+	RoleUser  = "user"
+)
+
+// User is a principal allowed to log in. Its ID is the username, so every login of the
+// same user gets the same identity and different users never share one.
+type User struct {
+	ID           string
+	Role         string
+	passwordHash []byte
+}
+
+// Users holds the principals that can log in, by username
+type Users struct {
+	byName map[string]*User
+	// dummyHash is compared for unknown usernames so that they take as long as wrong passwords
+	dummyHash []byte
+}
+
+// ParseUsers reads users from AUTH_USERS entries of the form "username:role:bcrypt-hash".
+// Approvers are provisioned by giving them the approver role.
+func ParseUsers(entries []string) (*Users, error) {
+	users := newUsers()
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid user entry %q: want username:role:bcrypt-hash", redactEntry(entry))
+		}
+		if _, err := bcrypt.Cost([]byte(parts[2])); err != nil {
+			return nil, fmt.Errorf("invalid password hash for user %q: %w", parts[0], err)
+		}
+		if err := users.add(parts[0], parts[1], []byte(parts[2])); err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
+}
+
+// DemoUsers returns the development users admin/admin123 (admin role) and
+// user/user123 (user role), used only when AUTH_DEMO_USERS opts in to them
+func DemoUsers() (*Users, error) {
+	users := newUsers()
+	for _, u := range []struct{ name, role, password string }{
+		{"admin", RoleAdmin, "admin123"},
+		{"user", RoleUser, "user123"},
+	} {
+		hash, err := bcrypt.GenerateFromPassword([]byte(u.password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash demo password: %w", err)
+		}
+		if err := users.add(u.name, u.role, hash); err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
+}
+
+// Authenticate returns the user with the username if the password matches
+func (u *Users) Authenticate(username, password string) (*User, bool) {
+	user, ok := u.byName[username]
+	hash := u.dummyHash
+	if ok {
+		hash = user.passwordHash
+	}
+
+	matches := bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil
+	if !ok || !matches {
+		return nil, false
+	}
+	return user, true
+}
+
+// Len returns the number of users
+func (u *Users) Len() int {
+	return len(u.byName)
+}
+
+func newUsers() *Users {
+	// Any valid hash works; its password is never accepted because the username is unknown
+	dummy, _ := bcrypt.GenerateFromPassword([]byte("unknown-user"), bcrypt.DefaultCost)
+	return &Users{byName: make(map[string]*User), dummyHash: dummy}
+}
+
+func (u *Users) add(username, role string, hash []byte) error {
+	if _, exists := u.byName[username]; exists {
+		return fmt.Errorf("duplicate user %q", username)
+	}
+	u.byName[username] = &User{ID: username, Role: role, passwordHash: hash}
+	return nil
+}
+
+// redactEntry drops everything after the username so that hashes are not logged
+func redactEntry(entry string) string {
+	name, _, _ := strings.Cut(entry, ":")
+	return name + ":..."
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func hashPassword(t *testing.T, password string) string {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword() error = %v", err)
+	}
+	return string(hash)
+}
+
+func TestParseUsers(t *testing.T) {
+	hash := hashPassword(t, "secret")
+
+	tests := []struct {
+		name    string
+		entries []string
+		wantErr bool
+	}{
+		{name: "no users", entries: nil},
+		{name: "users with roles", entries: []string{"alice:user:" + hash, "bob:approver:" + hash}},
+		{name: "missing hash", entries: []string{"alice:user"}, wantErr: true},
+		{name: "empty username", entries: []string{":user:" + hash}, wantErr: true},
+		{name: "empty role", entries: []string{"alice::" + hash}, wantErr: true},
+		{name: "plain text password", entries: []string{"alice:user:secret"}, wantErr: true},
+		{name: "duplicate username", entries: []string{"alice:user:" + hash, "alice:admin:" + hash}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, err := ParseUsers(tt.entries)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseUsers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && users.Len() != len(tt.entries) {
+				t.Errorf("ParseUsers() has %d users, want %d", users.Len(), len(tt.entries))
+			}
+		})
+	}
+}
+
+func TestUsersAuthenticate(t *testing.T) {
+	users, err := ParseUsers([]string{
+		"alice:user:" + hashPassword(t, "alice-password"),
+		"bob:approver:" + hashPassword(t, "bob-password"),
+	})
+	if err != nil {
+		t.Fatalf("ParseUsers() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+		wantID   string // Empty when authentication fails
+		wantRole string
+	}{
+		{name: "user", username: "alice", password: "alice-password", wantID: "alice", wantRole: "user"},
+		{name: "approver", username: "bob", password: "bob-password", wantID: "bob", wantRole: "approver"},
+		{name: "wrong password", username: "alice", password: "bob-password"},
+		{name: "unknown user", username: "carol", password: "alice-password"},
+		{name: "empty password", username: "alice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, ok := users.Authenticate(tt.username, tt.password)
+			if tt.wantID == "" {
+				if ok {
+					t.Fatalf("Authenticate() = %+v, want failure", user)
+				}
+				return
+			}
+
+			if !ok {
+				t.Fatalf("Authenticate() failed, want user %s", tt.wantID)
+			}
+			if user.ID != tt.wantID || user.Role != tt.wantRole {
+				t.Errorf("Authenticate() = %s (%s), want %s (%s)", user.ID, user.Role, tt.wantID, tt.wantRole)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"net/http"
 	"time"
 
 	"quasarflow-api/internal/config"
@@ -16,6 +17,25 @@ func SetupRouter(
 	accountHandler *handler.AccountHandler,
 	healthHandler *handler.HealthHandler,
 	authHandler *handler.AuthHandler,
+	feeHandler *handler.FeeHandler,
+	submissionHandler *handler.SubmissionHandler,
+	federationHandler *handler.FederationHandler,
+	trustlineHandler *handler.TrustlineHandler,
+	assetHandler *handler.AssetHandler,
+	registryHandler *handler.RegistryHandler,
+	offerHandler *handler.OfferHandler,
+	liquidityPoolHandler *handler.LiquidityPoolHandler,
+	priceHandler *handler.PriceHandler,
+	claimableBalanceHandler *handler.ClaimableBalanceHandler,
+	sponsorshipHandler *handler.SponsorshipHandler,
+	webhookHandler *handler.WebhookHandler,
+	streamHandler *handler.StreamHandler,
+	balanceHistoryHandler *handler.BalanceHistoryHandler,
+	statementHandler *handler.StatementHandler,
+	reconciliationHandler *handler.ReconciliationHandler,
+	spendingHandler *handler.SpendingHandler,
+	addressBookHandler *handler.AddressBookHandler,
+	approvalHandler *handler.ApprovalHandler,
 	cfg *config.Config,
 	log logger.Logger,
 ) *mux.Router {
@@ -23,9 +43,10 @@ func SetupRouter(
 
 	// Initialize security middlewares
 	authConfig := middleware.AuthConfig{
-		SecretKey:     cfg.JWTSecret,
-		TokenDuration: parseDuration(cfg.JWTExpiration),
-		Issuer:        cfg.JWTIssuer,
+		SecretKey:           cfg.JWTSecret,
+		TokenDuration:       parseDuration(cfg.JWTExpiration),
+		StreamTokenDuration: parseDuration(cfg.StreamTokenTTL),
+		Issuer:              cfg.JWTIssuer,
 	}
 	authMiddleware := middleware.NewAuthMiddleware(authConfig, log)
 
@@ -62,6 +83,9 @@ func SetupRouter(
 	// Health check (public endpoint)
 	r.HandleFunc("/health", healthHandler.Check).Methods("GET")
 
+	// SEP-2 federation server (public), advertised as FEDERATION_SERVER in stellar.toml
+	r.HandleFunc("/federation", federationHandler.Lookup).Methods("GET")
+
 	// Authentication endpoints (public)
 	auth := r.PathPrefix("/auth").Subrouter()
 	auth.HandleFunc("/login", authHandler.Login).Methods("POST")
@@ -77,6 +101,10 @@ func SetupRouter(
 	accounts.HandleFunc("/{public_key}/balance", accountHandler.GetAccountBalance).Methods("GET")
 	accounts.HandleFunc("/{public_key}/transactions", accountHandler.GetAccountTransactionHistory).Methods("GET")
 
+	// Wallet activity stream, registered ahead of the API subrouter because browsers
+	// authenticate it with a stream token in the query instead of the Authorization header
+	r.Handle("/api/v1/wallets/{id}/stream", authMiddleware.RequireStreamAuth(http.HandlerFunc(streamHandler.Stream))).Methods("GET")
+
 	// API v1 (protected routes)
 	api := r.PathPrefix("/api/v1").Subrouter()
 
@@ -85,15 +113,127 @@ func SetupRouter(
 
 	// User info endpoint
 	api.HandleFunc("/me", authHandler.Me).Methods("GET")
+	api.HandleFunc("/stream-token", authHandler.StreamToken).Methods("POST")
+
+	// Network fee estimate used when building transactions
+	api.HandleFunc("/fees", feeHandler.GetEstimate).Methods("GET")
+
+	// Federation address resolution
+	api.HandleFunc("/federation/resolve", federationHandler.Resolve).Methods("GET")
 
 	// Wallet endpoints (all require authentication)
 	api.HandleFunc("/wallets", walletHandler.Create).Methods("POST")
 	api.HandleFunc("/wallets", walletHandler.List).Methods("GET")
 	api.HandleFunc("/wallets/{id}", walletHandler.GetByID).Methods("GET")
+	api.HandleFunc("/wallets/{id}/balance", balanceHistoryHandler.GetBalanceAt).Methods("GET").Queries("at", "{at}")
 	api.HandleFunc("/wallets/{id}/balance", walletHandler.GetBalance).Methods("GET")
+	api.HandleFunc("/wallets/{id}/balance/history", balanceHistoryHandler.Series).Methods("GET")
+	api.HandleFunc("/wallets/{id}/statement", statementHandler.Export).Methods("GET")
+	api.HandleFunc("/statements/verify", statementHandler.Verify).Methods("POST")
 	api.HandleFunc("/wallets/{id}/fund", walletHandler.Fund).Methods("POST")
 	api.HandleFunc("/wallets/{id}/payment", walletHandler.SendPayment).Methods("POST")
+	api.HandleFunc("/wallets/{id}/limits", spendingHandler.GetLimits).Methods("GET")
+	api.HandleFunc("/wallets/{id}/address-book", addressBookHandler.List).Methods("GET")
+	api.HandleFunc("/wallets/{id}/address-book", addressBookHandler.Add).Methods("POST")
+	api.HandleFunc("/wallets/{id}/address-book/mode", addressBookHandler.GetMode).Methods("GET")
+	api.HandleFunc("/wallets/{id}/address-book/mode", addressBookHandler.SetMode).Methods("PUT")
+	api.HandleFunc("/wallets/{id}/address-book/{entry_id}", addressBookHandler.Delete).Methods("DELETE")
 	api.HandleFunc("/wallets/{id}/transactions", walletHandler.GetTransactionHistory).Methods("GET")
+	api.HandleFunc("/wallets/{id}/close", walletHandler.Close).Methods("POST")
+	api.HandleFunc("/wallets/{id}/submissions", submissionHandler.ListByWallet).Methods("GET")
+	api.HandleFunc("/wallets/{id}/federation-name", federationHandler.AssignName).Methods("POST")
+	api.HandleFunc("/wallets/{id}/trustlines", trustlineHandler.List).Methods("GET")
+	api.HandleFunc("/wallets/{id}/trustlines", trustlineHandler.Add).Methods("POST")
+	api.HandleFunc("/wallets/{id}/trustlines", trustlineHandler.Remove).Methods("DELETE")
+
+	// DEX offer endpoints
+	api.HandleFunc("/wallets/{id}/offers", offerHandler.List).Methods("GET")
+	api.HandleFunc("/wallets/{id}/offers", offerHandler.Place).Methods("POST")
+	api.HandleFunc("/wallets/{id}/offers/{offer_id}", offerHandler.Get).Methods("GET")
+	api.HandleFunc("/wallets/{id}/offers/{offer_id}", offerHandler.Update).Methods("PUT")
+	api.HandleFunc("/wallets/{id}/offers/{offer_id}", offerHandler.Cancel).Methods("DELETE")
+	api.HandleFunc("/wallets/{id}/trades", offerHandler.Trades).Methods("GET")
+
+	// Liquidity pool endpoints
+	api.HandleFunc("/liquidity-pools/{pool_id}", liquidityPoolHandler.Get).Methods("GET")
+	api.HandleFunc("/wallets/{id}/liquidity-pools/deposit", liquidityPoolHandler.Deposit).Methods("POST")
+	api.HandleFunc("/wallets/{id}/liquidity-pools/{pool_id}/withdraw", liquidityPoolHandler.Withdraw).Methods("POST")
+
+	// Claimable balance endpoints
+	api.HandleFunc("/wallets/{id}/claimable-balances", claimableBalanceHandler.List).Methods("GET")
+	api.HandleFunc("/wallets/{id}/claimable-balances", claimableBalanceHandler.Create).Methods("POST")
+	api.HandleFunc("/wallets/{id}/claimable-balances/{balance_id}/claim", claimableBalanceHandler.Claim).Methods("POST")
+	api.HandleFunc("/wallets/{id}/claimable-balances/{balance_id}/reclaim", claimableBalanceHandler.Reclaim).Methods("POST")
+
+	// Sponsored reserve endpoints
+	api.HandleFunc("/wallets/{id}/sponsorships", sponsorshipHandler.List).Methods("GET")
+	api.HandleFunc("/wallets/{id}/sponsorships", sponsorshipHandler.Sponsor).Methods("POST")
+	api.HandleFunc("/sponsorships/{id}", sponsorshipHandler.GetByID).Methods("GET")
+	api.HandleFunc("/sponsorships/{id}/revoke", sponsorshipHandler.Revoke).Methods("POST")
+	api.HandleFunc("/sponsorships/{id}/transfer", sponsorshipHandler.Transfer).Methods("POST")
+
+	// Webhook endpoints, scoped to the authenticated user
+	api.HandleFunc("/webhooks", webhookHandler.Create).Methods("POST")
+	api.HandleFunc("/webhooks", webhookHandler.List).Methods("GET")
+	api.HandleFunc("/webhooks/{id}", webhookHandler.GetByID).Methods("GET")
+	api.HandleFunc("/webhooks/{id}", webhookHandler.Update).Methods("PATCH")
+	api.HandleFunc("/webhooks/{id}", webhookHandler.Delete).Methods("DELETE")
+	api.HandleFunc("/webhooks/{id}/deliveries", webhookHandler.ListDeliveries).Methods("GET")
+	api.HandleFunc("/webhooks/{id}/deliveries/{delivery_id}", webhookHandler.GetDelivery).Methods("GET")
+	api.HandleFunc("/webhooks/{id}/deliveries/{delivery_id}/redeliver", webhookHandler.Redeliver).Methods("POST")
+
+	// Address book of the authenticated user, applying to payments from any wallet
+	api.HandleFunc("/address-book", addressBookHandler.List).Methods("GET")
+	api.HandleFunc("/address-book", addressBookHandler.Add).Methods("POST")
+	api.HandleFunc("/address-book/mode", addressBookHandler.GetMode).Methods("GET")
+	api.HandleFunc("/address-book/mode", addressBookHandler.SetMode).Methods("PUT")
+	api.HandleFunc("/address-book/{entry_id}", addressBookHandler.Delete).Methods("DELETE")
+
+	// Payments held for approval; deciding them requires the approver role
+	requireApprover := authMiddleware.RequireRole(cfg.ApproverRole)
+	api.HandleFunc("/approvals", approvalHandler.List).Methods("GET")
+	api.HandleFunc("/approvals/{id}", approvalHandler.Get).Methods("GET")
+	api.Handle("/approvals/{id}/approve", requireApprover(http.HandlerFunc(approvalHandler.Approve))).Methods("POST")
+	api.Handle("/approvals/{id}/reject", requireApprover(http.HandlerFunc(approvalHandler.Reject))).Methods("POST")
+
+	// Asset issuance endpoints
+	api.HandleFunc("/assets", assetHandler.Issue).Methods("POST")
+	api.HandleFunc("/assets", assetHandler.List).Methods("GET")
+	api.HandleFunc("/assets/{id}", assetHandler.GetByID).Methods("GET")
+	api.HandleFunc("/assets/{id}/mint", assetHandler.Mint).Methods("POST")
+	api.HandleFunc("/assets/{id}/flags", assetHandler.SetFlags).Methods("PUT")
+	api.HandleFunc("/assets/{id}/authorize", assetHandler.Authorize).Methods("POST")
+	api.HandleFunc("/assets/{id}/clawback", assetHandler.Clawback).Methods("POST")
+	api.HandleFunc("/assets/{id}/lock", assetHandler.Lock).Methods("POST")
+
+	// Asset prices in reference currencies
+	api.HandleFunc("/prices", priceHandler.Get).Methods("GET")
+
+	// Asset registry (SEP-1 metadata and verification status)
+	api.HandleFunc("/registry/assets/{code}/{issuer}", registryHandler.GetAssetInfo).Methods("GET")
+
+	// Submission tracking endpoints
+	api.HandleFunc("/submissions/{id}", submissionHandler.GetByID).Methods("GET")
+
+	// Admin endpoints (require the admin role)
+	admin := api.PathPrefix("/admin").Subrouter()
+	admin.Use(authMiddleware.RequireRole("admin"))
+
+	admin.HandleFunc("/registry/verified", registryHandler.ListVerified).Methods("GET")
+	admin.HandleFunc("/registry/verified", registryHandler.AddVerified).Methods("POST")
+	admin.HandleFunc("/registry/verified/{code}/{issuer}", registryHandler.RemoveVerified).Methods("DELETE")
+
+	admin.HandleFunc("/reconciliation/reports", reconciliationHandler.ListReports).Methods("GET")
+	admin.HandleFunc("/reconciliation/reports/{id}", reconciliationHandler.GetReport).Methods("GET")
+	admin.HandleFunc("/reconciliation/wallets/{id}", reconciliationHandler.Run).Methods("POST")
+
+	admin.HandleFunc("/spending-policies", spendingHandler.ListPolicies).Methods("GET")
+	admin.HandleFunc("/spending-policies", spendingHandler.SetPolicy).Methods("PUT")
+	admin.HandleFunc("/spending-policies/{id}", spendingHandler.DeletePolicy).Methods("DELETE")
+
+	admin.HandleFunc("/approval-policies", approvalHandler.ListPolicies).Methods("GET")
+	admin.HandleFunc("/approval-policies", approvalHandler.SetPolicy).Methods("PUT")
+	admin.HandleFunc("/approval-policies/{id}", approvalHandler.DeletePolicy).Methods("DELETE")
 
 	return r
 }
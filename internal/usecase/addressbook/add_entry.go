@@ -0,0 +1,145 @@
+package addressbook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/addressbook"
+	"quasarflow-api/internal/domain/federation"
+	domainWallet "quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/strkey"
+)
+
+// AddEntryInput represents a new counterparty. Either address or federation_address is
+// required; a federation address is resolved, and its memo used unless one is given.
+type AddEntryInput struct {
+	Scope             string `json:"-"` // Set from the route
+	SubjectID         string `json:"-"`
+	CreatedBy         string `json:"-"`
+	Name              string `json:"name"`
+	Address           string `json:"address,omitempty"`
+	Memo              string `json:"memo,omitempty"`
+	MemoType          string `json:"memo_type,omitempty"` // text (default), id, hash or return
+	FederationAddress string `json:"federation_address,omitempty"`
+}
+
+// AddEntryUseCase adds a counterparty to an address book, usable after the cool-down
+type AddEntryUseCase struct {
+	entries    addressbook.Repository
+	wallets    domainWallet.Repository
+	federation federation.Resolver
+	coolDown   time.Duration
+	logger     logger.Logger
+}
+
+// NewAddEntryUseCase creates a new add entry use case
+func NewAddEntryUseCase(
+	entries addressbook.Repository,
+	wallets domainWallet.Repository,
+	federationResolver federation.Resolver,
+	coolDown time.Duration,
+	logger logger.Logger,
+) *AddEntryUseCase {
+	return &AddEntryUseCase{
+		entries:    entries,
+		wallets:    wallets,
+		federation: federationResolver,
+		coolDown:   coolDown,
+		logger:     logger,
+	}
+}
+
+// Execute validates and stores the entry
+func (uc *AddEntryUseCase) Execute(ctx context.Context, input AddEntryInput) (*EntryOutput, error) {
+	if err := checkWallet(ctx, uc.wallets, input.Scope, input.SubjectID); err != nil {
+		return nil, err
+	}
+
+	// 1. Resolve the federation address (SEP-2), which must agree with a given account ID
+	if input.FederationAddress != "" {
+		if !federation.IsAddress(input.FederationAddress) {
+			return nil, errors.NewValidationError("Invalid federation address", "federation_address must be name*domain")
+		}
+		record, err := uc.federation.Resolve(ctx, input.FederationAddress)
+		if err != nil {
+			return nil, err
+		}
+		if input.Address != "" && input.Address != record.AccountID {
+			return nil, errors.NewValidationError("Address conflicts with federation address",
+				fmt.Sprintf("%s resolves to %s", input.FederationAddress, record.AccountID))
+		}
+		input.Address = record.AccountID
+		input.FederationAddress = record.StellarAddress
+		if input.Memo == "" {
+			input.Memo, input.MemoType = record.Memo, record.MemoType
+		}
+	}
+
+	// 2. Validate the account ID and memo
+	if !strkey.IsValidEd25519PublicKey(input.Address) {
+		return nil, errors.NewValidationError("Invalid address", "address must be a Stellar account ID (G...)")
+	}
+	memoType, err := wallet.ValidateMemo(input.MemoType, input.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := addressbook.NewEntry(input.Scope, input.SubjectID, input.Name, input.Address, input.Memo, memoType,
+		input.FederationAddress, input.CreatedBy, uc.coolDown)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid address book entry", err.Error())
+	}
+
+	// 3. Names are unique within an address book
+	existing, err := uc.entries.List(ctx, entry.Scope, entry.SubjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list address book entries: %w", err)
+	}
+	for _, e := range existing {
+		if e.Name == entry.Name {
+			return nil, errors.ErrAddressBookEntryExists
+		}
+	}
+
+	if err := uc.entries.Create(ctx, entry); err != nil {
+		uc.logger.Error("failed to create address book entry", logger.Error(err))
+		return nil, fmt.Errorf("failed to create address book entry: %w", err)
+	}
+
+	uc.logger.Info("address book entry added",
+		logger.String("entry_id", entry.ID.String()),
+		logger.String("scope", entry.Scope),
+		logger.String("subject_id", entry.SubjectID),
+		logger.String("address", entry.Address),
+		logger.String("created_by", entry.CreatedBy),
+		logger.String("usable_at", entry.UsableAt.Format(time.RFC3339)))
+
+	output := toEntryOutput(entry, time.Now())
+	return &output, nil
+}
+
+// checkWallet checks that the wallet of a wallet address book exists and is not closed
+func checkWallet(ctx context.Context, wallets domainWallet.Repository, scope, subjectID string) error {
+	if scope != addressbook.ScopeWallet {
+		return nil
+	}
+
+	id, err := uuid.Parse(subjectID)
+	if err != nil {
+		return errors.NewValidationError("Invalid wallet ID", "wallet address books belong to a wallet ID")
+	}
+	w, err := wallets.FindByID(ctx, id)
+	if err != nil {
+		return errors.NewNotFoundError("Wallet not found")
+	}
+	if w.IsClosed() {
+		return errors.ErrWalletClosed
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package addressbook
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/addressbook"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// DeleteEntryUseCase removes a counterparty from an address book. Removal takes effect
+// at once; it can only narrow what may be paid.
+type DeleteEntryUseCase struct {
+	entries addressbook.Repository
+	logger  logger.Logger
+}
+
+// NewDeleteEntryUseCase creates a new delete entry use case
+func NewDeleteEntryUseCase(entries addressbook.Repository, logger logger.Logger) *DeleteEntryUseCase {
+	return &DeleteEntryUseCase{
+		entries: entries,
+		logger:  logger,
+	}
+}
+
+// Execute deletes an entry of the given address book
+func (uc *DeleteEntryUseCase) Execute(ctx context.Context, id uuid.UUID, scope, subjectID string) error {
+	entry, err := uc.entries.FindByID(ctx, id)
+	if err != nil || entry.Scope != scope || entry.SubjectID != subjectID {
+		return errors.NewNotFoundError("Address book entry not found")
+	}
+
+	if err := uc.entries.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete address book entry: %w", err)
+	}
+
+	uc.logger.Info("address book entry deleted",
+		logger.String("entry_id", id.String()),
+		logger.String("scope", scope),
+		logger.String("subject_id", subjectID))
+	return nil
+}
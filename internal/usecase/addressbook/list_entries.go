@@ -0,0 +1,35 @@
+package addressbook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/addressbook"
+)
+
+// ListEntriesUseCase lists the entries of an address book
+type ListEntriesUseCase struct {
+	entries addressbook.Repository
+}
+
+// NewListEntriesUseCase creates a new list entries use case
+func NewListEntriesUseCase(entries addressbook.Repository) *ListEntriesUseCase {
+	return &ListEntriesUseCase{entries: entries}
+}
+
+// Execute lists an address book's entries by name
+func (uc *ListEntriesUseCase) Execute(ctx context.Context, scope, subjectID string) ([]EntryOutput, error) {
+	entries, err := uc.entries.List(ctx, scope, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list address book entries: %w", err)
+	}
+
+	now := time.Now()
+	items := make([]EntryOutput, 0, len(entries))
+	for _, e := range entries {
+		items = append(items, toEntryOutput(e, now))
+	}
+
+	return items, nil
+}
@@ -0,0 +1,75 @@
+package addressbook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/addressbook"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/logger"
+)
+
+// GetModeUseCase reports whether an address book restricts payments to its entries
+type GetModeUseCase struct {
+	entries addressbook.Repository
+}
+
+// NewGetModeUseCase creates a new get mode use case
+func NewGetModeUseCase(entries addressbook.Repository) *GetModeUseCase {
+	return &GetModeUseCase{entries: entries}
+}
+
+// Execute returns the address book's mode
+func (uc *GetModeUseCase) Execute(ctx context.Context, scope, subjectID string) (*ModeOutput, error) {
+	mode, err := uc.entries.GetMode(ctx, scope, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address book mode: %w", err)
+	}
+
+	return toModeOutput(mode, time.Now()), nil
+}
+
+// SetModeUseCase turns allow-list only mode on, which takes effect at once, or off,
+// which takes effect after the cool-down
+type SetModeUseCase struct {
+	entries  addressbook.Repository
+	wallets  wallet.Repository
+	coolDown time.Duration
+	logger   logger.Logger
+}
+
+// NewSetModeUseCase creates a new set mode use case
+func NewSetModeUseCase(entries addressbook.Repository, wallets wallet.Repository, coolDown time.Duration, logger logger.Logger) *SetModeUseCase {
+	return &SetModeUseCase{
+		entries:  entries,
+		wallets:  wallets,
+		coolDown: coolDown,
+		logger:   logger,
+	}
+}
+
+// Execute saves the address book's mode
+func (uc *SetModeUseCase) Execute(ctx context.Context, scope, subjectID string, allowlistOnly bool) (*ModeOutput, error) {
+	if err := checkWallet(ctx, uc.wallets, scope, subjectID); err != nil {
+		return nil, err
+	}
+
+	mode, err := uc.entries.GetMode(ctx, scope, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address book mode: %w", err)
+	}
+
+	mode.Set(allowlistOnly, uc.coolDown)
+	if err := uc.entries.SaveMode(ctx, mode); err != nil {
+		uc.logger.Error("failed to save address book mode", logger.Error(err))
+		return nil, fmt.Errorf("failed to save address book mode: %w", err)
+	}
+
+	uc.logger.Info("address book mode changed",
+		logger.String("scope", scope),
+		logger.String("subject_id", subjectID),
+		logger.Bool("allowlist_only", allowlistOnly))
+
+	return toModeOutput(mode, time.Now()), nil
+}
@@ -0,0 +1,69 @@
+package addressbook
+
+import (
+	"time"
+
+	"quasarflow-api/internal/domain/addressbook"
+)
+
+// Address book scopes, for routes that pick the book
+const (
+	ScopeWallet = addressbook.ScopeWallet
+	ScopeUser   = addressbook.ScopeUser
+)
+
+// EntryOutput represents an address book entry
+type EntryOutput struct {
+	ID                string `json:"id"`
+	Scope             string `json:"scope"`
+	SubjectID         string `json:"subject_id"`
+	Name              string `json:"name"`
+	Address           string `json:"address"`
+	Memo              string `json:"memo,omitempty"`
+	MemoType          string `json:"memo_type,omitempty"`
+	FederationAddress string `json:"federation_address,omitempty"`
+	CreatedBy         string `json:"created_by,omitempty"`
+	CreatedAt         string `json:"created_at"`
+	UsableAt          string `json:"usable_at"`
+	Usable            bool   `json:"usable"`
+}
+
+// ModeOutput represents whether an address book restricts payments to its entries
+type ModeOutput struct {
+	Scope         string `json:"scope"`
+	SubjectID     string `json:"subject_id"`
+	AllowlistOnly bool   `json:"allowlist_only"`
+	Enforced      bool   `json:"enforced"`             // True while turning allow-list only off has not taken effect yet
+	RelaxesAt     string `json:"relaxes_at,omitempty"` // When turning allow-list only off takes effect
+}
+
+func toEntryOutput(e *addressbook.Entry, now time.Time) EntryOutput {
+	return EntryOutput{
+		ID:                e.ID.String(),
+		Scope:             e.Scope,
+		SubjectID:         e.SubjectID,
+		Name:              e.Name,
+		Address:           e.Address,
+		Memo:              e.Memo,
+		MemoType:          e.MemoType,
+		FederationAddress: e.FederationAddress,
+		CreatedBy:         e.CreatedBy,
+		CreatedAt:         e.CreatedAt.Format(time.RFC3339),
+		UsableAt:          e.UsableAt.Format(time.RFC3339),
+		Usable:            e.Usable(now),
+	}
+}
+
+func toModeOutput(m *addressbook.Mode, now time.Time) *ModeOutput {
+	output := &ModeOutput{
+		Scope:         m.Scope,
+		SubjectID:     m.SubjectID,
+		AllowlistOnly: m.AllowlistOnly,
+		Enforced:      m.Enforced(now),
+	}
+	if m.RelaxesAt != nil && now.Before(*m.RelaxesAt) {
+		output.RelaxesAt = m.RelaxesAt.Format(time.RFC3339)
+	}
+
+	return output
+}
@@ -0,0 +1,162 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// DecideInput represents an approver's decision on a request
+type DecideInput struct {
+	RequestID uuid.UUID `json:"-"`
+	Approver  string    `json:"-"` // Authenticated approver
+	Approve   bool      `json:"-"` // False rejects
+	Comment   string    `json:"comment,omitempty"`
+}
+
+// DecideUseCase records approvals and rejections. The decision that reaches the quorum
+// builds, signs and submits the held payment; its outcome is recorded on the request.
+type DecideUseCase struct {
+	approvals approval.Repository
+	payments  *wallet.SendPaymentUseCase
+	publisher event.Publisher
+	logger    logger.Logger
+}
+
+// NewDecideUseCase creates a new decide use case
+func NewDecideUseCase(
+	approvals approval.Repository,
+	payments *wallet.SendPaymentUseCase,
+	publisher event.Publisher,
+	logger logger.Logger,
+) *DecideUseCase {
+	return &DecideUseCase{
+		approvals: approvals,
+		payments:  payments,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute applies a decision and returns the request as it stands afterwards
+func (uc *DecideUseCase) Execute(ctx context.Context, input DecideInput) (*RequestOutput, error) {
+	// 1. Find the request
+	request, err := uc.approvals.FindByID(ctx, input.RequestID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Approval request not found")
+	}
+
+	// 2. Check the request can still be decided by this approver
+	if request.IsExpired(time.Now()) {
+		request.Expire()
+		if saved, err := uc.approvals.Update(ctx, request); err == nil && saved {
+			notify(ctx, uc.publisher, uc.logger, event.TypeApprovalCompleted, request)
+		}
+		return nil, errors.ErrApprovalExpired
+	}
+	if !request.IsPending() {
+		return nil, errors.ErrApprovalNotPending
+	}
+	if input.Approver == request.RequestedBy {
+		return nil, errors.ErrSelfApproval
+	}
+	if request.HasDecided(input.Approver) {
+		return nil, errors.ErrApprovalAlreadyDecided
+	}
+
+	// 3. Record the decision; the version check makes sure only one decision reaches the quorum
+	if input.Approve {
+		request.Approve(input.Approver, input.Comment)
+	} else {
+		request.Reject(input.Approver, input.Comment)
+	}
+
+	saved, err := uc.approvals.Update(ctx, request)
+	if err != nil {
+		uc.logger.Error("failed to save approval decision", logger.Error(err))
+		return nil, fmt.Errorf("failed to save approval decision: %w", err)
+	}
+	if !saved {
+		return nil, errors.ErrApprovalChanged
+	}
+
+	uc.logger.Info("approval decision recorded",
+		logger.String("request_id", request.ID.String()),
+		logger.String("approver", input.Approver),
+		logger.Bool("approve", input.Approve),
+		logger.Int("approvals", request.Approvals),
+		logger.Int("quorum", request.Quorum))
+
+	// 4. Execute the payment once approved. It is executed to the end even if the
+	// approver's request is cancelled; an execution cut short is settled by the expiry job.
+	switch request.Status {
+	case approval.StatusApproved:
+		uc.execute(context.WithoutCancel(ctx), request)
+		notify(ctx, uc.publisher, uc.logger, event.TypeApprovalCompleted, request)
+	case approval.StatusRejected:
+		notify(ctx, uc.publisher, uc.logger, event.TypeApprovalCompleted, request)
+	}
+
+	output := toRequestOutput(request)
+	return &output, nil
+}
+
+// execute sends the held payment on behalf of the requester and records the outcome
+func (uc *DecideUseCase) execute(ctx context.Context, request *approval.Request) {
+	var input wallet.SendPaymentInput
+	err := json.Unmarshal(request.Payment, &input)
+	if err == nil {
+		input.UserID = request.RequestedBy
+
+		var output *wallet.SendPaymentOutput
+		output, err = uc.payments.ExecuteApproved(ctx, input, func(hash string) error {
+			return uc.signed(ctx, request, hash)
+		})
+		if err == nil {
+			request.Executed(output.TransactionHash, output.SubmissionID)
+		}
+	}
+	if err != nil {
+		request.Failed(err)
+		uc.logger.Warn("approved payment failed",
+			logger.String("request_id", request.ID.String()),
+			logger.Error(err))
+	} else {
+		uc.logger.Info("approved payment executed",
+			logger.String("request_id", request.ID.String()),
+			logger.String("transaction_hash", request.TransactionHash))
+	}
+
+	saved, err := uc.approvals.Update(ctx, request)
+	if err != nil {
+		uc.logger.Error("failed to save approved payment outcome",
+			logger.String("request_id", request.ID.String()),
+			logger.Error(err))
+	} else if !saved {
+		uc.logger.Warn("approved payment outcome not saved, the request changed",
+			logger.String("request_id", request.ID.String()))
+	}
+}
+
+// signed saves the payment's transaction hash before it is submitted, so that the expiry
+// job can settle the request from the submission if the execution is cut short
+func (uc *DecideUseCase) signed(ctx context.Context, request *approval.Request, hash string) error {
+	request.Signed(hash)
+	saved, err := uc.approvals.Update(ctx, request)
+	if err != nil {
+		return fmt.Errorf("failed to save approved payment transaction: %w", err)
+	}
+	if !saved {
+		return errors.ErrApprovalChanged
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package approval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// stubApprovals keeps one request and saves updates only when the version matches,
+// like the Postgres repository
+type stubApprovals struct {
+	approval.Repository
+	stored *approval.Request
+	// race runs once before the next update, to save another decision in between
+	race func()
+}
+
+func (s *stubApprovals) FindByID(ctx context.Context, id uuid.UUID) (*approval.Request, error) {
+	copied := *s.stored
+	copied.Trail = append([]approval.AuditEntry(nil), s.stored.Trail...)
+	return &copied, nil
+}
+
+func (s *stubApprovals) Update(ctx context.Context, request *approval.Request) (bool, error) {
+	if race := s.race; race != nil {
+		s.race = nil
+		race()
+	}
+	if request.Version != s.stored.Version {
+		return false, nil
+	}
+	request.Version++
+	copied := *request
+	copied.Trail = append([]approval.AuditEntry(nil), request.Trail...)
+	s.stored = &copied
+	return true, nil
+}
+
+type stubPublisher struct {
+	events []*event.Event
+}
+
+func (s *stubPublisher) Publish(ctx context.Context, e *event.Event) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func TestDecide(t *testing.T) {
+	type decision struct {
+		approver string
+		approve  bool
+	}
+
+	tests := []struct {
+		name          string
+		quorum        int
+		setup         func(r *approval.Request)
+		before        []decision // Decisions made before the one under test
+		concurrent    *decision  // Decision saved while the one under test is being made
+		decision      decision
+		wantErr       *errors.AppError
+		wantStatus    approval.Status
+		wantApprovals int
+		wantEvents    int
+	}{
+		{
+			name:          "approval below the quorum",
+			quorum:        2,
+			decision:      decision{"alice", true},
+			wantStatus:    approval.StatusPending,
+			wantApprovals: 1,
+		},
+		{
+			// The payment is executed once the quorum is reached; this request holds no
+			// payment, so the execution is recorded as failed
+			name:          "approval reaching the quorum",
+			quorum:        2,
+			before:        []decision{{"alice", true}},
+			decision:      decision{"bob", true},
+			wantStatus:    approval.StatusFailed,
+			wantApprovals: 2,
+			wantEvents:    1,
+		},
+		{
+			name:          "rejection",
+			quorum:        2,
+			before:        []decision{{"alice", true}},
+			decision:      decision{"bob", false},
+			wantStatus:    approval.StatusRejected,
+			wantApprovals: 1,
+			wantEvents:    1,
+		},
+		{
+			name:       "requester deciding their own payment",
+			quorum:     1,
+			decision:   decision{"requester", true},
+			wantErr:    errors.ErrSelfApproval,
+			wantStatus: approval.StatusPending,
+		},
+		{
+			name:          "approver deciding twice",
+			quorum:        2,
+			before:        []decision{{"alice", true}},
+			decision:      decision{"alice", true},
+			wantErr:       errors.ErrApprovalAlreadyDecided,
+			wantStatus:    approval.StatusPending,
+			wantApprovals: 1,
+		},
+		{
+			name:       "decision after a rejection",
+			quorum:     2,
+			before:     []decision{{"alice", false}},
+			decision:   decision{"bob", true},
+			wantErr:    errors.ErrApprovalNotPending,
+			wantStatus: approval.StatusRejected,
+			wantEvents: 1,
+		},
+		{
+			name:          "decision saved concurrently",
+			quorum:        3,
+			concurrent:    &decision{"bob", true},
+			decision:      decision{"alice", true},
+			wantErr:       errors.ErrApprovalChanged,
+			wantStatus:    approval.StatusPending,
+			wantApprovals: 1,
+		},
+		{
+			name:   "decision after expiry",
+			quorum: 1,
+			setup: func(r *approval.Request) {
+				r.ExpiresAt = time.Now().Add(-time.Second)
+			},
+			decision:   decision{"alice", true},
+			wantErr:    errors.ErrApprovalExpired,
+			wantStatus: approval.StatusExpired,
+			wantEvents: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := approval.NewPolicy(uuid.New(), "native", decimal.NewFromInt(1000), tt.quorum, time.Hour)
+			if err != nil {
+				t.Fatalf("NewPolicy() error = %v", err)
+			}
+			request := approval.NewRequest(policy, decimal.NewFromInt(5000), "GDEST", "", nil, "requester")
+			if tt.setup != nil {
+				tt.setup(request)
+			}
+
+			repo := &stubApprovals{stored: request}
+			publisher := &stubPublisher{}
+			uc := NewDecideUseCase(repo, nil, publisher, logger.New("error"))
+
+			decide := func(d decision) error {
+				_, err := uc.Execute(context.Background(), DecideInput{
+					RequestID: request.ID,
+					Approver:  d.approver,
+					Approve:   d.approve,
+				})
+				return err
+			}
+			for _, d := range tt.before {
+				if err := decide(d); err != nil {
+					t.Fatalf("decision by %s error = %v", d.approver, err)
+				}
+			}
+			if tt.concurrent != nil {
+				repo.race = func() {
+					if err := decide(*tt.concurrent); err != nil {
+						t.Fatalf("concurrent decision by %s error = %v", tt.concurrent.approver, err)
+					}
+				}
+			}
+
+			err = decide(tt.decision)
+			if tt.wantErr != nil {
+				if err != tt.wantErr {
+					t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if repo.stored.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", repo.stored.Status, tt.wantStatus)
+			}
+			if repo.stored.Approvals != tt.wantApprovals {
+				t.Errorf("approvals = %d, want %d", repo.stored.Approvals, tt.wantApprovals)
+			}
+			if len(publisher.events) != tt.wantEvents {
+				t.Errorf("published %d events, want %d", len(publisher.events), tt.wantEvents)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/pkg/logger"
+)
+
+// settleAfter is how long an approved request may go without an outcome before its
+// execution is considered cut short. Executions record their transaction hash within
+// seconds, and a submitted payment is left to its submission until it is settled.
+const settleAfter = 10 * time.Minute
+
+// ExpireRequestsUseCase expires pending requests whose time ran out and settles approved
+// requests whose execution was cut short, publishing approval.completed for each
+type ExpireRequestsUseCase struct {
+	approvals   approval.Repository
+	submissions submission.Repository
+	publisher   event.Publisher
+	logger      logger.Logger
+}
+
+// NewExpireRequestsUseCase creates a new expire requests use case
+func NewExpireRequestsUseCase(
+	approvals approval.Repository,
+	submissions submission.Repository,
+	publisher event.Publisher,
+	logger logger.Logger,
+) *ExpireRequestsUseCase {
+	return &ExpireRequestsUseCase{
+		approvals:   approvals,
+		submissions: submissions,
+		publisher:   publisher,
+		logger:      logger,
+	}
+}
+
+// Execute expires the requests due at the time of the run, settles the unsettled ones and
+// returns how many it expired
+func (uc *ExpireRequestsUseCase) Execute(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := uc.approvals.ListExpired(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired approval requests: %w", err)
+	}
+
+	expired := 0
+	for _, listed := range due {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Listings do not carry the audit trail the update appends to
+		request, err := uc.approvals.FindByID(ctx, listed.ID)
+		if err != nil {
+			uc.logger.Error("failed to find expired approval request",
+				logger.String("request_id", listed.ID.String()),
+				logger.Error(err))
+			continue
+		}
+		if !request.IsExpired(now) {
+			continue // Decided in the meantime
+		}
+
+		request.Expire()
+		if uc.save(ctx, request, "failed to expire approval request") {
+			expired++
+		}
+	}
+
+	if expired > 0 {
+		uc.logger.Info("expired approval requests", logger.Int("count", expired))
+	}
+
+	if err := uc.settle(ctx, now.Add(-settleAfter)); err != nil {
+		return expired, err
+	}
+
+	return expired, nil
+}
+
+// settle records the outcome of approved requests last updated before the given time.
+// A payment whose transaction was submitted takes the outcome of its submission once it
+// is known; one that was never submitted is marked failed.
+func (uc *ExpireRequestsUseCase) settle(ctx context.Context, before time.Time) error {
+	unsettled, err := uc.approvals.ListUnsettled(ctx, before)
+	if err != nil {
+		return fmt.Errorf("failed to list unsettled approval requests: %w", err)
+	}
+
+	settled := 0
+	for _, listed := range unsettled {
+		if ctx.Err() != nil {
+			break
+		}
+
+		request, err := uc.approvals.FindByID(ctx, listed.ID)
+		if err != nil {
+			uc.logger.Error("failed to find unsettled approval request",
+				logger.String("request_id", listed.ID.String()),
+				logger.Error(err))
+			continue
+		}
+		if request.Status != approval.StatusApproved {
+			continue // Settled in the meantime
+		}
+
+		var record *submission.Submission
+		if request.TransactionHash != "" {
+			record, err = uc.submissions.FindByHash(ctx, request.TransactionHash)
+			if err != nil {
+				uc.logger.Error("failed to find approved payment submission",
+					logger.String("request_id", request.ID.String()),
+					logger.String("transaction_hash", request.TransactionHash),
+					logger.Error(err))
+				continue
+			}
+		}
+
+		switch {
+		case record == nil:
+			request.Failed(fmt.Errorf("payment execution was interrupted before the transaction was submitted"))
+		case record.Status == submission.StatusSuccess:
+			request.Executed(record.Hash, record.ID.String())
+		case record.IsFinal():
+			request.Failed(fmt.Errorf("payment transaction %s: %s", record.Status, submissionError(record)))
+		default:
+			continue // Outcome not known yet
+		}
+
+		if uc.save(ctx, request, "failed to settle approval request") {
+			settled++
+		}
+	}
+
+	if settled > 0 {
+		uc.logger.Info("settled approval requests", logger.Int("count", settled))
+	}
+
+	return nil
+}
+
+// save updates a request that reached an outcome, publishes approval.completed and
+// reports whether it was saved
+func (uc *ExpireRequestsUseCase) save(ctx context.Context, request *approval.Request, failure string) bool {
+	saved, err := uc.approvals.Update(ctx, request)
+	if err != nil {
+		uc.logger.Error(failure,
+			logger.String("request_id", request.ID.String()),
+			logger.Error(err))
+		return false
+	}
+	if !saved {
+		return false // Changed in the meantime
+	}
+
+	notify(ctx, uc.publisher, uc.logger, event.TypeApprovalCompleted, request)
+	return true
+}
+
+// submissionError describes why a submission did not succeed
+func submissionError(record *submission.Submission) string {
+	switch {
+	case record.ErrorMessage != "":
+		return record.ErrorMessage
+	case record.ResultCode != "":
+		return record.ResultCode
+	default:
+		return "no result recorded"
+	}
+}
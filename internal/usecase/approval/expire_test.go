@@ -0,0 +1,154 @@
+package approval
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func (s *stubApprovals) ListExpired(ctx context.Context, now time.Time) ([]*approval.Request, error) {
+	if !s.stored.IsExpired(now) {
+		return nil, nil
+	}
+	listed := *s.stored
+	listed.Trail = nil
+	return []*approval.Request{&listed}, nil
+}
+
+func (s *stubApprovals) ListUnsettled(ctx context.Context, before time.Time) ([]*approval.Request, error) {
+	if s.stored.Status != approval.StatusApproved || !s.stored.UpdatedAt.Before(before) {
+		return nil, nil
+	}
+	listed := *s.stored
+	listed.Trail = nil
+	return []*approval.Request{&listed}, nil
+}
+
+type stubSubmissions struct {
+	submission.Repository
+	record *submission.Submission
+}
+
+func (s *stubSubmissions) FindByHash(ctx context.Context, hash string) (*submission.Submission, error) {
+	if s.record == nil || s.record.Hash != hash {
+		return nil, nil
+	}
+	return s.record, nil
+}
+
+func TestExpireRequests(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	stale := time.Now().Add(-settleAfter - time.Minute)
+
+	approved := func(transactionHash string, updatedAt time.Time) func(r *approval.Request) {
+		return func(r *approval.Request) {
+			r.Approve("alice", "")
+			r.TransactionHash = transactionHash
+			r.UpdatedAt = updatedAt
+		}
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(r *approval.Request)
+		record      *submission.Submission
+		wantExpired int
+		wantStatus  approval.Status
+		wantEvents  int
+	}{
+		{
+			name: "pending request past its expiry",
+			setup: func(r *approval.Request) {
+				r.ExpiresAt = time.Now().Add(-time.Second)
+			},
+			wantExpired: 1,
+			wantStatus:  approval.StatusExpired,
+			wantEvents:  1,
+		},
+		{
+			name:       "pending request before its expiry",
+			wantStatus: approval.StatusPending,
+		},
+		{
+			name:       "approved request still executing",
+			setup:      approved("", time.Now()),
+			wantStatus: approval.StatusApproved,
+		},
+		{
+			name:       "execution cut short before signing",
+			setup:      approved("", stale),
+			wantStatus: approval.StatusFailed,
+			wantEvents: 1,
+		},
+		{
+			name:       "execution cut short before submitting",
+			setup:      approved(hash, stale),
+			wantStatus: approval.StatusFailed,
+			wantEvents: 1,
+		},
+		{
+			name:       "submission still pending",
+			setup:      approved(hash, stale),
+			record:     &submission.Submission{ID: uuid.New(), Hash: hash, Status: submission.StatusPending},
+			wantStatus: approval.StatusApproved,
+		},
+		{
+			name:       "submission succeeded",
+			setup:      approved(hash, stale),
+			record:     &submission.Submission{ID: uuid.New(), Hash: hash, Status: submission.StatusSuccess},
+			wantStatus: approval.StatusExecuted,
+			wantEvents: 1,
+		},
+		{
+			name:       "submission failed",
+			setup:      approved(hash, stale),
+			record:     &submission.Submission{ID: uuid.New(), Hash: hash, Status: submission.StatusFailed, ResultCode: "tx_bad_seq"},
+			wantStatus: approval.StatusFailed,
+			wantEvents: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := approval.NewPolicy(uuid.New(), "native", decimal.NewFromInt(1000), 1, time.Hour)
+			if err != nil {
+				t.Fatalf("NewPolicy() error = %v", err)
+			}
+			request := approval.NewRequest(policy, decimal.NewFromInt(5000), "GDEST", "", nil, "requester")
+			if tt.setup != nil {
+				tt.setup(request)
+			}
+			trail := len(request.Trail)
+
+			repo := &stubApprovals{stored: request}
+			publisher := &stubPublisher{}
+			uc := NewExpireRequestsUseCase(repo, &stubSubmissions{record: tt.record}, publisher, logger.New("error"))
+
+			expired, err := uc.Execute(context.Background())
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if expired != tt.wantExpired {
+				t.Errorf("expired %d requests, want %d", expired, tt.wantExpired)
+			}
+			if repo.stored.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", repo.stored.Status, tt.wantStatus)
+			}
+			if len(publisher.events) != tt.wantEvents {
+				t.Errorf("published %d events, want %d", len(publisher.events), tt.wantEvents)
+			}
+			if tt.wantEvents > 0 && len(repo.stored.Trail) != trail+1 {
+				t.Errorf("trail has %d entries, want %d", len(repo.stored.Trail), trail+1)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/internal/domain/event"
+	domainWallet "quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/shopspring/decimal"
+)
+
+// HoldPaymentUseCase turns payments above a wallet's approval threshold into pending
+// requests. It is the wallet.PaymentHolder of SendPaymentUseCase.
+type HoldPaymentUseCase struct {
+	approvals approval.Repository
+	publisher event.Publisher
+	logger    logger.Logger
+}
+
+// NewHoldPaymentUseCase creates a new hold payment use case
+func NewHoldPaymentUseCase(approvals approval.Repository, publisher event.Publisher, logger logger.Logger) *HoldPaymentUseCase {
+	return &HoldPaymentUseCase{
+		approvals: approvals,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Hold creates a pending request when the wallet's policy for the asset requires approval
+// of the amount, and returns nil otherwise. Time and ledger bounds are dropped: they are
+// applied from when the approved payment is built.
+func (uc *HoldPaymentUseCase) Hold(ctx context.Context, w *domainWallet.Wallet, input wallet.SendPaymentInput, asset string, amount decimal.Decimal) (*approval.Request, error) {
+	policy, err := uc.approvals.FindPolicy(ctx, w.ID, asset)
+	if err != nil {
+		return nil, errors.NewInternalError("Failed to check approval policy", err)
+	}
+	if policy == nil || !policy.Requires(amount) {
+		return nil, nil
+	}
+
+	input.ValidUntil, input.MinLedger, input.MaxLedger = nil, 0, 0
+	payment, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode payment: %w", err)
+	}
+
+	request := approval.NewRequest(policy, amount, input.ToAddress, input.Memo, payment, input.UserID)
+	if err := uc.approvals.Create(ctx, request); err != nil {
+		uc.logger.Error("failed to create approval request", logger.Error(err))
+		return nil, fmt.Errorf("failed to create approval request: %w", err)
+	}
+
+	uc.logger.Info("payment held for approval",
+		logger.String("request_id", request.ID.String()),
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("requested_by", request.RequestedBy),
+		logger.String("asset", asset),
+		logger.String("amount", input.Amount),
+		logger.Int("quorum", request.Quorum))
+
+	notify(ctx, uc.publisher, uc.logger, event.TypeApprovalRequested, request)
+	return request, nil
+}
@@ -0,0 +1,105 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ListRequestsInput represents a request for approval requests
+type ListRequestsInput struct {
+	WalletID uuid.UUID // Optional filter
+	Status   string    // Optional filter
+	Limit    int
+	Offset   int
+}
+
+// ListRequestsOutput represents a page of approval requests, without their trails
+type ListRequestsOutput struct {
+	Requests []RequestOutput `json:"requests"`
+	Total    int64           `json:"total"`
+	Limit    int             `json:"limit"`
+	Offset   int             `json:"offset"`
+}
+
+// ListRequestsUseCase lists approval requests
+type ListRequestsUseCase struct {
+	approvals approval.Repository
+}
+
+// NewListRequestsUseCase creates a new list requests use case
+func NewListRequestsUseCase(approvals approval.Repository) *ListRequestsUseCase {
+	return &ListRequestsUseCase{approvals: approvals}
+}
+
+// Execute retrieves a paginated list of requests, newest first
+func (uc *ListRequestsUseCase) Execute(ctx context.Context, input ListRequestsInput) (*ListRequestsOutput, error) {
+	filter := approval.Filter{
+		WalletID: input.WalletID,
+		Status:   approval.Status(input.Status),
+	}
+	switch filter.Status {
+	case "", approval.StatusPending, approval.StatusApproved, approval.StatusRejected,
+		approval.StatusExpired, approval.StatusExecuted, approval.StatusFailed:
+	default:
+		return nil, errors.NewValidationError("Invalid status", "status must be pending, approved, rejected, expired, executed or failed")
+	}
+
+	limit, offset := input.Limit, input.Offset
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	requests, err := uc.approvals.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval requests: %w", err)
+	}
+
+	total, err := uc.approvals.Count(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count approval requests: %w", err)
+	}
+
+	items := make([]RequestOutput, 0, len(requests))
+	for _, r := range requests {
+		items = append(items, toRequestOutput(r))
+	}
+
+	return &ListRequestsOutput{
+		Requests: items,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+// GetRequestUseCase retrieves an approval request with its audit trail
+type GetRequestUseCase struct {
+	approvals approval.Repository
+}
+
+// NewGetRequestUseCase creates a new get request use case
+func NewGetRequestUseCase(approvals approval.Repository) *GetRequestUseCase {
+	return &GetRequestUseCase{approvals: approvals}
+}
+
+// Execute retrieves a request by ID
+func (uc *GetRequestUseCase) Execute(ctx context.Context, id uuid.UUID) (*RequestOutput, error) {
+	request, err := uc.approvals.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Approval request not found")
+	}
+
+	output := toRequestOutput(request)
+	return &output, nil
+}
@@ -0,0 +1,35 @@
+package approval
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/pkg/logger"
+)
+
+// notify publishes an approval event so webhooks subscribed to it reach the approvers
+// and the requester. Failures are logged; the request is already saved.
+func notify(ctx context.Context, publisher event.Publisher, log logger.Logger, eventType event.Type, r *approval.Request) {
+	e := event.New(eventType, r.WalletID, r.ID.String()+":"+string(r.Status))
+	e.Approval = &event.Approval{
+		RequestID:       r.ID,
+		Status:          string(r.Status),
+		Asset:           r.Asset,
+		Amount:          r.Amount,
+		Destination:     r.Destination,
+		RequestedBy:     r.RequestedBy,
+		Approvals:       r.Approvals,
+		Quorum:          r.Quorum,
+		ExpiresAt:       r.ExpiresAt,
+		TransactionHash: r.TransactionHash,
+		Error:           r.Error,
+	}
+
+	if err := publisher.Publish(ctx, e); err != nil {
+		log.Error("failed to publish approval event",
+			logger.String("request_id", r.ID.String()),
+			logger.String("type", string(eventType)),
+			logger.Error(err))
+	}
+}
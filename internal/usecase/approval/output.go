@@ -0,0 +1,95 @@
+package approval
+
+import (
+	"time"
+
+	"quasarflow-api/internal/domain/approval"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+)
+
+// PolicyOutput represents an approval policy
+type PolicyOutput struct {
+	ID        string `json:"id"`
+	WalletID  string `json:"wallet_id"`
+	Asset     string `json:"asset"`
+	Threshold string `json:"threshold"`
+	Quorum    int    `json:"quorum"`
+	TTL       string `json:"ttl"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// RequestOutput represents an approval request; the audit trail is only included when
+// getting a single request
+type RequestOutput struct {
+	ID              string        `json:"id"`
+	WalletID        string        `json:"wallet_id"`
+	Asset           string        `json:"asset"`
+	Amount          string        `json:"amount"`
+	Destination     string        `json:"destination"`
+	Memo            string        `json:"memo,omitempty"`
+	RequestedBy     string        `json:"requested_by"`
+	Quorum          int           `json:"quorum"`
+	Approvals       int           `json:"approvals"`
+	Status          string        `json:"status"`
+	TransactionHash string        `json:"transaction_hash,omitempty"`
+	SubmissionID    string        `json:"submission_id,omitempty"`
+	Error           string        `json:"error,omitempty"`
+	ExpiresAt       string        `json:"expires_at"`
+	CreatedAt       string        `json:"created_at"`
+	UpdatedAt       string        `json:"updated_at"`
+	Trail           []AuditOutput `json:"trail,omitempty"`
+}
+
+// AuditOutput represents one step of a request's history
+type AuditOutput struct {
+	Action  string `json:"action"`
+	Actor   string `json:"actor"`
+	Comment string `json:"comment,omitempty"`
+	At      string `json:"at"`
+}
+
+func toPolicyOutput(p *approval.Policy) PolicyOutput {
+	return PolicyOutput{
+		ID:        p.ID.String(),
+		WalletID:  p.WalletID.String(),
+		Asset:     p.Asset,
+		Threshold: p.Threshold.StringFixed(domainStellar.AmountDecimals),
+		Quorum:    p.Quorum,
+		TTL:       p.TTL.String(),
+		CreatedAt: p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: p.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toRequestOutput(r *approval.Request) RequestOutput {
+	output := RequestOutput{
+		ID:              r.ID.String(),
+		WalletID:        r.WalletID.String(),
+		Asset:           r.Asset,
+		Amount:          r.Amount.StringFixed(domainStellar.AmountDecimals),
+		Destination:     r.Destination,
+		Memo:            r.Memo,
+		RequestedBy:     r.RequestedBy,
+		Quorum:          r.Quorum,
+		Approvals:       r.Approvals,
+		Status:          string(r.Status),
+		TransactionHash: r.TransactionHash,
+		SubmissionID:    r.SubmissionID,
+		Error:           r.Error,
+		ExpiresAt:       r.ExpiresAt.Format(time.RFC3339),
+		CreatedAt:       r.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       r.UpdatedAt.Format(time.RFC3339),
+	}
+
+	for _, e := range r.Trail {
+		output.Trail = append(output.Trail, AuditOutput{
+			Action:  e.Action,
+			Actor:   e.Actor,
+			Comment: e.Comment,
+			At:      e.At.Format(time.RFC3339),
+		})
+	}
+
+	return output
+}
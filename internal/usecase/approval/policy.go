@@ -0,0 +1,153 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// defaultTTL is how long requests stay open when a policy does not say
+const defaultTTL = 24 * time.Hour
+
+// SetPolicyInput represents the approval policy of a wallet for one asset
+type SetPolicyInput struct {
+	WalletID  string `json:"wallet_id"`
+	Asset     string `json:"asset"`         // native or CODE:ISSUER
+	Threshold string `json:"threshold"`     // Payments of more than this need approval
+	Quorum    int    `json:"quorum"`        // Approvals needed, at least 1
+	TTL       string `json:"ttl,omitempty"` // Go duration requests stay open; default: 24h
+}
+
+// SetPolicyUseCase creates or replaces the approval policy of a wallet for an asset
+type SetPolicyUseCase struct {
+	approvals approval.Repository
+	wallets   wallet.Repository
+	logger    logger.Logger
+}
+
+// NewSetPolicyUseCase creates a new set policy use case
+func NewSetPolicyUseCase(approvals approval.Repository, wallets wallet.Repository, logger logger.Logger) *SetPolicyUseCase {
+	return &SetPolicyUseCase{
+		approvals: approvals,
+		wallets:   wallets,
+		logger:    logger,
+	}
+}
+
+// Execute validates and saves the policy. Requests already pending keep the quorum and
+// expiry they were created with.
+func (uc *SetPolicyUseCase) Execute(ctx context.Context, input SetPolicyInput) (*PolicyOutput, error) {
+	walletID, err := uuid.Parse(input.WalletID)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid wallet ID", "wallet_id must be a UUID")
+	}
+
+	asset := strings.TrimSpace(input.Asset)
+	switch {
+	case strings.EqualFold(asset, "native"), strings.EqualFold(asset, "XLM"):
+		asset = "native"
+	default:
+		parts := strings.Split(asset, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.NewValidationError("Invalid asset", "asset must be native or CODE:ISSUER")
+		}
+	}
+
+	threshold, err := decimal.NewFromString(input.Threshold)
+	if err != nil {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	ttl := defaultTTL
+	if input.TTL != "" {
+		ttl, err = time.ParseDuration(input.TTL)
+		if err != nil {
+			return nil, errors.NewValidationError("Invalid TTL", "ttl must be a duration such as 1h or 24h")
+		}
+	}
+
+	w, err := uc.wallets.FindByID(ctx, walletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Wallet not found")
+	}
+	if w.IsClosed() {
+		return nil, errors.ErrWalletClosed
+	}
+
+	policy, err := approval.NewPolicy(walletID, asset, threshold, input.Quorum, ttl)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid approval policy", err.Error())
+	}
+
+	if err := uc.approvals.SavePolicy(ctx, policy); err != nil {
+		uc.logger.Error("failed to save approval policy", logger.Error(err))
+		return nil, fmt.Errorf("failed to save approval policy: %w", err)
+	}
+
+	uc.logger.Info("approval policy saved",
+		logger.String("policy_id", policy.ID.String()),
+		logger.String("wallet_id", walletID.String()),
+		logger.String("asset", policy.Asset),
+		logger.Int("quorum", policy.Quorum))
+
+	output := toPolicyOutput(policy)
+	return &output, nil
+}
+
+// ListPoliciesUseCase lists approval policies
+type ListPoliciesUseCase struct {
+	approvals approval.Repository
+}
+
+// NewListPoliciesUseCase creates a new list policies use case
+func NewListPoliciesUseCase(approvals approval.Repository) *ListPoliciesUseCase {
+	return &ListPoliciesUseCase{approvals: approvals}
+}
+
+// Execute lists the policies of a wallet, or of every wallet for uuid.Nil
+func (uc *ListPoliciesUseCase) Execute(ctx context.Context, walletID uuid.UUID) ([]PolicyOutput, error) {
+	policies, err := uc.approvals.ListPolicies(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval policies: %w", err)
+	}
+
+	items := make([]PolicyOutput, 0, len(policies))
+	for _, p := range policies {
+		items = append(items, toPolicyOutput(p))
+	}
+
+	return items, nil
+}
+
+// DeletePolicyUseCase removes an approval policy; existing requests are kept
+type DeletePolicyUseCase struct {
+	approvals approval.Repository
+	logger    logger.Logger
+}
+
+// NewDeletePolicyUseCase creates a new delete policy use case
+func NewDeletePolicyUseCase(approvals approval.Repository, logger logger.Logger) *DeletePolicyUseCase {
+	return &DeletePolicyUseCase{
+		approvals: approvals,
+		logger:    logger,
+	}
+}
+
+// Execute deletes a policy by ID
+func (uc *DeletePolicyUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	if err := uc.approvals.DeletePolicy(ctx, id); err != nil {
+		return errors.NewNotFoundError("Approval policy not found")
+	}
+
+	uc.logger.Info("approval policy deleted", logger.String("policy_id", id.String()))
+	return nil
+}
@@ -0,0 +1,117 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/asset"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+)
+
+// AuthorizeTrustlineInput represents a change to a holder's trustline authorization
+type AuthorizeTrustlineInput struct {
+	AssetID    uuid.UUID `json:"asset_id"`
+	Trustor    string    `json:"trustor" validate:"required"` // Account holding the trustline
+	Authorized bool      `json:"authorized"`
+	// MaintainLiabilities keeps existing offers when revoking; the holder can no longer send or receive
+	MaintainLiabilities bool  `json:"maintain_liabilities,omitempty"`
+	MaxFee              int64 `json:"max_fee,omitempty"`
+}
+
+// AuthorizeTrustlineOutput represents the result of a SetTrustLineFlags transaction
+type AuthorizeTrustlineOutput struct {
+	AssetOperationOutput
+	Trustor    string `json:"trustor"`
+	Authorized bool   `json:"authorized"`
+}
+
+// AuthorizeTrustlineUseCase authorizes or revokes a holder's trustline with SetTrustLineFlags
+type AuthorizeTrustlineUseCase struct {
+	assets    asset.Repository
+	wallets   wallet.Repository
+	txBuilder *walletUC.TransactionBuilder
+	logger    logger.Logger
+}
+
+// NewAuthorizeTrustlineUseCase creates a new authorize trustline use case
+func NewAuthorizeTrustlineUseCase(
+	assets asset.Repository,
+	wallets wallet.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *AuthorizeTrustlineUseCase {
+	return &AuthorizeTrustlineUseCase{
+		assets:    assets,
+		wallets:   wallets,
+		txBuilder: txBuilder,
+		logger:    logger,
+	}
+}
+
+// Execute submits SetTrustLineFlags from the issuer
+func (uc *AuthorizeTrustlineUseCase) Execute(ctx context.Context, input AuthorizeTrustlineInput) (*AuthorizeTrustlineOutput, error) {
+	if !strkey.IsValidEd25519PublicKey(input.Trustor) {
+		return nil, errors.ErrInvalidPublicKey
+	}
+
+	a, err := uc.assets.FindByID(ctx, input.AssetID)
+	if err != nil {
+		return nil, errors.ErrAssetNotFound
+	}
+
+	if a.Locked {
+		return nil, errors.ErrIssuerLocked
+	}
+
+	if !input.Authorized && !a.AuthRevocable {
+		return nil, errors.ErrAssetNotRevocable
+	}
+
+	issuer, err := uc.wallets.FindByID(ctx, a.IssuerWalletID)
+	if err != nil {
+		return nil, fmt.Errorf("issuer wallet not found: %w", err)
+	}
+
+	op := &txnbuild.SetTrustLineFlags{
+		Trustor: input.Trustor,
+		Asset:   creditAsset(a),
+	}
+	switch {
+	case input.Authorized:
+		op.SetFlags = []txnbuild.TrustLineFlag{txnbuild.TrustLineAuthorized}
+		op.ClearFlags = []txnbuild.TrustLineFlag{txnbuild.TrustLineAuthorizedToMaintainLiabilities}
+	case input.MaintainLiabilities:
+		op.SetFlags = []txnbuild.TrustLineFlag{txnbuild.TrustLineAuthorizedToMaintainLiabilities}
+		op.ClearFlags = []txnbuild.TrustLineFlag{txnbuild.TrustLineAuthorized}
+	default:
+		op.ClearFlags = []txnbuild.TrustLineFlag{txnbuild.TrustLineAuthorized, txnbuild.TrustLineAuthorizedToMaintainLiabilities}
+	}
+
+	tx, err := submitOperation(ctx, uc.txBuilder, issuer, submission.KindSetTrustFlags, op, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("trustline authorization changed",
+		logger.String("asset_id", a.ID.String()),
+		logger.String("trustor", input.Trustor),
+		logger.Bool("authorized", input.Authorized),
+		logger.String("hash", tx.TransactionHash))
+
+	return &AuthorizeTrustlineOutput{
+		AssetOperationOutput: AssetOperationOutput{
+			Asset:        toAssetOutput(a),
+			Transactions: []TransactionOutput{tx},
+		},
+		Trustor:    input.Trustor,
+		Authorized: input.Authorized,
+	}, nil
+}
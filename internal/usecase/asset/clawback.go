@@ -0,0 +1,106 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/asset"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+)
+
+// ClawbackInput represents a request to claw back part of a holder's balance
+type ClawbackInput struct {
+	AssetID uuid.UUID `json:"asset_id"`
+	From    string    `json:"from" validate:"required"`
+	Amount  string    `json:"amount" validate:"required"`
+	MaxFee  int64     `json:"max_fee,omitempty"`
+}
+
+// ClawbackUseCase burns a holder's balance with a Clawback operation from the issuer
+type ClawbackUseCase struct {
+	assets    asset.Repository
+	wallets   wallet.Repository
+	txBuilder *walletUC.TransactionBuilder
+	logger    logger.Logger
+}
+
+// NewClawbackUseCase creates a new clawback use case
+func NewClawbackUseCase(
+	assets asset.Repository,
+	wallets wallet.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *ClawbackUseCase {
+	return &ClawbackUseCase{
+		assets:    assets,
+		wallets:   wallets,
+		txBuilder: txBuilder,
+		logger:    logger,
+	}
+}
+
+// Execute claws back the amount and removes it from the recorded supply
+func (uc *ClawbackUseCase) Execute(ctx context.Context, input ClawbackInput) (*AssetOperationOutput, error) {
+	if !strkey.IsValidEd25519PublicKey(input.From) {
+		return nil, errors.ErrInvalidPublicKey
+	}
+
+	amount, err := domainStellar.ParseAmount(input.Amount)
+	if err != nil {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	a, err := uc.assets.FindByID(ctx, input.AssetID)
+	if err != nil {
+		return nil, errors.ErrAssetNotFound
+	}
+
+	if a.Locked {
+		return nil, errors.ErrIssuerLocked
+	}
+
+	if !a.ClawbackEnabled {
+		return nil, errors.ErrClawbackNotEnabled
+	}
+
+	issuer, err := uc.wallets.FindByID(ctx, a.IssuerWalletID)
+	if err != nil {
+		return nil, fmt.Errorf("issuer wallet not found: %w", err)
+	}
+
+	tx, err := submitOperation(ctx, uc.txBuilder, issuer, submission.KindClawback, &txnbuild.Clawback{
+		From:   input.From,
+		Amount: amount.String(),
+		Asset:  creditAsset(a),
+	}, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.assets.AddClawedBack(ctx, a, amount); err != nil {
+		uc.logger.Error("asset clawed back but supply not recorded",
+			logger.Error(err),
+			logger.String("asset_id", a.ID.String()),
+			logger.String("hash", tx.TransactionHash))
+	}
+
+	uc.logger.Info("asset clawed back",
+		logger.String("asset_id", a.ID.String()),
+		logger.String("from", input.From),
+		logger.String("amount", amount.String()),
+		logger.String("hash", tx.TransactionHash))
+
+	return &AssetOperationOutput{
+		Asset:        toAssetOutput(a),
+		Transactions: []TransactionOutput{tx},
+	}, nil
+}
@@ -0,0 +1,33 @@
+package asset
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/asset"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetAssetUseCase handles retrieving an issued asset by ID
+type GetAssetUseCase struct {
+	assets asset.Repository
+}
+
+// NewGetAssetUseCase creates a new get asset use case
+func NewGetAssetUseCase(assets asset.Repository) *GetAssetUseCase {
+	return &GetAssetUseCase{
+		assets: assets,
+	}
+}
+
+// Execute retrieves an issued asset with its supply
+func (uc *GetAssetUseCase) Execute(ctx context.Context, id uuid.UUID) (*AssetOutput, error) {
+	a, err := uc.assets.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.ErrAssetNotFound
+	}
+
+	output := toAssetOutput(a)
+	return &output, nil
+}
@@ -0,0 +1,162 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/asset"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/txnbuild"
+)
+
+// IssueAssetInput represents a request to issue a new asset
+type IssueAssetInput struct {
+	Code                 string    `json:"code" validate:"required"`
+	IssuerWalletID       uuid.UUID `json:"issuer_wallet_id" validate:"required"`
+	DistributionWalletID uuid.UUID `json:"distribution_wallet_id" validate:"required"`
+	AuthRequired         bool      `json:"auth_required"`
+	AuthRevocable        bool      `json:"auth_revocable"`
+	ClawbackEnabled      bool      `json:"clawback_enabled"`
+	InitialSupply        string    `json:"initial_supply,omitempty"` // Optional amount minted to the distribution wallet
+	MaxFee               int64     `json:"max_fee,omitempty"`
+}
+
+// IssueAssetUseCase sets up an issuer and distribution wallet pair for a new asset
+type IssueAssetUseCase struct {
+	assets    asset.Repository
+	wallets   wallet.Repository
+	txBuilder *walletUC.TransactionBuilder
+	logger    logger.Logger
+}
+
+// NewIssueAssetUseCase creates a new issue asset use case
+func NewIssueAssetUseCase(
+	assets asset.Repository,
+	wallets wallet.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *IssueAssetUseCase {
+	return &IssueAssetUseCase{
+		assets:    assets,
+		wallets:   wallets,
+		txBuilder: txBuilder,
+		logger:    logger,
+	}
+}
+
+// Execute sets the issuer flags, opens the distribution trustline (authorizing it when
+// AUTH_REQUIRED is set), records the asset and optionally mints the initial supply.
+// Flags are set first so that the distribution trustline inherits clawback.
+func (uc *IssueAssetUseCase) Execute(ctx context.Context, input IssueAssetInput) (*AssetOperationOutput, error) {
+	// 1. Load wallets
+	issuer, err := uc.wallets.FindByID(ctx, input.IssuerWalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Issuer wallet not found")
+	}
+
+	distribution, err := uc.wallets.FindByID(ctx, input.DistributionWalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Distribution wallet not found")
+	}
+
+	if issuer.Network != distribution.Network {
+		return nil, errors.ErrAssetWalletNetworkMismatch
+	}
+
+	// 2. Validate asset
+	a, err := asset.NewAsset(input.Code, issuer.ID, issuer.PublicKey, distribution.ID)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid asset", err.Error())
+	}
+
+	if err := a.SetFlags(input.AuthRequired, input.AuthRevocable, input.ClawbackEnabled); err != nil {
+		return nil, errors.ErrInvalidAssetFlags
+	}
+
+	initialSupply := decimal.Zero
+	if input.InitialSupply != "" {
+		initialSupply, err = domainStellar.ParseAmount(input.InitialSupply)
+		if err != nil {
+			return nil, errors.ErrInvalidAmount
+		}
+	}
+
+	if _, err := uc.assets.FindByCodeAndIssuer(ctx, a.Code, a.IssuerPublicKey); err == nil {
+		return nil, errors.ErrAssetAlreadyExists
+	}
+
+	transactions := make([]TransactionOutput, 0, 4)
+	credit := creditAsset(a)
+
+	// 3. Set issuer flags
+	if a.AuthRequired || a.AuthRevocable || a.ClawbackEnabled {
+		setFlags, _ := issuerFlagOps(a)
+		tx, err := submitOperation(ctx, uc.txBuilder, issuer, submission.KindSetOptions, &txnbuild.SetOptions{
+			SetFlags: setFlags,
+		}, input.MaxFee)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set issuer flags: %w", err)
+		}
+		transactions = append(transactions, tx)
+	}
+
+	// 4. Open the distribution trustline
+	line, err := credit.ToChangeTrustAsset()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trustline asset: %w", err)
+	}
+
+	tx, err := submitOperation(ctx, uc.txBuilder, distribution, submission.KindChangeTrust, &txnbuild.ChangeTrust{
+		Line: line,
+	}, input.MaxFee)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create distribution trustline: %w", err)
+	}
+	transactions = append(transactions, tx)
+
+	// 5. Authorize the distribution trustline
+	if a.AuthRequired {
+		tx, err := submitOperation(ctx, uc.txBuilder, issuer, submission.KindSetTrustFlags, &txnbuild.SetTrustLineFlags{
+			Trustor:  distribution.PublicKey,
+			Asset:    credit,
+			SetFlags: []txnbuild.TrustLineFlag{txnbuild.TrustLineAuthorized},
+		}, input.MaxFee)
+		if err != nil {
+			return nil, fmt.Errorf("failed to authorize distribution trustline: %w", err)
+		}
+		transactions = append(transactions, tx)
+	}
+
+	// 6. Record the asset
+	if err := uc.assets.Create(ctx, a); err != nil {
+		uc.logger.Error("failed to save asset", logger.Error(err))
+		return nil, fmt.Errorf("failed to save asset: %w", err)
+	}
+
+	uc.logger.Info("asset issued",
+		logger.String("asset_id", a.ID.String()),
+		logger.String("code", a.Code),
+		logger.String("issuer", a.IssuerPublicKey))
+
+	// 7. Mint the initial supply
+	if initialSupply.IsPositive() {
+		tx, err := mint(ctx, uc.assets, uc.txBuilder, uc.logger, a, issuer, distribution, initialSupply, input.MaxFee)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, tx)
+	}
+
+	return &AssetOperationOutput{
+		Asset:        toAssetOutput(a),
+		Transactions: transactions,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/asset"
+)
+
+// ListAssetsOutput represents a page of issued assets
+type ListAssetsOutput struct {
+	Assets []AssetOutput `json:"assets"`
+	Total  int64         `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+}
+
+// ListAssetsUseCase handles listing issued assets with pagination
+type ListAssetsUseCase struct {
+	assets asset.Repository
+}
+
+// NewListAssetsUseCase creates a new list assets use case
+func NewListAssetsUseCase(assets asset.Repository) *ListAssetsUseCase {
+	return &ListAssetsUseCase{
+		assets: assets,
+	}
+}
+
+// Execute retrieves a paginated list of issued assets, newest first
+func (uc *ListAssetsUseCase) Execute(ctx context.Context, limit, offset int) (*ListAssetsOutput, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	assets, err := uc.assets.List(ctx, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assets: %w", err)
+	}
+
+	total, err := uc.assets.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count assets: %w", err)
+	}
+
+	items := make([]AssetOutput, 0, len(assets))
+	for _, a := range assets {
+		items = append(items, toAssetOutput(a))
+	}
+
+	return &ListAssetsOutput{
+		Assets: items,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/asset"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/txnbuild"
+)
+
+// LockIssuerInput represents a request to permanently lock an asset's issuer
+type LockIssuerInput struct {
+	AssetID uuid.UUID `json:"asset_id"`
+	MaxFee  int64     `json:"max_fee,omitempty"`
+}
+
+// LockIssuerUseCase sets the issuer's master key weight to zero, fixing the supply.
+// This cannot be undone: the issuer wallet can never sign again.
+type LockIssuerUseCase struct {
+	assets    asset.Repository
+	wallets   wallet.Repository
+	txBuilder *walletUC.TransactionBuilder
+	logger    logger.Logger
+}
+
+// NewLockIssuerUseCase creates a new lock issuer use case
+func NewLockIssuerUseCase(
+	assets asset.Repository,
+	wallets wallet.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *LockIssuerUseCase {
+	return &LockIssuerUseCase{
+		assets:    assets,
+		wallets:   wallets,
+		txBuilder: txBuilder,
+		logger:    logger,
+	}
+}
+
+// Execute locks the issuer and marks every asset it issues as locked
+func (uc *LockIssuerUseCase) Execute(ctx context.Context, input LockIssuerInput) (*AssetOperationOutput, error) {
+	a, err := uc.assets.FindByID(ctx, input.AssetID)
+	if err != nil {
+		return nil, errors.ErrAssetNotFound
+	}
+
+	if a.Locked {
+		return nil, errors.ErrIssuerLocked
+	}
+
+	issuer, err := uc.wallets.FindByID(ctx, a.IssuerWalletID)
+	if err != nil {
+		return nil, fmt.Errorf("issuer wallet not found: %w", err)
+	}
+
+	tx, err := submitOperation(ctx, uc.txBuilder, issuer, submission.KindSetOptions, &txnbuild.SetOptions{
+		MasterWeight: txnbuild.NewThreshold(0),
+	}, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	siblings, err := uc.assets.ListByIssuerWallet(ctx, a.IssuerWalletID)
+	if err != nil {
+		uc.logger.Error("failed to list issuer assets", logger.Error(err))
+		siblings = []*asset.Asset{a}
+	}
+	for _, s := range siblings {
+		if s.ID == a.ID {
+			s = a
+		}
+		if err := uc.assets.MarkLocked(ctx, s); err != nil {
+			uc.logger.Error("failed to mark asset locked", logger.Error(err), logger.String("asset_id", s.ID.String()))
+		}
+	}
+
+	uc.logger.Warn("issuer locked",
+		logger.String("asset_id", a.ID.String()),
+		logger.String("issuer", a.IssuerPublicKey),
+		logger.String("hash", tx.TransactionHash))
+
+	return &AssetOperationOutput{
+		Asset:        toAssetOutput(a),
+		Transactions: []TransactionOutput{tx},
+	}, nil
+}
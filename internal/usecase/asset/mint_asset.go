@@ -0,0 +1,121 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/asset"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/txnbuild"
+)
+
+// MintAssetInput represents a request to issue new units of an asset
+type MintAssetInput struct {
+	AssetID uuid.UUID `json:"asset_id"`
+	Amount  string    `json:"amount" validate:"required"`
+	MaxFee  int64     `json:"max_fee,omitempty"`
+}
+
+// MintAssetUseCase issues new units by paying them from the issuer to the distribution wallet
+type MintAssetUseCase struct {
+	assets    asset.Repository
+	wallets   wallet.Repository
+	txBuilder *walletUC.TransactionBuilder
+	logger    logger.Logger
+}
+
+// NewMintAssetUseCase creates a new mint asset use case
+func NewMintAssetUseCase(
+	assets asset.Repository,
+	wallets wallet.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *MintAssetUseCase {
+	return &MintAssetUseCase{
+		assets:    assets,
+		wallets:   wallets,
+		txBuilder: txBuilder,
+		logger:    logger,
+	}
+}
+
+// Execute mints the requested amount and updates the recorded supply
+func (uc *MintAssetUseCase) Execute(ctx context.Context, input MintAssetInput) (*AssetOperationOutput, error) {
+	amount, err := domainStellar.ParseAmount(input.Amount)
+	if err != nil {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	a, err := uc.assets.FindByID(ctx, input.AssetID)
+	if err != nil {
+		return nil, errors.ErrAssetNotFound
+	}
+
+	if a.Locked {
+		return nil, errors.ErrIssuerLocked
+	}
+
+	issuer, err := uc.wallets.FindByID(ctx, a.IssuerWalletID)
+	if err != nil {
+		return nil, fmt.Errorf("issuer wallet not found: %w", err)
+	}
+
+	distribution, err := uc.wallets.FindByID(ctx, a.DistributionWalletID)
+	if err != nil {
+		return nil, fmt.Errorf("distribution wallet not found: %w", err)
+	}
+
+	tx, err := mint(ctx, uc.assets, uc.txBuilder, uc.logger, a, issuer, distribution, amount, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AssetOperationOutput{
+		Asset:        toAssetOutput(a),
+		Transactions: []TransactionOutput{tx},
+	}, nil
+}
+
+// mint pays amount from the issuer to the distribution wallet and records it in the supply
+func mint(
+	ctx context.Context,
+	assets asset.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	log logger.Logger,
+	a *asset.Asset,
+	issuer, distribution *wallet.Wallet,
+	amount decimal.Decimal,
+	maxFee int64,
+) (TransactionOutput, error) {
+	tx, err := submitOperation(ctx, txBuilder, issuer, submission.KindPayment, &txnbuild.Payment{
+		Destination: distribution.PublicKey,
+		Amount:      amount.String(),
+		Asset:       creditAsset(a),
+	}, maxFee)
+	if err != nil {
+		return TransactionOutput{}, fmt.Errorf("failed to mint asset: %w", err)
+	}
+
+	if err := assets.AddMinted(ctx, a, amount); err != nil {
+		// The payment is on the ledger; the supply can be reconciled from the issuer's history
+		log.Error("asset minted but supply not recorded",
+			logger.Error(err),
+			logger.String("asset_id", a.ID.String()),
+			logger.String("hash", tx.TransactionHash))
+	}
+
+	log.Info("asset minted",
+		logger.String("asset_id", a.ID.String()),
+		logger.String("amount", amount.String()),
+		logger.String("hash", tx.TransactionHash))
+
+	return tx, nil
+}
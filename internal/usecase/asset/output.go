@@ -0,0 +1,122 @@
+package asset
+
+import (
+	"context"
+	"time"
+
+	"quasarflow-api/internal/domain/asset"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+
+	"github.com/stellar/go/txnbuild"
+)
+
+// AssetOutput represents an issued asset and its supply
+type AssetOutput struct {
+	ID                   string `json:"id"`
+	Code                 string `json:"code"`
+	Issuer               string `json:"issuer"`
+	IssuerWalletID       string `json:"issuer_wallet_id"`
+	DistributionWalletID string `json:"distribution_wallet_id"`
+	AuthRequired         bool   `json:"auth_required"`
+	AuthRevocable        bool   `json:"auth_revocable"`
+	ClawbackEnabled      bool   `json:"clawback_enabled"`
+	Locked               bool   `json:"locked"`
+	Minted               string `json:"minted"`
+	ClawedBack           string `json:"clawed_back"`
+	Supply               string `json:"supply"`
+	CreatedAt            string `json:"created_at"`
+	UpdatedAt            string `json:"updated_at"`
+}
+
+// TransactionOutput identifies a transaction submitted on behalf of an asset operation
+type TransactionOutput struct {
+	Kind            string `json:"kind"`
+	SubmissionID    string `json:"submission_id"`
+	TransactionHash string `json:"transaction_hash"`
+	Ledger          int32  `json:"ledger"`
+}
+
+// AssetOperationOutput is returned by every use case that changes an asset on the ledger
+type AssetOperationOutput struct {
+	Asset        AssetOutput         `json:"asset"`
+	Transactions []TransactionOutput `json:"transactions"`
+}
+
+func toAssetOutput(a *asset.Asset) AssetOutput {
+	return AssetOutput{
+		ID:                   a.ID.String(),
+		Code:                 a.Code,
+		Issuer:               a.IssuerPublicKey,
+		IssuerWalletID:       a.IssuerWalletID.String(),
+		DistributionWalletID: a.DistributionWalletID.String(),
+		AuthRequired:         a.AuthRequired,
+		AuthRevocable:        a.AuthRevocable,
+		ClawbackEnabled:      a.ClawbackEnabled,
+		Locked:               a.Locked,
+		Minted:               a.Minted.String(),
+		ClawedBack:           a.ClawedBack.String(),
+		Supply:               a.Supply().String(),
+		CreatedAt:            a.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:            a.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// creditAsset returns the txnbuild representation of an issued asset
+func creditAsset(a *asset.Asset) txnbuild.CreditAsset {
+	return txnbuild.CreditAsset{Code: a.Code, Issuer: a.IssuerPublicKey}
+}
+
+// submitOperation builds, signs and submits a single-operation transaction for a wallet
+func submitOperation(
+	ctx context.Context,
+	txBuilder *walletUC.TransactionBuilder,
+	w *wallet.Wallet,
+	kind string,
+	op txnbuild.Operation,
+	maxFee int64,
+) (TransactionOutput, error) {
+	signed, err := txBuilder.Build(ctx, walletUC.BuildTransactionParams{
+		Wallet:     w,
+		Kind:       kind,
+		Operations: []txnbuild.Operation{op},
+		MaxFee:     maxFee,
+	})
+	if err != nil {
+		return TransactionOutput{}, err
+	}
+
+	resp, err := txBuilder.Submit(ctx, signed)
+	if err != nil {
+		return TransactionOutput{}, err
+	}
+
+	return TransactionOutput{
+		Kind:            kind,
+		SubmissionID:    resp.SubmissionID.String(),
+		TransactionHash: resp.Hash,
+		Ledger:          resp.Ledger,
+	}, nil
+}
+
+// issuerFlagOps translates asset flags into issuer SetOptions set and clear lists
+func issuerFlagOps(a *asset.Asset) (set, clear []txnbuild.AccountFlag) {
+	flags := []struct {
+		enabled bool
+		flag    txnbuild.AccountFlag
+	}{
+		{a.AuthRequired, txnbuild.AuthRequired},
+		{a.AuthRevocable, txnbuild.AuthRevocable},
+		{a.ClawbackEnabled, txnbuild.AuthClawbackEnabled},
+	}
+
+	for _, f := range flags {
+		if f.enabled {
+			set = append(set, f.flag)
+		} else {
+			clear = append(clear, f.flag)
+		}
+	}
+
+	return set, clear
+}
@@ -0,0 +1,105 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/asset"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/txnbuild"
+)
+
+// SetAssetFlagsInput represents the desired issuer flags for an asset
+type SetAssetFlagsInput struct {
+	AssetID         uuid.UUID `json:"asset_id"`
+	AuthRequired    bool      `json:"auth_required"`
+	AuthRevocable   bool      `json:"auth_revocable"`
+	ClawbackEnabled bool      `json:"clawback_enabled"`
+	MaxFee          int64     `json:"max_fee,omitempty"`
+}
+
+// SetAssetFlagsUseCase sets or clears AUTH_REQUIRED, AUTH_REVOCABLE and AUTH_CLAWBACK_ENABLED on the issuer
+type SetAssetFlagsUseCase struct {
+	assets    asset.Repository
+	wallets   wallet.Repository
+	txBuilder *walletUC.TransactionBuilder
+	logger    logger.Logger
+}
+
+// NewSetAssetFlagsUseCase creates a new set asset flags use case
+func NewSetAssetFlagsUseCase(
+	assets asset.Repository,
+	wallets wallet.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *SetAssetFlagsUseCase {
+	return &SetAssetFlagsUseCase{
+		assets:    assets,
+		wallets:   wallets,
+		txBuilder: txBuilder,
+		logger:    logger,
+	}
+}
+
+// Execute applies the flags to the issuer account. Flags are account-wide, so every
+// asset issued by the same wallet is updated. Clawback only applies to trustlines
+// created after it is enabled.
+func (uc *SetAssetFlagsUseCase) Execute(ctx context.Context, input SetAssetFlagsInput) (*AssetOperationOutput, error) {
+	a, err := uc.assets.FindByID(ctx, input.AssetID)
+	if err != nil {
+		return nil, errors.ErrAssetNotFound
+	}
+
+	if a.Locked {
+		return nil, errors.ErrIssuerLocked
+	}
+
+	if err := a.SetFlags(input.AuthRequired, input.AuthRevocable, input.ClawbackEnabled); err != nil {
+		return nil, errors.ErrInvalidAssetFlags
+	}
+
+	issuer, err := uc.wallets.FindByID(ctx, a.IssuerWalletID)
+	if err != nil {
+		return nil, fmt.Errorf("issuer wallet not found: %w", err)
+	}
+
+	setFlags, clearFlags := issuerFlagOps(a)
+	tx, err := submitOperation(ctx, uc.txBuilder, issuer, submission.KindSetOptions, &txnbuild.SetOptions{
+		SetFlags:   setFlags,
+		ClearFlags: clearFlags,
+	}, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	siblings, err := uc.assets.ListByIssuerWallet(ctx, a.IssuerWalletID)
+	if err != nil {
+		uc.logger.Error("failed to list issuer assets", logger.Error(err))
+		siblings = []*asset.Asset{a}
+	}
+	for _, s := range siblings {
+		if s.ID == a.ID {
+			s = a
+		} else if err := s.SetFlags(a.AuthRequired, a.AuthRevocable, a.ClawbackEnabled); err != nil {
+			continue
+		}
+		if err := uc.assets.UpdateFlags(ctx, s); err != nil {
+			uc.logger.Error("failed to update asset flags", logger.Error(err), logger.String("asset_id", s.ID.String()))
+		}
+	}
+
+	uc.logger.Info("issuer flags updated",
+		logger.String("asset_id", a.ID.String()),
+		logger.String("hash", tx.TransactionHash))
+
+	return &AssetOperationOutput{
+		Asset:        toAssetOutput(a),
+		Transactions: []TransactionOutput{tx},
+	}, nil
+}
@@ -0,0 +1,93 @@
+package balance
+
+import (
+	"context"
+	"time"
+
+	"quasarflow-api/internal/domain/ledger"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// GetBalanceAtInput selects a wallet and the time to report its balances at
+type GetBalanceAtInput struct {
+	WalletID uuid.UUID
+	At       time.Time
+}
+
+type GetBalanceAtOutput struct {
+	WalletID   string         `json:"wallet_id"`
+	PublicKey  string         `json:"public_key"`
+	Network    string         `json:"network"`
+	At         time.Time      `json:"at"`
+	Balances   []AssetBalance `json:"balances"`
+	SnapshotAt *time.Time     `json:"snapshot_at,omitempty"` // Snapshot the balances were replayed from, absent when replayed from the account's creation
+}
+
+// GetBalanceAtUseCase reports a wallet's balances as they were at a past time,
+// including every operation closed at or before it
+type GetBalanceAtUseCase struct {
+	wallets  wallet.Repository
+	replayer *replayer
+	logger   logger.Logger
+}
+
+// NewGetBalanceAtUseCase creates a new balance-at-time use case
+func NewGetBalanceAtUseCase(
+	wallets wallet.Repository,
+	index ledger.Repository,
+	snapshots ledger.SnapshotRepository,
+	logger logger.Logger,
+) *GetBalanceAtUseCase {
+	return &GetBalanceAtUseCase{
+		wallets:  wallets,
+		replayer: &replayer{index: index, snapshots: snapshots},
+		logger:   logger,
+	}
+}
+
+func (uc *GetBalanceAtUseCase) Execute(ctx context.Context, input GetBalanceAtInput) (*GetBalanceAtOutput, error) {
+	if input.At.After(time.Now()) {
+		return nil, errors.NewValidationError("Invalid time", "at cannot be in the future")
+	}
+	at := input.At.UTC()
+
+	// 1. Find wallet in database
+	w, err := uc.wallets.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Wallet not found")
+	}
+
+	// 2. Start from the latest snapshot before the requested time
+	balances, snapshot, err := uc.replayer.start(ctx, w, at)
+	if err != nil {
+		return nil, err
+	}
+
+	from, after := since(snapshot)
+	var snapshotAt *time.Time
+	if snapshot != nil {
+		snapshotAt = &snapshot.TakenAt
+	}
+
+	// 3. Apply the operations closed since, up to and including the requested time.
+	// The index stores times with microsecond precision.
+	if err := uc.replayer.replay(ctx, w, balances, from, after, at.Add(time.Microsecond), nil); err != nil {
+		uc.logger.Error("failed to reconstruct balances",
+			logger.String("wallet_id", w.ID.String()),
+			logger.Error(err))
+		return nil, err
+	}
+
+	return &GetBalanceAtOutput{
+		WalletID:   w.ID.String(),
+		PublicKey:  w.PublicKey,
+		Network:    w.Network,
+		At:         at,
+		Balances:   toAssetBalances(balances, ""),
+		SnapshotAt: snapshotAt,
+	}, nil
+}
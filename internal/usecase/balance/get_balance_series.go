@@ -0,0 +1,186 @@
+package balance
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"quasarflow-api/internal/domain/ledger"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	// defaultSeriesDays is the span of a series requested without a start date
+	defaultSeriesDays = 30
+
+	// maxSeriesDays limits a series to about a year of daily points
+	maxSeriesDays = 366
+)
+
+// GetBalanceSeriesInput selects a wallet and a range of UTC days, both inclusive
+type GetBalanceSeriesInput struct {
+	WalletID uuid.UUID
+	From     *time.Time // Default: 30 days before To
+	To       *time.Time // Default: today
+	Asset    string     // Only this asset: "native", "XLM", "CODE:ISSUER" or "liquidity_pool:<pool id>"
+}
+
+// BalancePoint is the balances at the end of one UTC day
+type BalancePoint struct {
+	Date     string         `json:"date"` // YYYY-MM-DD
+	Balances []AssetBalance `json:"balances"`
+}
+
+type GetBalanceSeriesOutput struct {
+	WalletID  string         `json:"wallet_id"`
+	PublicKey string         `json:"public_key"`
+	Network   string         `json:"network"`
+	From      string         `json:"from"`
+	To        string         `json:"to"`
+	Points    []BalancePoint `json:"points"`
+}
+
+// GetBalanceSeriesUseCase reports a wallet's end-of-day balances over a range of days.
+// The current day reports the balances so far.
+type GetBalanceSeriesUseCase struct {
+	wallets  wallet.Repository
+	replayer *replayer
+	logger   logger.Logger
+}
+
+// NewGetBalanceSeriesUseCase creates a new balance series use case
+func NewGetBalanceSeriesUseCase(
+	wallets wallet.Repository,
+	index ledger.Repository,
+	snapshots ledger.SnapshotRepository,
+	logger logger.Logger,
+) *GetBalanceSeriesUseCase {
+	return &GetBalanceSeriesUseCase{
+		wallets:  wallets,
+		replayer: &replayer{index: index, snapshots: snapshots},
+		logger:   logger,
+	}
+}
+
+func (uc *GetBalanceSeriesUseCase) Execute(ctx context.Context, input GetBalanceSeriesInput) (*GetBalanceSeriesOutput, error) {
+	// 1. Validate the range
+	first, last, err := seriesRange(input.From, input.To)
+	if err != nil {
+		return nil, err
+	}
+
+	asset, err := normalizeAsset(input.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Find wallet in database
+	w, err := uc.wallets.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Wallet not found")
+	}
+
+	// 3. Start from the latest snapshot before the end of the first day
+	end := first.AddDate(0, 0, 1)
+	balances, snapshot, err := uc.replayer.start(ctx, w, end)
+	if err != nil {
+		return nil, err
+	}
+
+	from, after := since(snapshot)
+
+	// 4. Replay up to the end of the last day, recording the balances whenever an
+	// operation closes after the end of the current day
+	points := make([]BalancePoint, 0, int(last.Sub(first).Hours()/24)+1)
+	record := func() {
+		points = append(points, BalancePoint{
+			Date:     end.AddDate(0, 0, -1).Format(dateLayout),
+			Balances: toAssetBalances(balances, asset),
+		})
+		end = end.AddDate(0, 0, 1)
+	}
+
+	stop := last.AddDate(0, 0, 1)
+	if err := uc.replayer.replay(ctx, w, balances, from, after, stop, func(op *ledger.Operation, _ *ledger.Transaction, _ decimal.Decimal) error {
+		for !op.ClosedAt.Before(end) {
+			record()
+		}
+		return nil
+	}); err != nil {
+		uc.logger.Error("failed to reconstruct balance series",
+			logger.String("wallet_id", w.ID.String()),
+			logger.Error(err))
+		return nil, err
+	}
+
+	for !end.After(stop) {
+		record()
+	}
+
+	return &GetBalanceSeriesOutput{
+		WalletID:  w.ID.String(),
+		PublicKey: w.PublicKey,
+		Network:   w.Network,
+		From:      first.Format(dateLayout),
+		To:        last.Format(dateLayout),
+		Points:    points,
+	}, nil
+}
+
+// seriesRange returns the first and last day of a series as UTC midnights
+func seriesRange(from, to *time.Time) (time.Time, time.Time, error) {
+	today := truncateDay(time.Now())
+
+	last := today
+	if to != nil {
+		last = truncateDay(*to)
+	}
+	if last.After(today) {
+		last = today
+	}
+
+	first := last.AddDate(0, 0, -(defaultSeriesDays - 1))
+	if from != nil {
+		first = truncateDay(*from)
+	}
+
+	if first.After(last) {
+		return first, last, errors.NewValidationError("Invalid date range", "from must not be after to")
+	}
+	if last.Sub(first) >= maxSeriesDays*24*time.Hour {
+		return first, last, errors.NewValidationError("Invalid date range", "a series covers at most 366 days")
+	}
+
+	return first, last, nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// normalizeAsset accepts "native", "XLM", "CODE:ISSUER" or "liquidity_pool:<pool id>"
+func normalizeAsset(asset string) (string, error) {
+	asset = strings.TrimSpace(asset)
+	switch {
+	case asset == "":
+		return "", nil
+	case strings.EqualFold(asset, "native"), strings.EqualFold(asset, "XLM"):
+		return "native", nil
+	case strings.HasPrefix(asset, "liquidity_pool:"):
+		return asset, nil
+	}
+
+	parts := strings.Split(asset, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", errors.NewValidationError("Invalid asset", "asset must be native, CODE:ISSUER or liquidity_pool:<pool id>")
+	}
+	return asset, nil
+}
@@ -0,0 +1,215 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"quasarflow-api/internal/domain/ledger"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// replayPageSize is how many indexed operations are read per query while replaying
+const replayPageSize = 200
+
+// AssetBalance is a balance of one asset
+type AssetBalance struct {
+	Asset   string `json:"asset"` // "native", "CODE:ISSUER" or "liquidity_pool:<pool id>"
+	Balance string `json:"balance"`
+}
+
+// replayer reconstructs past balances: it starts from the latest snapshot before the
+// requested time, or from an empty account, and applies the per-asset flows and fees
+// of the wallet's indexed operations that followed
+type replayer struct {
+	index     ledger.Repository
+	snapshots ledger.SnapshotRepository
+}
+
+// start returns the balances to replay from for a time and the snapshot they come from,
+// nil when replaying from the start of the account's history. Replaying needs the
+// wallet's complete history, so it fails while the history is still being backfilled.
+func (r *replayer) start(ctx context.Context, w *wallet.Wallet, at time.Time) (map[string]decimal.Decimal, *ledger.BalanceSnapshot, error) {
+	state, err := r.index.GetState(ctx, w.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get index state: %w", err)
+	}
+	if state == nil || state.BackfilledAt == nil {
+		return nil, nil, errors.ErrBalanceHistoryUnavailable
+	}
+
+	snapshot, err := r.snapshots.FindLatest(ctx, w.ID, at)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balances := make(map[string]decimal.Decimal)
+	if snapshot != nil {
+		for asset, amount := range snapshot.Balances {
+			balances[asset] = amount
+		}
+	}
+
+	return balances, snapshot, nil
+}
+
+// DeriveBalances returns the wallet's balances after its indexed operations closed before
+// to, replayed from the account's creation without snapshots so they depend on the index
+// alone. Like past balances, it fails while the history is still being backfilled.
+func DeriveBalances(ctx context.Context, index ledger.Repository, w *wallet.Wallet, to time.Time) (map[string]decimal.Decimal, error) {
+	state, err := index.GetState(ctx, w.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get index state: %w", err)
+	}
+	if state == nil || state.BackfilledAt == nil {
+		return nil, errors.ErrBalanceHistoryUnavailable
+	}
+
+	balances := make(map[string]decimal.Decimal)
+	r := &replayer{index: index}
+	if err := r.replay(ctx, w, balances, nil, 0, to, nil); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// visitFunc is called with each replayed operation ahead of applying it, together with
+// its transaction, nil if it was not indexed, and the fee the wallet paid for that
+// transaction, which is charged with its first operation. An error stops the replay.
+type visitFunc func(op *ledger.Operation, tx *ledger.Transaction, fee decimal.Decimal) error
+
+// since returns where to replay from after a snapshot: the operations following its
+// ledger, or those closed at or after it was taken when it has none. A nil snapshot
+// replays from the account's creation.
+func since(snapshot *ledger.BalanceSnapshot) (*time.Time, int64) {
+	if snapshot == nil {
+		return nil, 0
+	}
+	return &snapshot.TakenAt, snapshot.ReplayAfter()
+}
+
+// replay applies the wallet's operations closed in [from, to) and positioned after the
+// given one, if any, to balances in ledger order
+func (r *replayer) replay(
+	ctx context.Context,
+	w *wallet.Wallet,
+	balances map[string]decimal.Decimal,
+	from *time.Time,
+	after int64,
+	to time.Time,
+	visit visitFunc,
+) error {
+	filter := ledger.Filter{
+		WalletID: w.ID,
+		From:     from,
+		To:       &to,
+		After:    after,
+		Order:    ledger.OrderAsc,
+		Limit:    replayPageSize,
+	}
+	charged := make(map[string]bool)
+
+	for {
+		ops, err := r.index.ListOperations(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to replay operations: %w", err)
+		}
+
+		txs, err := r.transactions(ctx, ops)
+		if err != nil {
+			return err
+		}
+
+		for _, op := range ops {
+			tx := txs[op.TransactionHash]
+			fee := decimal.Zero
+			if !charged[op.TransactionHash] {
+				charged[op.TransactionHash] = true
+				fee = feePaid(w.PublicKey, tx)
+			}
+
+			if visit != nil {
+				if err := visit(op, tx, fee); err != nil {
+					return err
+				}
+			}
+			balances["native"] = balances["native"].Sub(fee)
+			for _, f := range ledger.Flows(op.Effects) {
+				balances[f.Asset] = balances[f.Asset].Add(f.Net())
+			}
+		}
+
+		if len(ops) < replayPageSize {
+			return nil
+		}
+		filter.After = ops[len(ops)-1].Position
+	}
+}
+
+// transactions returns the indexed transactions of ops by hash
+func (r *replayer) transactions(ctx context.Context, ops []*ledger.Operation) (map[string]*ledger.Transaction, error) {
+	hashes := make([]string, 0, len(ops))
+	seen := make(map[string]bool)
+	for _, op := range ops {
+		if !seen[op.TransactionHash] {
+			seen[op.TransactionHash] = true
+			hashes = append(hashes, op.TransactionHash)
+		}
+	}
+
+	txs := make(map[string]*ledger.Transaction, len(hashes))
+	if len(hashes) == 0 {
+		return txs, nil
+	}
+
+	found, err := r.index.FindTransactions(ctx, hashes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay transactions: %w", err)
+	}
+	for _, tx := range found {
+		txs[tx.Hash] = tx
+	}
+
+	return txs, nil
+}
+
+// feePaid returns the fee the account paid for a transaction. Fees are paid by the
+// transaction's source account, whether or not the transaction succeeded.
+func feePaid(account string, tx *ledger.Transaction) decimal.Decimal {
+	if tx == nil || tx.SourceAccount != account || tx.FeeCharged <= 0 {
+		return decimal.Zero
+	}
+	return decimal.New(tx.FeeCharged, -domainStellar.AmountDecimals)
+}
+
+// toAssetBalances lists non-zero balances sorted by asset, optionally only one asset
+func toAssetBalances(balances map[string]decimal.Decimal, asset string) []AssetBalance {
+	result := make([]AssetBalance, 0, len(balances))
+	for a, amount := range balances {
+		if amount.IsZero() || (asset != "" && a != asset) {
+			continue
+		}
+		result = append(result, AssetBalance{Asset: a, Balance: amount.StringFixed(domainStellar.AmountDecimals)})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Asset < result[j].Asset })
+
+	return result
+}
+
+// AssetKey returns the canonical name of a Horizon balance's asset
+func AssetKey(assetType, code, issuer, poolID string) string {
+	switch assetType {
+	case "native":
+		return "native"
+	case domainStellar.AssetTypePoolShares:
+		return "liquidity_pool:" + poolID
+	default:
+		return code + ":" + issuer
+	}
+}
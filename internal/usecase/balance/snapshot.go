@@ -0,0 +1,129 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/ledger"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+// SnapshotOutput summarizes one run of the snapshot job
+type SnapshotOutput struct {
+	Taken   int
+	Skipped int // Accounts not funded yet
+	Failed  int
+}
+
+// SnapshotUseCase stores the live balances of every active wallet. Snapshots are the
+// starting points from which past balances are reconstructed, and keep a record that
+// does not depend on the ledger index.
+type SnapshotUseCase struct {
+	wallets       wallet.Repository
+	snapshots     ledger.SnapshotRepository
+	horizonClient *horizonclient.Client
+	network       string
+	logger        logger.Logger
+}
+
+// NewSnapshotUseCase creates a new snapshot use case
+func NewSnapshotUseCase(
+	wallets wallet.Repository,
+	snapshots ledger.SnapshotRepository,
+	horizonClient *horizonclient.Client,
+	network string,
+	logger logger.Logger,
+) *SnapshotUseCase {
+	return &SnapshotUseCase{
+		wallets:       wallets,
+		snapshots:     snapshots,
+		horizonClient: horizonClient,
+		network:       network,
+		logger:        logger,
+	}
+}
+
+// Execute snapshots every active wallet on the network. A failing wallet is logged and
+// does not stop the others.
+func (uc *SnapshotUseCase) Execute(ctx context.Context) (*SnapshotOutput, error) {
+	wallets, err := uc.wallets.ListActive(ctx, uc.network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	output := &SnapshotOutput{}
+	for _, w := range wallets {
+		if ctx.Err() != nil {
+			return output, ctx.Err()
+		}
+
+		taken, err := uc.snapshot(ctx, w)
+		switch {
+		case err != nil:
+			output.Failed++
+			uc.logger.Error("failed to snapshot wallet balances",
+				logger.String("wallet_id", w.ID.String()),
+				logger.Error(err))
+		case taken:
+			output.Taken++
+		default:
+			output.Skipped++
+		}
+	}
+
+	if output.Taken > 0 || output.Failed > 0 {
+		uc.logger.Info("wallet balances snapshotted",
+			logger.Int("taken", output.Taken),
+			logger.Int("skipped", output.Skipped),
+			logger.Int("failed", output.Failed))
+	}
+
+	return output, nil
+}
+
+// snapshot stores the wallet's current balances and reports whether its account exists.
+// The balances are stamped with the latest ledger that modified the account or any of its
+// balances, since they have been the same since that ledger closed, so that replays apply
+// exactly the operations after it.
+func (uc *SnapshotUseCase) snapshot(ctx context.Context, w *wallet.Wallet) (bool, error) {
+	account, err := uc.horizonClient.AccountDetail(horizonclient.AccountRequest{AccountID: w.PublicKey})
+	if err != nil {
+		if horizonclient.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to load account: %w", err)
+	}
+
+	seq := account.LastModifiedLedger
+	balances := make(map[string]decimal.Decimal, len(account.Balances))
+	for _, b := range account.Balances {
+		amount, err := decimal.NewFromString(b.Balance)
+		if err != nil {
+			return false, fmt.Errorf("invalid balance %q: %w", b.Balance, err)
+		}
+		balances[AssetKey(b.Asset.Type, b.Asset.Code, b.Asset.Issuer, b.LiquidityPoolId)] = amount
+		if b.LastModifiedLedger > seq {
+			seq = b.LastModifiedLedger
+		}
+	}
+
+	closed, err := uc.horizonClient.LedgerDetail(seq)
+	if err != nil {
+		return false, fmt.Errorf("failed to load ledger %d: %w", seq, err)
+	}
+
+	if err := uc.snapshots.Save(ctx, &ledger.BalanceSnapshot{
+		WalletID: w.ID,
+		Balances: balances,
+		TakenAt:  closed.ClosedAt.UTC(),
+		Ledger:   seq,
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
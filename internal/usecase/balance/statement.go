@@ -0,0 +1,392 @@
+package balance
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"quasarflow-api/internal/domain/ledger"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/keypair"
+)
+
+// Statement formats
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+	FormatOFX   = "ofx"
+)
+
+// Statement entry types
+const (
+	EntryCredit = "credit"
+	EntryDebit  = "debit"
+	EntryFee    = "fee"
+)
+
+// StatementHeader opens a statement with the balances at its start
+type StatementHeader struct {
+	WalletID    string         `json:"wallet_id"`
+	PublicKey   string         `json:"public_key"`
+	Network     string         `json:"network"`
+	Asset       string         `json:"asset,omitempty"` // Set when the statement covers one asset
+	From        time.Time      `json:"from"`
+	To          time.Time      `json:"to"` // Exclusive
+	GeneratedAt time.Time      `json:"generated_at"`
+	Opening     []AssetBalance `json:"opening_balances"`
+}
+
+// StatementEntry is one credit, debit or fee. Amounts are positive; the type gives the direction.
+type StatementEntry struct {
+	Time            time.Time `json:"time"`
+	Type            string    `json:"type"`
+	Asset           string    `json:"asset"`
+	Amount          string    `json:"amount"`
+	Counterparty    string    `json:"counterparty,omitempty"`
+	Memo            string    `json:"memo,omitempty"`
+	TransactionHash string    `json:"transaction_hash"`
+	OperationID     string    `json:"operation_id"`
+	OperationType   string    `json:"operation_type"`
+}
+
+// StatementFooter closes a statement with the balances at its end
+type StatementFooter struct {
+	Closing []AssetBalance `json:"closing_balances"`
+	Credits int            `json:"credits"`
+	Debits  int            `json:"debits"`
+	Fees    int            `json:"fees"`
+}
+
+// StatementChecksum identifies an exported file. The signature is an Ed25519 signature
+// of the raw SHA-256 digest by a Stellar key, so anyone with the signer's public key
+// can verify it.
+type StatementChecksum struct {
+	SHA256    string `json:"sha256"`              // Hex digest of the file
+	Signature string `json:"signature,omitempty"` // Base64, empty when signing is not configured
+	Signer    string `json:"signer,omitempty"`    // Public key of the signing key
+}
+
+type ExportStatementInput struct {
+	WalletID uuid.UUID
+	From     time.Time
+	To       time.Time // Exclusive
+	Format   string    // csv, jsonl or ofx; default: csv
+	Asset    string    // Only this asset; OFX covers one asset and defaults to native
+}
+
+// ExportStatementUseCase writes a wallet's statement for a period: opening and closing
+// balances and every credit, debit and fee in between, reconstructed like past balances
+type ExportStatementUseCase struct {
+	wallets  wallet.Repository
+	replayer *replayer
+	signer   *keypair.Full // Nil leaves exports unsigned
+	logger   logger.Logger
+}
+
+// NewExportStatementUseCase creates a new statement export use case
+func NewExportStatementUseCase(
+	wallets wallet.Repository,
+	index ledger.Repository,
+	snapshots ledger.SnapshotRepository,
+	signer *keypair.Full,
+	logger logger.Logger,
+) *ExportStatementUseCase {
+	return &ExportStatementUseCase{
+		wallets:  wallets,
+		replayer: &replayer{index: index, snapshots: snapshots},
+		signer:   signer,
+		logger:   logger,
+	}
+}
+
+// ParseSigner parses the Stellar secret seed that signs statements; an empty seed
+// returns nil, which leaves exports unsigned
+func ParseSigner(seed string) (*keypair.Full, error) {
+	if seed == "" {
+		return nil, nil
+	}
+
+	kp, err := keypair.ParseFull(seed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statement signing seed: %w", err)
+	}
+	return kp, nil
+}
+
+// Signer returns the public key exports are signed with, empty when signing is not configured
+func (uc *ExportStatementUseCase) Signer() string {
+	if uc.signer == nil {
+		return ""
+	}
+	return uc.signer.Address()
+}
+
+// Prepare validates the request and computes the opening balances. Errors are returned
+// here, before anything is written.
+func (uc *ExportStatementUseCase) Prepare(ctx context.Context, input ExportStatementInput) (*Statement, error) {
+	format := strings.ToLower(input.Format)
+	if format == "" {
+		format = FormatCSV
+	}
+	if format != FormatCSV && format != FormatJSONL && format != FormatOFX {
+		return nil, errors.NewValidationError("Invalid format", "format must be csv, jsonl or ofx")
+	}
+
+	asset, err := normalizeAsset(input.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if format == FormatOFX && asset == "" {
+		asset = "native"
+	}
+
+	from, to := input.From.UTC(), input.To.UTC()
+	if now := time.Now().UTC(); to.After(now) {
+		to = now
+	}
+	if !from.Before(to) {
+		return nil, errors.NewValidationError("Invalid date range", "from must be before to and in the past")
+	}
+
+	// 1. Find wallet in database
+	w, err := uc.wallets.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Wallet not found")
+	}
+
+	// 2. Replay up to the start of the period
+	balances, snapshot, err := uc.replayer.start(ctx, w, from)
+	if err != nil {
+		return nil, err
+	}
+
+	start, after := since(snapshot)
+	if err := uc.replayer.replay(ctx, w, balances, start, after, from, nil); err != nil {
+		return nil, err
+	}
+
+	return &Statement{
+		uc:       uc,
+		wallet:   w,
+		format:   format,
+		asset:    asset,
+		balances: balances,
+		header: StatementHeader{
+			WalletID:    w.ID.String(),
+			PublicKey:   w.PublicKey,
+			Network:     w.Network,
+			Asset:       asset,
+			From:        from,
+			To:          to,
+			GeneratedAt: time.Now().UTC(),
+			Opening:     toAssetBalances(balances, asset),
+		},
+	}, nil
+}
+
+// Statement is a prepared export, written once with WriteTo
+type Statement struct {
+	uc       *ExportStatementUseCase
+	wallet   *wallet.Wallet
+	format   string
+	asset    string
+	balances map[string]decimal.Decimal
+	header   StatementHeader
+}
+
+// Filename names the file after the wallet, the period and the format
+func (s *Statement) Filename() string {
+	last := s.header.To.Add(-time.Nanosecond)
+	return fmt.Sprintf("statement-%s-%s-%s.%s",
+		s.wallet.PublicKey[:8], s.header.From.Format(dateLayout), last.Format(dateLayout), s.format)
+}
+
+// ContentType is the media type of the format
+func (s *Statement) ContentType() string {
+	switch s.format {
+	case FormatJSONL:
+		return "application/x-ndjson"
+	case FormatOFX:
+		return "application/x-ofx"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// WriteTo streams the statement to out, reading the period's operations page by page,
+// and returns the checksum of everything written
+func (s *Statement) WriteTo(ctx context.Context, out io.Writer) (*StatementChecksum, error) {
+	digest := sha256.New()
+	enc := newStatementEncoder(s.format, io.MultiWriter(out, digest))
+
+	if err := enc.header(&s.header); err != nil {
+		return nil, err
+	}
+
+	footer := &StatementFooter{}
+	account := s.wallet.PublicKey
+	from := s.header.From
+	err := s.uc.replayer.replay(ctx, s.wallet, s.balances, &from, 0, s.header.To, func(op *ledger.Operation, tx *ledger.Transaction, fee decimal.Decimal) error {
+		entry := StatementEntry{
+			Time:            op.ClosedAt.UTC(),
+			Counterparty:    counterpartyOf(op, account),
+			TransactionHash: op.TransactionHash,
+			OperationID:     op.ID,
+			OperationType:   op.Type,
+		}
+		if tx != nil {
+			entry.Memo = tx.Memo
+		}
+
+		write := func(entryType, asset string, amount decimal.Decimal) error {
+			if amount.IsZero() || (s.asset != "" && asset != s.asset) {
+				return nil
+			}
+			e := entry
+			e.Type = entryType
+			e.Asset = asset
+			e.Amount = amount.StringFixed(domainStellar.AmountDecimals)
+			switch entryType {
+			case EntryCredit:
+				footer.Credits++
+			case EntryDebit:
+				footer.Debits++
+			case EntryFee:
+				footer.Fees++
+				e.Counterparty = ""
+			}
+			return enc.entry(&e)
+		}
+
+		if err := write(EntryFee, "native", fee); err != nil {
+			return err
+		}
+		for _, f := range ledger.Flows(op.Effects) {
+			if err := write(EntryCredit, f.Asset, f.In); err != nil {
+				return err
+			}
+			if err := write(EntryDebit, f.Asset, f.Out); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.uc.logger.Error("statement export interrupted",
+			logger.String("wallet_id", s.wallet.ID.String()),
+			logger.Error(err))
+		return nil, err
+	}
+
+	footer.Closing = toAssetBalances(s.balances, s.asset)
+	if err := enc.footer(footer); err != nil {
+		return nil, err
+	}
+
+	sum := digest.Sum(nil)
+	checksum := &StatementChecksum{SHA256: hex.EncodeToString(sum)}
+	if s.uc.signer != nil {
+		signature, err := s.uc.signer.Sign(sum)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign statement: %w", err)
+		}
+		checksum.Signature = base64.StdEncoding.EncodeToString(signature)
+		checksum.Signer = s.uc.signer.Address()
+	}
+
+	s.uc.logger.Info("statement exported",
+		logger.String("wallet_id", s.wallet.ID.String()),
+		logger.String("format", s.format),
+		logger.Int("credits", footer.Credits),
+		logger.Int("debits", footer.Debits),
+		logger.Int("fees", footer.Fees))
+
+	return checksum, nil
+}
+
+// counterpartyOf returns the other account of an operation, empty when there is none
+// or more than one
+func counterpartyOf(op *ledger.Operation, account string) string {
+	other := func(a, b string) string {
+		if a == account {
+			return b
+		}
+		return a
+	}
+
+	switch d := ledger.DecodeDetails(op.Type, op.Details).(type) {
+	case *ledger.PaymentDetails:
+		return other(d.From, d.To)
+	case *ledger.PathPaymentDetails:
+		return other(d.From, d.To)
+	case *ledger.CreateAccountDetails:
+		return other(d.Funder, d.Account)
+	case *ledger.AccountMergeDetails:
+		return other(d.Account, d.Into)
+	case *ledger.ClawbackDetails:
+		return other(d.From, op.SourceAccount)
+	}
+
+	if len(op.Counterparties) == 1 {
+		return op.Counterparties[0]
+	}
+	return ""
+}
+
+// VerifyStatementOutput reports whether a file matches a signature
+type VerifyStatementOutput struct {
+	SHA256 string `json:"sha256"`
+	Signer string `json:"signer"`
+	Valid  bool   `json:"valid"`
+}
+
+// VerifyStatementUseCase checks an exported file against its signature
+type VerifyStatementUseCase struct {
+	signer string // Default signer public key
+}
+
+// NewVerifyStatementUseCase creates a new statement verification use case. signer is the
+// public key checked when a request names none.
+func NewVerifyStatementUseCase(signer string) *VerifyStatementUseCase {
+	return &VerifyStatementUseCase{signer: signer}
+}
+
+// Execute hashes file and checks the base64 signature of the digest by signer, or by
+// the configured key when signer is empty
+func (uc *VerifyStatementUseCase) Execute(ctx context.Context, file io.Reader, signature, signer string) (*VerifyStatementOutput, error) {
+	if signer == "" {
+		signer = uc.signer
+	}
+	kp, err := keypair.ParseAddress(signer)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid signer", "signer must be a Stellar public key")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(sig) == 0 {
+		return nil, errors.NewValidationError("Invalid signature", "signature must be base64")
+	}
+
+	digest := sha256.New()
+	if _, err := io.Copy(digest, file); err != nil {
+		return nil, fmt.Errorf("failed to read statement: %w", err)
+	}
+	sum := digest.Sum(nil)
+
+	return &VerifyStatementOutput{
+		SHA256: hex.EncodeToString(sum),
+		Signer: signer,
+		Valid:  kp.Verify(sum, sig) == nil,
+	}, nil
+}
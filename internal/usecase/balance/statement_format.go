@@ -0,0 +1,179 @@
+package balance
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// statementEncoder writes a statement in one format
+type statementEncoder interface {
+	header(h *StatementHeader) error
+	entry(e *StatementEntry) error
+	footer(f *StatementFooter) error
+}
+
+func newStatementEncoder(format string, w io.Writer) statementEncoder {
+	switch format {
+	case FormatJSONL:
+		return &jsonlEncoder{enc: json.NewEncoder(w)}
+	case FormatOFX:
+		return &ofxEncoder{w: bufio.NewWriter(w)}
+	default:
+		return &csvEncoder{w: csv.NewWriter(w)}
+	}
+}
+
+// csvEncoder writes one row per entry, framed by opening and closing balance rows
+type csvEncoder struct {
+	w  *csv.Writer
+	to time.Time
+}
+
+var csvColumns = []string{
+	"time", "type", "asset", "amount", "counterparty", "memo", "transaction_hash", "operation_id", "operation_type",
+}
+
+func (c *csvEncoder) header(h *StatementHeader) error {
+	c.to = h.To
+	if err := c.w.Write(csvColumns); err != nil {
+		return err
+	}
+	return c.balances("opening_balance", h.From, h.Opening)
+}
+
+func (c *csvEncoder) entry(e *StatementEntry) error {
+	return c.w.Write([]string{
+		e.Time.Format(time.RFC3339), e.Type, e.Asset, e.Amount, e.Counterparty, e.Memo,
+		e.TransactionHash, e.OperationID, e.OperationType,
+	})
+}
+
+func (c *csvEncoder) footer(f *StatementFooter) error {
+	if err := c.balances("closing_balance", c.to, f.Closing); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvEncoder) balances(rowType string, at time.Time, balances []AssetBalance) error {
+	for _, b := range balances {
+		if err := c.w.Write([]string{at.Format(time.RFC3339), rowType, b.Asset, b.Balance, "", "", "", "", ""}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonlEncoder writes a header line, one line per entry and a footer line, each tagged
+// with its record type
+type jsonlEncoder struct {
+	enc *json.Encoder
+}
+
+func (j *jsonlEncoder) header(h *StatementHeader) error {
+	return j.enc.Encode(struct {
+		Record string `json:"record"`
+		*StatementHeader
+	}{"header", h})
+}
+
+func (j *jsonlEncoder) entry(e *StatementEntry) error {
+	return j.enc.Encode(struct {
+		Record string `json:"record"`
+		*StatementEntry
+	}{"entry", e})
+}
+
+func (j *jsonlEncoder) footer(f *StatementFooter) error {
+	return j.enc.Encode(struct {
+		Record string `json:"record"`
+		*StatementFooter
+	}{"footer", f})
+}
+
+// ofxEncoder writes an OFX 2.2 bank statement for a single asset. The account is the
+// wallet's public key and the currency the asset code; OFX carries the closing balance only.
+type ofxEncoder struct {
+	w     *bufio.Writer
+	asset string
+	to    time.Time
+}
+
+const ofxTimeLayout = "20060102150405"
+
+func (o *ofxEncoder) header(h *StatementHeader) error {
+	o.asset = h.Asset
+	o.to = h.To
+
+	currency := "XLM"
+	switch {
+	case strings.HasPrefix(h.Asset, "liquidity_pool:"):
+		currency = "POOL"
+	case h.Asset != "native":
+		currency, _, _ = strings.Cut(h.Asset, ":")
+	}
+
+	fmt.Fprint(o.w, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>`+"\n")
+	fmt.Fprint(o.w, `<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>`+"\n")
+	fmt.Fprint(o.w, "<OFX>\n<SIGNONMSGSRSV1><SONRS>\n")
+	fmt.Fprint(o.w, "<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n")
+	fmt.Fprintf(o.w, "<DTSERVER>%s</DTSERVER><LANGUAGE>ENG</LANGUAGE>\n", h.GeneratedAt.Format(ofxTimeLayout))
+	fmt.Fprint(o.w, "</SONRS></SIGNONMSGSRSV1>\n<BANKMSGSRSV1><STMTTRNRS>\n")
+	fmt.Fprint(o.w, "<TRNUID>1</TRNUID><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n<STMTRS>\n")
+	fmt.Fprintf(o.w, "<CURDEF>%s</CURDEF>\n", ofxText(currency))
+	fmt.Fprintf(o.w, "<BANKACCTFROM><BANKID>STELLAR</BANKID><ACCTID>%s</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>\n", h.PublicKey)
+	fmt.Fprintf(o.w, "<BANKTRANLIST>\n<DTSTART>%s</DTSTART><DTEND>%s</DTEND>\n", h.From.Format(ofxTimeLayout), h.To.Format(ofxTimeLayout))
+	return o.w.Flush()
+}
+
+func (o *ofxEncoder) entry(e *StatementEntry) error {
+	trnType, sign, fitID := "CREDIT", "", e.OperationID+"-"+e.Type
+	switch e.Type {
+	case EntryDebit:
+		trnType, sign = "DEBIT", "-"
+	case EntryFee:
+		trnType, sign, fitID = "FEE", "-", e.TransactionHash+"-fee"
+	}
+
+	fmt.Fprintf(o.w, "<STMTTRN><TRNTYPE>%s</TRNTYPE><DTPOSTED>%s</DTPOSTED><TRNAMT>%s%s</TRNAMT><FITID>%s</FITID>",
+		trnType, e.Time.Format(ofxTimeLayout), sign, e.Amount, fitID)
+	if e.Counterparty != "" {
+		fmt.Fprintf(o.w, "<NAME>%s</NAME>", ofxText(truncate(e.Counterparty, 32)))
+	}
+	fmt.Fprintf(o.w, "<MEMO>%s</MEMO></STMTTRN>\n", ofxText(truncate(strings.TrimSpace(e.Memo+" "+e.TransactionHash), 255)))
+	return o.w.Flush()
+}
+
+func (o *ofxEncoder) footer(f *StatementFooter) error {
+	closing := "0.0000000"
+	for _, b := range f.Closing {
+		if b.Asset == o.asset {
+			closing = b.Balance
+		}
+	}
+
+	fmt.Fprint(o.w, "</BANKTRANLIST>\n")
+	fmt.Fprintf(o.w, "<LEDGERBAL><BALAMT>%s</BALAMT><DTASOF>%s</DTASOF></LEDGERBAL>\n", closing, o.to.Format(ofxTimeLayout))
+	fmt.Fprint(o.w, "</STMTRS>\n</STMTTRNRS></BANKMSGSRSV1>\n</OFX>\n")
+	return o.w.Flush()
+}
+
+func ofxText(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
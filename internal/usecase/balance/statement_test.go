@@ -0,0 +1,199 @@
+package balance
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"quasarflow-api/internal/domain/ledger"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/keypair"
+)
+
+type stubWallets struct {
+	wallet.Repository
+	w *wallet.Wallet
+}
+
+func (s *stubWallets) FindByID(ctx context.Context, id uuid.UUID) (*wallet.Wallet, error) {
+	return s.w, nil
+}
+
+// stubIndex is a backfilled index without operations
+type stubIndex struct {
+	ledger.Repository
+}
+
+func (s *stubIndex) GetState(ctx context.Context, walletID uuid.UUID) (*ledger.IndexState, error) {
+	backfilled := time.Now()
+	return &ledger.IndexState{WalletID: walletID, BackfilledAt: &backfilled}, nil
+}
+
+func (s *stubIndex) ListOperations(ctx context.Context, filter ledger.Filter) ([]*ledger.Operation, error) {
+	return nil, nil
+}
+
+func (s *stubIndex) FindTransactions(ctx context.Context, hashes []string) ([]*ledger.Transaction, error) {
+	return nil, nil
+}
+
+type stubSnapshots struct {
+	ledger.SnapshotRepository
+	snapshot *ledger.BalanceSnapshot
+}
+
+func (s *stubSnapshots) FindLatest(ctx context.Context, walletID uuid.UUID, at time.Time) (*ledger.BalanceSnapshot, error) {
+	return s.snapshot, nil
+}
+
+// exportStatement exports a statement of a wallet holding 100 XLM, signed by signer
+func exportStatement(t *testing.T, signer *keypair.Full, format string) ([]byte, *StatementChecksum) {
+	t.Helper()
+
+	account, err := keypair.Random()
+	if err != nil {
+		t.Fatalf("keypair.Random() error = %v", err)
+	}
+	w := &wallet.Wallet{ID: uuid.New(), PublicKey: account.Address(), Network: "testnet"}
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	uc := NewExportStatementUseCase(
+		&stubWallets{w: w},
+		&stubIndex{},
+		&stubSnapshots{snapshot: &ledger.BalanceSnapshot{
+			WalletID: w.ID,
+			Balances: map[string]decimal.Decimal{"native": decimal.NewFromInt(100)},
+			TakenAt:  from.Add(-time.Hour),
+		}},
+		signer,
+		logger.New("error"),
+	)
+
+	statement, err := uc.Prepare(context.Background(), ExportStatementInput{
+		WalletID: w.ID,
+		From:     from,
+		To:       from.AddDate(0, 1, 0),
+		Format:   format,
+	})
+	if err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	checksum, err := statement.WriteTo(context.Background(), &out)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	return out.Bytes(), checksum
+}
+
+func TestStatementSignatureRoundTrip(t *testing.T) {
+	issuer, err := keypair.Random()
+	if err != nil {
+		t.Fatalf("keypair.Random() error = %v", err)
+	}
+	other, err := keypair.Random()
+	if err != nil {
+		t.Fatalf("keypair.Random() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		format    string
+		signedBy  *keypair.Full
+		modify    func([]byte) []byte
+		signer    string // Signer named in the request; empty checks the configured key
+		wantValid bool
+	}{
+		{
+			name:      "csv unchanged",
+			format:    FormatCSV,
+			signedBy:  issuer,
+			wantValid: true,
+		},
+		{
+			name:      "jsonl unchanged",
+			format:    FormatJSONL,
+			signedBy:  issuer,
+			wantValid: true,
+		},
+		{
+			name:      "ofx unchanged",
+			format:    FormatOFX,
+			signedBy:  issuer,
+			wantValid: true,
+		},
+		{
+			name:     "altered file",
+			format:   FormatCSV,
+			signedBy: issuer,
+			modify: func(b []byte) []byte {
+				return bytes.Replace(b, []byte("100.0000000"), []byte("900.0000000"), 1)
+			},
+		},
+		{
+			name:     "truncated file",
+			format:   FormatJSONL,
+			signedBy: issuer,
+			modify: func(b []byte) []byte {
+				return b[:len(b)-1]
+			},
+		},
+		{
+			name:     "re-signed by another key",
+			format:   FormatCSV,
+			signedBy: other,
+		},
+		{
+			name:      "another key named explicitly",
+			format:    FormatCSV,
+			signedBy:  other,
+			signer:    other.Address(),
+			wantValid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, checksum := exportStatement(t, tt.signedBy, tt.format)
+			if checksum.Signer != tt.signedBy.Address() {
+				t.Fatalf("checksum signer = %s, want %s", checksum.Signer, tt.signedBy.Address())
+			}
+			if tt.modify != nil {
+				data = tt.modify(data)
+			}
+
+			output, err := NewVerifyStatementUseCase(issuer.Address()).
+				Execute(context.Background(), bytes.NewReader(data), checksum.Signature, tt.signer)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if output.Valid != tt.wantValid {
+				t.Errorf("Execute() valid = %v, want %v", output.Valid, tt.wantValid)
+			}
+			if tt.modify == nil && output.SHA256 != checksum.SHA256 {
+				t.Errorf("Execute() sha256 = %s, want %s", output.SHA256, checksum.SHA256)
+			}
+		})
+	}
+}
+
+func TestVerifyStatementRejectsMissingSignature(t *testing.T) {
+	data, checksum := exportStatement(t, nil, FormatCSV)
+	if checksum.Signature != "" || checksum.Signer != "" {
+		t.Fatalf("unsigned export has signature %q by %q", checksum.Signature, checksum.Signer)
+	}
+
+	issuer, err := keypair.Random()
+	if err != nil {
+		t.Fatalf("keypair.Random() error = %v", err)
+	}
+	if _, err := NewVerifyStatementUseCase(issuer.Address()).Execute(context.Background(), bytes.NewReader(data), "", ""); err == nil {
+		t.Errorf("Execute() without a signature succeeded")
+	}
+}
@@ -0,0 +1,172 @@
+package claimable
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/txnbuild"
+)
+
+// ClaimInput represents a request to claim a claimable balance into a managed wallet.
+// Reclaim restricts the claim to balances the wallet created, typically after expiry.
+type ClaimInput struct {
+	WalletID  uuid.UUID `json:"wallet_id"`
+	BalanceID string    `json:"balance_id"`
+	Reclaim   bool      `json:"-"`
+	MaxFee    int64     `json:"max_fee,omitempty"`
+}
+
+// ClaimUseCase claims or reclaims claimable balances with a managed wallet
+type ClaimUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	txBuilder     *walletUC.TransactionBuilder
+	logger        logger.Logger
+}
+
+// NewClaimUseCase creates a new claim use case
+func NewClaimUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *ClaimUseCase {
+	return &ClaimUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		txBuilder:     txBuilder,
+		logger:        logger,
+	}
+}
+
+// Execute checks that the wallet may claim the balance now and submits ClaimClaimableBalance,
+// preceded in the same transaction by a ChangeTrust when the wallet lacks a trustline for the asset.
+func (uc *ClaimUseCase) Execute(ctx context.Context, input ClaimInput) (*ClaimableBalanceOperationOutput, error) {
+	// 1. Validate balance ID and find wallet
+	balanceID, err := parseBalanceID(input.BalanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	// 2. Load the balance and check the wallet's claim
+	b, err := uc.horizonClient.ClaimableBalance(balanceID)
+	if err != nil {
+		if horizonclient.IsNotFoundError(err) {
+			return nil, errors.ErrClaimableBalanceNotFound
+		}
+		return nil, fmt.Errorf("failed to load claimable balance: %w", err)
+	}
+
+	if input.Reclaim && b.Sponsor != w.PublicKey {
+		return nil, errors.ErrNotBalanceSponsor
+	}
+
+	claimant := findClaimant(&b, w.PublicKey)
+	if claimant == nil {
+		return nil, errors.ErrNotClaimant
+	}
+	if !canClaim(claimant.Predicate, time.Now()) {
+		return nil, errors.ErrClaimPredicateNotMet
+	}
+
+	// 3. Add a trustline for credit assets the wallet cannot hold yet
+	var ops []txnbuild.Operation
+	trustlineCreated := false
+	if asset := balanceAsset(b.Asset); asset != nil && asset.GetIssuer() != w.PublicKey {
+		account, err := uc.horizonClient.AccountDetail(horizonclient.AccountRequest{AccountID: w.PublicKey})
+		if err != nil {
+			uc.logger.Error("failed to load wallet account", logger.Error(err))
+			return nil, fmt.Errorf("failed to load wallet account: %w", err)
+		}
+		if !holdsAsset(&account, asset) {
+			line, err := asset.ToChangeTrustAsset()
+			if err != nil {
+				return nil, fmt.Errorf("failed to build trustline asset: %w", err)
+			}
+			ops = append(ops, &txnbuild.ChangeTrust{
+				Line:  line,
+				Limit: txnbuild.MaxTrustlineLimit,
+			})
+			trustlineCreated = true
+		}
+	}
+	ops = append(ops, &txnbuild.ClaimClaimableBalance{BalanceID: balanceID})
+
+	// 4. Build, sign and submit
+	signed, err := uc.txBuilder.Build(ctx, walletUC.BuildTransactionParams{
+		Wallet:     w,
+		Kind:       submission.KindClaimClaimableBalance,
+		Operations: ops,
+		MaxFee:     input.MaxFee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.txBuilder.Submit(ctx, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("claimable balance claimed",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("balance_id", balanceID),
+		logger.Bool("reclaim", input.Reclaim),
+		logger.Bool("trustline_created", trustlineCreated),
+		logger.String("hash", resp.Hash))
+
+	return &ClaimableBalanceOperationOutput{
+		BalanceID:        balanceID,
+		WalletID:         w.ID.String(),
+		SubmissionID:     resp.SubmissionID.String(),
+		TransactionHash:  resp.Hash,
+		Ledger:           resp.Ledger,
+		FeeCharged:       resp.FeeCharged,
+		TrustlineCreated: trustlineCreated,
+	}, nil
+}
+
+// findClaimant returns the wallet's entry among the balance's claimants
+func findClaimant(b *horizon.ClaimableBalance, publicKey string) *horizon.Claimant {
+	for i := range b.Claimants {
+		if b.Claimants[i].Destination == publicKey {
+			return &b.Claimants[i]
+		}
+	}
+	return nil
+}
+
+// balanceAsset parses Horizon's "CODE:ISSUER" asset string; nil means the native asset
+func balanceAsset(value string) *txnbuild.CreditAsset {
+	code, issuer, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil
+	}
+	return &txnbuild.CreditAsset{Code: code, Issuer: issuer}
+}
+
+// holdsAsset reports whether the account has a trustline for a credit asset
+func holdsAsset(account *horizon.Account, asset *txnbuild.CreditAsset) bool {
+	for _, b := range account.Balances {
+		if b.Asset.Code == asset.Code && b.Asset.Issuer == asset.Issuer {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+package claimable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/claimable"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+)
+
+// ClaimantInput is a destination that may claim the balance and its predicate
+type ClaimantInput struct {
+	Destination string         `json:"destination" validate:"required"`
+	Predicate   PredicateInput `json:"predicate"` // Defaults to unconditional when type is empty
+}
+
+// CreateClaimableBalanceInput represents a request to lock funds in a claimable balance.
+// Setting reclaim_after or reclaim_after_seconds adds the sending wallet as a claimant
+// that can take the funds back once that time has passed.
+type CreateClaimableBalanceInput struct {
+	WalletID            uuid.UUID       `json:"wallet_id"`
+	AssetCode           string          `json:"asset_code,omitempty"` // Omit code and issuer (or use XLM) for the native asset
+	AssetIssuer         string          `json:"asset_issuer,omitempty"`
+	Amount              string          `json:"amount" validate:"required"`
+	Claimants           []ClaimantInput `json:"claimants" validate:"required"`
+	ReclaimAfter        *time.Time      `json:"reclaim_after,omitempty"`
+	ReclaimAfterSeconds int64           `json:"reclaim_after_seconds,omitempty"`
+	MaxFee              int64           `json:"max_fee,omitempty"`
+	UserID              string          `json:"-"` // Authenticated caller, checked against the address book and spending limits
+}
+
+// CreateClaimableBalanceUseCase creates claimable balances from a managed wallet
+type CreateClaimableBalanceUseCase struct {
+	repo      wallet.Repository
+	txBuilder *walletUC.TransactionBuilder
+	guard     *walletUC.OutflowGuard
+	logger    logger.Logger
+}
+
+// NewCreateClaimableBalanceUseCase creates a new create claimable balance use case
+func NewCreateClaimableBalanceUseCase(
+	repo wallet.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	guard *walletUC.OutflowGuard,
+	logger logger.Logger,
+) *CreateClaimableBalanceUseCase {
+	return &CreateClaimableBalanceUseCase{
+		repo:      repo,
+		txBuilder: txBuilder,
+		guard:     guard,
+		logger:    logger,
+	}
+}
+
+// Execute validates the claimants and predicates and submits CreateClaimableBalance.
+// The balance ID is derived from the signed transaction before it is submitted.
+func (uc *CreateClaimableBalanceUseCase) Execute(ctx context.Context, input CreateClaimableBalanceInput) (*ClaimableBalanceOperationOutput, error) {
+	// 1. Validate asset and amount
+	asset, err := parseAsset(input.AssetCode, input.AssetIssuer)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := domainStellar.ParseAmount(input.Amount)
+	if err != nil {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	if input.ReclaimAfter != nil && input.ReclaimAfterSeconds != 0 {
+		return nil, errors.NewValidationError("Invalid reclaim condition", "Set either reclaim_after or reclaim_after_seconds, not both")
+	}
+
+	// 2. Find wallet; its own reclaim claimant counts against the limit
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	claimants := input.Claimants
+	switch {
+	case input.ReclaimAfter != nil:
+		claimants = append(claimants, ClaimantInput{
+			Destination: w.PublicKey,
+			Predicate:   PredicateInput{Type: claimable.PredicateAfterAbsoluteTime, Time: input.ReclaimAfter},
+		})
+	case input.ReclaimAfterSeconds != 0:
+		claimants = append(claimants, ClaimantInput{
+			Destination: w.PublicKey,
+			Predicate:   PredicateInput{Type: claimable.PredicateAfterRelativeTime, Seconds: input.ReclaimAfterSeconds},
+		})
+	}
+
+	destinations, err := buildClaimants(claimants)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Every claimant other than the wallet itself must pass the allow-list, and the
+	// amount counts against the spending limits like a payment
+	for _, c := range claimants {
+		if c.Destination == w.PublicKey {
+			continue
+		}
+		if err := uc.guard.CheckDestination(ctx, w.ID, input.UserID, c.Destination, "", ""); err != nil {
+			return nil, err
+		}
+	}
+
+	spend, err := uc.guard.Reserve(ctx, w, input.UserID, walletUC.AssetKey(asset), amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Build and sign; the balance ID depends on the source account and sequence number
+	signed, err := uc.txBuilder.Build(ctx, walletUC.BuildTransactionParams{
+		Wallet: w,
+		Kind:   submission.KindCreateClaimableBalance,
+		Operations: []txnbuild.Operation{&txnbuild.CreateClaimableBalance{
+			Amount:       amount.StringFixed(domainStellar.AmountDecimals),
+			Asset:        asset,
+			Destinations: destinations,
+		}},
+		MaxFee: input.MaxFee,
+	})
+	if err != nil {
+		uc.guard.Release(ctx, spend)
+		return nil, err
+	}
+
+	if err := uc.guard.Attach(ctx, spend, signed.Hash); err != nil {
+		return nil, err
+	}
+
+	balanceID, err := signed.Tx.ClaimableBalanceID(0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive claimable balance id: %w", err)
+	}
+
+	// 5. Submit
+	resp, err := uc.txBuilder.Submit(ctx, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("claimable balance created",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("balance_id", balanceID),
+		logger.Int("claimants", len(destinations)),
+		logger.String("hash", resp.Hash))
+
+	return &ClaimableBalanceOperationOutput{
+		BalanceID:       balanceID,
+		WalletID:        w.ID.String(),
+		SubmissionID:    resp.SubmissionID.String(),
+		TransactionHash: resp.Hash,
+		Ledger:          resp.Ledger,
+		FeeCharged:      resp.FeeCharged,
+	}, nil
+}
+
+// buildClaimants validates destinations and predicates and converts them for the ledger
+func buildClaimants(claimants []ClaimantInput) ([]txnbuild.Claimant, error) {
+	if len(claimants) == 0 || len(claimants) > claimable.MaxClaimants {
+		return nil, errors.ErrInvalidClaimant
+	}
+
+	seen := make(map[string]bool, len(claimants))
+	destinations := make([]txnbuild.Claimant, 0, len(claimants))
+	for _, c := range claimants {
+		if !strkey.IsValidEd25519PublicKey(c.Destination) || seen[c.Destination] {
+			return nil, errors.ErrInvalidClaimant
+		}
+		seen[c.Destination] = true
+
+		if c.Predicate.Type == "" {
+			c.Predicate.Type = claimable.PredicateUnconditional
+		}
+		predicate := c.Predicate.toDomain()
+		if err := predicate.Validate(); err != nil {
+			return nil, errors.NewValidationError("Invalid claim predicate", err.Error())
+		}
+
+		pred := toXDR(predicate)
+		destinations = append(destinations, txnbuild.NewClaimant(c.Destination, &pred))
+	}
+
+	return destinations, nil
+}
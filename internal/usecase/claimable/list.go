@@ -0,0 +1,108 @@
+package claimable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+// Roles a wallet can have towards a claimable balance
+const (
+	RoleClaimant = "claimant" // Balances the wallet may claim
+	RoleSponsor  = "sponsor"  // Balances the wallet created and may reclaim
+)
+
+// ListClaimableBalancesInput represents a request for a wallet's claimable balances
+type ListClaimableBalancesInput struct {
+	WalletID uuid.UUID
+	Role     string // Defaults to claimant
+	Cursor   string
+	Limit    uint
+}
+
+// ListClaimableBalancesOutput represents a page of claimable balances
+type ListClaimableBalancesOutput struct {
+	WalletID   string                   `json:"wallet_id"`
+	PublicKey  string                   `json:"public_key"`
+	Role       string                   `json:"role"`
+	Balances   []ClaimableBalanceOutput `json:"balances"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
+
+// ListClaimableBalancesUseCase lists claimable balances a wallet can claim or has created
+type ListClaimableBalancesUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	logger        logger.Logger
+}
+
+// NewListClaimableBalancesUseCase creates a new list claimable balances use case
+func NewListClaimableBalancesUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	logger logger.Logger,
+) *ListClaimableBalancesUseCase {
+	return &ListClaimableBalancesUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		logger:        logger,
+	}
+}
+
+// Execute returns a page of claimable balances for the wallet. claimable_now reports
+// whether the wallet's own predicate currently allows it to claim.
+func (uc *ListClaimableBalancesUseCase) Execute(ctx context.Context, input ListClaimableBalancesInput) (*ListClaimableBalancesOutput, error) {
+	role := input.Role
+	if role == "" {
+		role = RoleClaimant
+	}
+	if role != RoleClaimant && role != RoleSponsor {
+		return nil, errors.NewValidationError("Invalid role", "role must be claimant or sponsor")
+	}
+
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	req := horizonclient.ClaimableBalanceRequest{
+		Cursor: input.Cursor,
+		Limit:  input.Limit,
+	}
+	if role == RoleSponsor {
+		req.Sponsor = w.PublicKey
+	} else {
+		req.Claimant = w.PublicKey
+	}
+
+	page, err := uc.horizonClient.ClaimableBalances(req)
+	if err != nil {
+		uc.logger.Error("failed to fetch claimable balances", logger.Error(err), logger.String("wallet_id", w.ID.String()))
+		return nil, fmt.Errorf("failed to fetch claimable balances: %w", err)
+	}
+
+	now := time.Now()
+	output := &ListClaimableBalancesOutput{
+		WalletID:  w.ID.String(),
+		PublicKey: w.PublicKey,
+		Role:      role,
+		Balances:  make([]ClaimableBalanceOutput, 0, len(page.Embedded.Records)),
+	}
+	for _, b := range page.Embedded.Records {
+		output.Balances = append(output.Balances, toClaimableBalanceOutput(b, w.PublicKey, now))
+	}
+
+	records := page.Embedded.Records
+	if len(records) > 0 && uint(len(records)) == input.Limit {
+		output.NextCursor = records[len(records)-1].PT
+	}
+
+	return output, nil
+}
@@ -0,0 +1,95 @@
+package claimable
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+)
+
+// balanceIDLength is the length of a hex claimable balance ID including its 4-byte type prefix
+const balanceIDLength = 72
+
+// ClaimantOutput is an account that may claim a balance and the condition under which it may
+type ClaimantOutput struct {
+	Destination string         `json:"destination"`
+	Predicate   PredicateInput `json:"predicate"`
+}
+
+// ClaimableBalanceOutput represents a claimable balance on the ledger
+type ClaimableBalanceOutput struct {
+	BalanceID          string           `json:"balance_id"`
+	Asset              string           `json:"asset"` // "native" or "CODE:ISSUER"
+	Amount             string           `json:"amount"`
+	Sponsor            string           `json:"sponsor"`
+	Claimants          []ClaimantOutput `json:"claimants"`
+	ClaimableNow       bool             `json:"claimable_now"` // The listing wallet's predicate is satisfied
+	LastModifiedLedger uint32           `json:"last_modified_ledger"`
+}
+
+// ClaimableBalanceOperationOutput is returned when a balance is created, claimed or reclaimed
+type ClaimableBalanceOperationOutput struct {
+	BalanceID        string `json:"balance_id"`
+	WalletID         string `json:"wallet_id"`
+	SubmissionID     string `json:"submission_id"`
+	TransactionHash  string `json:"transaction_hash"`
+	Ledger           int32  `json:"ledger"`
+	FeeCharged       int64  `json:"fee_charged"`
+	TrustlineCreated bool   `json:"trustline_created,omitempty"` // Claimant trustline added in the same transaction
+}
+
+func toClaimableBalanceOutput(b horizon.ClaimableBalance, publicKey string, now time.Time) ClaimableBalanceOutput {
+	output := ClaimableBalanceOutput{
+		BalanceID:          b.BalanceID,
+		Asset:              b.Asset,
+		Amount:             b.Amount,
+		Sponsor:            b.Sponsor,
+		Claimants:          make([]ClaimantOutput, 0, len(b.Claimants)),
+		LastModifiedLedger: b.LastModifiedLedger,
+	}
+
+	for _, c := range b.Claimants {
+		output.Claimants = append(output.Claimants, ClaimantOutput{
+			Destination: c.Destination,
+			Predicate:   fromXDR(c.Predicate),
+		})
+		if c.Destination == publicKey && canClaim(c.Predicate, now) {
+			output.ClaimableNow = true
+		}
+	}
+
+	return output
+}
+
+// parseAsset validates an asset given as code and issuer; an empty code or XLM without issuer is the native asset
+func parseAsset(code, issuer string) (txnbuild.Asset, error) {
+	if issuer == "" && (code == "" || strings.EqualFold(code, "XLM")) {
+		return txnbuild.NativeAsset{}, nil
+	}
+	if !domainStellar.IsValidAssetCode(code) {
+		return nil, errors.ErrInvalidAssetCode
+	}
+	if !strkey.IsValidEd25519PublicKey(issuer) {
+		return nil, errors.ErrInvalidIssuer
+	}
+
+	return txnbuild.CreditAsset{Code: code, Issuer: issuer}, nil
+}
+
+// parseBalanceID validates a claimable balance ID and normalizes it to lower case
+func parseBalanceID(value string) (string, error) {
+	id := strings.ToLower(value)
+	if len(id) != balanceIDLength {
+		return "", errors.ErrInvalidClaimableBalanceID
+	}
+	if _, err := hex.DecodeString(id); err != nil {
+		return "", errors.ErrInvalidClaimableBalanceID
+	}
+	return id, nil
+}
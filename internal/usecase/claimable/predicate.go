@@ -0,0 +1,135 @@
+package claimable
+
+import (
+	"time"
+
+	"quasarflow-api/internal/domain/claimable"
+
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+// PredicateInput is the JSON form of a claim predicate, used in requests and responses
+type PredicateInput struct {
+	Type       string           `json:"type"`
+	Time       *time.Time       `json:"time,omitempty"`    // before_absolute_time, after_absolute_time
+	Seconds    int64            `json:"seconds,omitempty"` // before_relative_time, after_relative_time
+	Predicates []PredicateInput `json:"predicates,omitempty"`
+}
+
+// toDomain converts the request form into a domain predicate
+func (p PredicateInput) toDomain() claimable.Predicate {
+	predicate := claimable.Predicate{
+		Type:    p.Type,
+		Seconds: p.Seconds,
+	}
+	if p.Time != nil {
+		predicate.Time = *p.Time
+	}
+	for _, operand := range p.Predicates {
+		predicate.Predicates = append(predicate.Predicates, operand.toDomain())
+	}
+	return predicate
+}
+
+// toXDR builds the ledger predicate for a validated domain predicate
+func toXDR(p claimable.Predicate) xdr.ClaimPredicate {
+	switch p.Type {
+	case claimable.PredicateAnd:
+		return txnbuild.AndPredicate(toXDR(p.Predicates[0]), toXDR(p.Predicates[1]))
+	case claimable.PredicateOr:
+		return txnbuild.OrPredicate(toXDR(p.Predicates[0]), toXDR(p.Predicates[1]))
+	case claimable.PredicateNot:
+		return txnbuild.NotPredicate(toXDR(p.Predicates[0]))
+	case claimable.PredicateBeforeAbsoluteTime:
+		return txnbuild.BeforeAbsoluteTimePredicate(p.Time.Unix())
+	case claimable.PredicateAfterAbsoluteTime:
+		return txnbuild.NotPredicate(txnbuild.BeforeAbsoluteTimePredicate(p.Time.Unix()))
+	case claimable.PredicateBeforeRelativeTime:
+		return txnbuild.BeforeRelativeTimePredicate(p.Seconds)
+	case claimable.PredicateAfterRelativeTime:
+		return txnbuild.NotPredicate(txnbuild.BeforeRelativeTimePredicate(p.Seconds))
+	default:
+		return txnbuild.UnconditionalPredicate
+	}
+}
+
+// fromXDR renders a ledger predicate; not(before_absolute_time) is shown as after_absolute_time
+func fromXDR(p xdr.ClaimPredicate) PredicateInput {
+	switch p.Type {
+	case xdr.ClaimPredicateTypeClaimPredicateAnd:
+		return PredicateInput{Type: claimable.PredicateAnd, Predicates: fromXDRList(p.AndPredicates)}
+	case xdr.ClaimPredicateTypeClaimPredicateOr:
+		return PredicateInput{Type: claimable.PredicateOr, Predicates: fromXDRList(p.OrPredicates)}
+	case xdr.ClaimPredicateTypeClaimPredicateNot:
+		if p.NotPredicate == nil || *p.NotPredicate == nil {
+			return PredicateInput{Type: claimable.PredicateNot}
+		}
+		inner := fromXDR(**p.NotPredicate)
+		switch inner.Type {
+		case claimable.PredicateBeforeAbsoluteTime:
+			inner.Type = claimable.PredicateAfterAbsoluteTime
+			return inner
+		case claimable.PredicateBeforeRelativeTime:
+			inner.Type = claimable.PredicateAfterRelativeTime
+			return inner
+		}
+		return PredicateInput{Type: claimable.PredicateNot, Predicates: []PredicateInput{inner}}
+	case xdr.ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime:
+		t := time.Unix(int64(*p.AbsBefore), 0).UTC()
+		return PredicateInput{Type: claimable.PredicateBeforeAbsoluteTime, Time: &t}
+	case xdr.ClaimPredicateTypeClaimPredicateBeforeRelativeTime:
+		return PredicateInput{Type: claimable.PredicateBeforeRelativeTime, Seconds: int64(*p.RelBefore)}
+	default:
+		return PredicateInput{Type: claimable.PredicateUnconditional}
+	}
+}
+
+func fromXDRList(list *[]xdr.ClaimPredicate) []PredicateInput {
+	if list == nil {
+		return nil
+	}
+	out := make([]PredicateInput, 0, len(*list))
+	for _, p := range *list {
+		out = append(out, fromXDR(p))
+	}
+	return out
+}
+
+// canClaim evaluates a predicate as reported by Horizon, which converts relative times to
+// absolute ones when the balance is created. Unknown forms are left for the ledger to judge.
+func canClaim(p xdr.ClaimPredicate, now time.Time) bool {
+	switch p.Type {
+	case xdr.ClaimPredicateTypeClaimPredicateUnconditional:
+		return true
+	case xdr.ClaimPredicateTypeClaimPredicateAnd:
+		if p.AndPredicates == nil {
+			return false
+		}
+		for _, operand := range *p.AndPredicates {
+			if !canClaim(operand, now) {
+				return false
+			}
+		}
+		return true
+	case xdr.ClaimPredicateTypeClaimPredicateOr:
+		if p.OrPredicates == nil {
+			return false
+		}
+		for _, operand := range *p.OrPredicates {
+			if canClaim(operand, now) {
+				return true
+			}
+		}
+		return false
+	case xdr.ClaimPredicateTypeClaimPredicateNot:
+		if p.NotPredicate == nil || *p.NotPredicate == nil {
+			return false
+		}
+		return !canClaim(**p.NotPredicate, now)
+	case xdr.ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime:
+		return p.AbsBefore != nil && now.Unix() < int64(*p.AbsBefore)
+	default:
+		return true
+	}
+}
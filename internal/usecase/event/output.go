@@ -0,0 +1,174 @@
+package event
+
+import (
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+)
+
+// EventOutput is the JSON representation of a domain event, as delivered to consumers
+type EventOutput struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	WalletID   string      `json:"wallet_id"`
+	PublicKey  string      `json:"public_key,omitempty"`
+	OccurredAt string      `json:"occurred_at"`
+	CreatedAt  string      `json:"created_at"`
+	Data       interface{} `json:"data"`
+}
+
+// WalletData describes a wallet.created event
+type WalletData struct {
+	Network string `json:"network"`
+}
+
+// PaymentData describes a payment.received or payment.sent event
+type PaymentData struct {
+	OperationID       string `json:"operation_id"`
+	OperationType     string `json:"operation_type"`
+	TransactionHash   string `json:"transaction_hash"`
+	Ledger            int32  `json:"ledger"`
+	PagingToken       string `json:"paging_token"`
+	From              string `json:"from"`
+	To                string `json:"to"`
+	Amount            string `json:"amount"`
+	AssetType         string `json:"asset_type"`
+	AssetCode         string `json:"asset_code,omitempty"`
+	AssetIssuer       string `json:"asset_issuer,omitempty"`
+	SourceAmount      string `json:"source_amount,omitempty"`
+	SourceAssetType   string `json:"source_asset_type,omitempty"`
+	SourceAssetCode   string `json:"source_asset_code,omitempty"`
+	SourceAssetIssuer string `json:"source_asset_issuer,omitempty"`
+	MemoType          string `json:"memo_type,omitempty"`
+	Memo              string `json:"memo,omitempty"`
+}
+
+// TransactionData describes a transaction.failed event
+type TransactionData struct {
+	SubmissionID    string `json:"submission_id"`
+	Kind            string `json:"kind"`
+	TransactionHash string `json:"transaction_hash"`
+	Status          string `json:"status"`
+	ResultCode      string `json:"result_code,omitempty"`
+	ErrorMessage    string `json:"error_message,omitempty"`
+}
+
+// TrustlineData describes a trustline.changed event
+type TrustlineData struct {
+	Action          string `json:"action"`
+	AssetCode       string `json:"asset_code"`
+	AssetIssuer     string `json:"asset_issuer"`
+	Limit           string `json:"limit"`
+	TransactionHash string `json:"transaction_hash"`
+	Ledger          int32  `json:"ledger"`
+}
+
+// ReconciliationData describes a reconciliation.discrepancy event
+type ReconciliationData struct {
+	ReportID   string `json:"report_id"`
+	Missing    int    `json:"missing"`
+	Unexpected int    `json:"unexpected"`
+	Mismatched int    `json:"mismatched"`
+}
+
+// ApprovalData describes an approval.requested or approval.completed event
+type ApprovalData struct {
+	RequestID       string `json:"request_id"`
+	Status          string `json:"status"`
+	Asset           string `json:"asset"`
+	Amount          string `json:"amount"`
+	Destination     string `json:"destination"`
+	RequestedBy     string `json:"requested_by"`
+	Approvals       int    `json:"approvals"`
+	Quorum          int    `json:"quorum"`
+	ExpiresAt       string `json:"expires_at"`
+	TransactionHash string `json:"transaction_hash,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// ToEventOutput converts a domain event to its JSON representation
+func ToEventOutput(e *event.Event) EventOutput {
+	output := EventOutput{
+		ID:         e.ID.String(),
+		Type:       string(e.Type),
+		WalletID:   e.WalletID.String(),
+		PublicKey:  e.PublicKey,
+		OccurredAt: e.OccurredAt.UTC().Format(time.RFC3339),
+		CreatedAt:  e.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	switch {
+	case e.Wallet != nil:
+		output.Data = WalletData{Network: e.Wallet.Network}
+	case e.Payment != nil:
+		output.Data = toPaymentData(e.Payment)
+	case e.Transaction != nil:
+		output.Data = TransactionData{
+			SubmissionID:    e.Transaction.SubmissionID.String(),
+			Kind:            e.Transaction.Kind,
+			TransactionHash: e.Transaction.Hash,
+			Status:          e.Transaction.Status,
+			ResultCode:      e.Transaction.ResultCode,
+			ErrorMessage:    e.Transaction.ErrorMessage,
+		}
+	case e.Trustline != nil:
+		output.Data = TrustlineData{
+			Action:          e.Trustline.Action,
+			AssetCode:       e.Trustline.AssetCode,
+			AssetIssuer:     e.Trustline.AssetIssuer,
+			Limit:           e.Trustline.Limit,
+			TransactionHash: e.Trustline.TransactionHash,
+			Ledger:          e.Trustline.Ledger,
+		}
+	case e.Reconciliation != nil:
+		output.Data = ReconciliationData{
+			ReportID:   e.Reconciliation.ReportID.String(),
+			Missing:    e.Reconciliation.Missing,
+			Unexpected: e.Reconciliation.Unexpected,
+			Mismatched: e.Reconciliation.Mismatched,
+		}
+	case e.Approval != nil:
+		output.Data = ApprovalData{
+			RequestID:       e.Approval.RequestID.String(),
+			Status:          e.Approval.Status,
+			Asset:           e.Approval.Asset,
+			Amount:          e.Approval.Amount.StringFixed(7),
+			Destination:     e.Approval.Destination,
+			RequestedBy:     e.Approval.RequestedBy,
+			Approvals:       e.Approval.Approvals,
+			Quorum:          e.Approval.Quorum,
+			ExpiresAt:       e.Approval.ExpiresAt.Format(time.RFC3339),
+			TransactionHash: e.Approval.TransactionHash,
+			Error:           e.Approval.Error,
+		}
+	default:
+		output.Data = struct{}{}
+	}
+
+	return output
+}
+
+func toPaymentData(p *event.Payment) PaymentData {
+	data := PaymentData{
+		OperationID:       p.OperationID,
+		OperationType:     p.OperationType,
+		TransactionHash:   p.TransactionHash,
+		Ledger:            p.Ledger,
+		PagingToken:       p.PagingToken,
+		From:              p.From,
+		To:                p.To,
+		Amount:            p.Amount.StringFixed(7),
+		AssetType:         p.AssetType,
+		AssetCode:         p.AssetCode,
+		AssetIssuer:       p.AssetIssuer,
+		SourceAssetType:   p.SourceAssetType,
+		SourceAssetCode:   p.SourceAssetCode,
+		SourceAssetIssuer: p.SourceAssetIssuer,
+		MemoType:          p.MemoType,
+		Memo:              p.Memo,
+	}
+	if p.SourceAmount != nil {
+		data.SourceAmount = p.SourceAmount.StringFixed(7)
+	}
+	return data
+}
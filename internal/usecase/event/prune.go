@@ -0,0 +1,41 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/pkg/logger"
+)
+
+// PruneUseCase removes stored events past the retention period. Clients resuming
+// from a pruned event receive no replay, only the current balance snapshot.
+type PruneUseCase struct {
+	repo      event.Repository
+	retention time.Duration
+	logger    logger.Logger
+}
+
+// NewPruneUseCase creates a new prune use case
+func NewPruneUseCase(repo event.Repository, retention time.Duration, logger logger.Logger) *PruneUseCase {
+	return &PruneUseCase{
+		repo:      repo,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Execute deletes events older than the retention period
+func (uc *PruneUseCase) Execute(ctx context.Context) (int64, error) {
+	deleted, err := uc.repo.DeleteBefore(ctx, time.Now().Add(-uc.retention))
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune events: %w", err)
+	}
+
+	if deleted > 0 {
+		uc.logger.Info("pruned stored events", logger.Int64("deleted", deleted))
+	}
+
+	return deleted, nil
+}
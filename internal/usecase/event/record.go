@@ -0,0 +1,40 @@
+package event
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/pkg/logger"
+)
+
+// RecordUseCase stores every published event and pushes new ones to live stream
+// listeners. It is subscribed to the event bus; an event published again after a
+// producer retry is stored once and not pushed twice.
+type RecordUseCase struct {
+	repo   event.Repository
+	feed   event.Feed
+	logger logger.Logger
+}
+
+// NewRecordUseCase creates a new record use case
+func NewRecordUseCase(repo event.Repository, feed event.Feed, logger logger.Logger) *RecordUseCase {
+	return &RecordUseCase{
+		repo:   repo,
+		feed:   feed,
+		logger: logger,
+	}
+}
+
+// Execute stores the event and broadcasts it if it was new
+func (uc *RecordUseCase) Execute(ctx context.Context, e *event.Event) error {
+	saved, err := uc.repo.Save(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	if saved {
+		uc.feed.Broadcast(e)
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package event
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// Stream message types besides the event types
+const (
+	MessageBalance   = "balance"
+	MessageHeartbeat = "heartbeat"
+	MessageReset     = "reset"
+)
+
+// replayPageSize is how many stored events are read per query when a client resumes
+const replayPageSize = 100
+
+// ErrStreamLagging ends a stream whose client could not keep up with live events;
+// the client reconnects with Last-Event-ID and replays what it missed
+var ErrStreamLagging = stdErrors.New("stream listener fell behind")
+
+// StreamMessage is one frame of a wallet activity stream
+type StreamMessage struct {
+	ID   string      `json:"id,omitempty"` // Event ID, the resume point for Last-Event-ID; empty for balances and heartbeats
+	Type string      `json:"type"`         // Event type, balance, heartbeat or reset
+	Data interface{} `json:"data,omitempty"`
+}
+
+// ResetOutput tells a resuming client that the events after its resume point cannot be
+// replayed, so it should reload the wallet's state instead of relying on the replay
+type ResetOutput struct {
+	LastEventID string `json:"last_event_id"` // The resume point that was not found
+	Reason      string `json:"reason"`
+}
+
+// StreamConfig controls wallet activity streams
+type StreamConfig struct {
+	HeartbeatInterval time.Duration // Must stay below the server's write timeout
+}
+
+// OpenStreamInput represents a request to stream a wallet's activity
+type OpenStreamInput struct {
+	WalletID    uuid.UUID
+	LastEventID string // Resume after this event; empty streams live events only
+	Currency    string // Valuation currency of balance snapshots, as for the balance endpoint
+}
+
+// StreamUseCase streams a wallet's events and balance changes to a client
+type StreamUseCase struct {
+	wallets  wallet.Repository
+	events   event.Repository
+	feed     event.Feed
+	balances *walletUC.GetBalanceUseCase
+	config   StreamConfig
+	logger   logger.Logger
+}
+
+// NewStreamUseCase creates a new stream use case
+func NewStreamUseCase(
+	wallets wallet.Repository,
+	events event.Repository,
+	feed event.Feed,
+	balances *walletUC.GetBalanceUseCase,
+	config StreamConfig,
+	logger logger.Logger,
+) *StreamUseCase {
+	return &StreamUseCase{
+		wallets:  wallets,
+		events:   events,
+		feed:     feed,
+		balances: balances,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Open validates the request and starts listening for live events, so errors can
+// still be reported before the stream response is written. The returned stream
+// must be closed by calling Run or Close.
+func (uc *StreamUseCase) Open(ctx context.Context, input OpenStreamInput) (*Stream, error) {
+	var after uuid.UUID
+	if input.LastEventID != "" {
+		id, err := uuid.Parse(input.LastEventID)
+		if err != nil {
+			return nil, errors.ErrInvalidLastEventID
+		}
+		after = id
+	}
+
+	if _, err := uc.wallets.FindByID(ctx, input.WalletID); err != nil {
+		return nil, errors.NewNotFoundError("Wallet not found")
+	}
+
+	// Listen before replaying so no event falls between the replay and live events
+	live, stop := uc.feed.Listen(input.WalletID)
+
+	return &Stream{
+		uc:    uc,
+		input: input,
+		after: after,
+		live:  live,
+		stop:  stop,
+	}, nil
+}
+
+// Stream is an open wallet activity stream
+type Stream struct {
+	uc    *StreamUseCase
+	input OpenStreamInput
+	after uuid.UUID
+	live  <-chan *event.Event
+	stop  func()
+
+	balances []walletUC.BalanceOutput // Last balances sent
+}
+
+// Close stops listening for live events
+func (s *Stream) Close() {
+	s.stop()
+}
+
+// Run sends the events stored after the resume point, the current balances, then live
+// events, a balance snapshot whenever they change and heartbeats, until ctx is done or
+// send fails. Run closes the stream.
+func (s *Stream) Run(ctx context.Context, send func(StreamMessage) error) error {
+	defer s.Close()
+
+	replayed, err := s.replay(ctx, send)
+	if err != nil {
+		return err
+	}
+
+	if err := s.sendBalances(ctx, send); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(s.uc.config.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if err := send(StreamMessage{Type: MessageHeartbeat}); err != nil {
+				return err
+			}
+		case e, ok := <-s.live:
+			if !ok {
+				return ErrStreamLagging
+			}
+
+			// Send every queued event before refreshing balances once
+			changed := false
+			for e != nil {
+				if !replayed[e.ID] {
+					if err := send(toStreamMessage(e)); err != nil {
+						return err
+					}
+					changed = changed || e.Type != event.TypeWalletCreated
+				}
+
+				select {
+				case e, ok = <-s.live:
+					if !ok {
+						return ErrStreamLagging
+					}
+				default:
+					e = nil
+				}
+			}
+
+			if changed {
+				if err := s.sendBalances(ctx, send); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// replay sends the stored events after the resume point and returns their IDs, so
+// the same events arriving live are not sent twice. A resume point that is not a
+// retained event of the wallet is answered with a reset message.
+func (s *Stream) replay(ctx context.Context, send func(StreamMessage) error) (map[uuid.UUID]bool, error) {
+	replayed := make(map[uuid.UUID]bool)
+	if s.after == uuid.Nil {
+		return replayed, nil
+	}
+
+	after := s.after
+	for {
+		page, err := s.uc.events.ListAfter(ctx, s.input.WalletID, after, replayPageSize)
+		if stdErrors.Is(err, event.ErrEventNotFound) {
+			s.uc.logger.Info("stream resume point not found",
+				logger.String("wallet_id", s.input.WalletID.String()),
+				logger.String("last_event_id", after.String()))
+			return replayed, send(StreamMessage{
+				Type: MessageReset,
+				Data: ResetOutput{
+					LastEventID: after.String(),
+					Reason:      "Events after last_event_id are unknown or no longer retained",
+				},
+			})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay events: %w", err)
+		}
+
+		for _, e := range page {
+			if err := send(toStreamMessage(e)); err != nil {
+				return nil, err
+			}
+			replayed[e.ID] = true
+		}
+
+		if len(page) < replayPageSize {
+			return replayed, nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
+
+// sendBalances sends the wallet's balances if they differ from the last ones sent.
+// A balance lookup failure is logged and does not end the stream.
+func (s *Stream) sendBalances(ctx context.Context, send func(StreamMessage) error) error {
+	output, err := s.uc.balances.Execute(ctx, walletUC.GetBalanceInput{
+		WalletID: s.input.WalletID,
+		Currency: s.input.Currency,
+	})
+	if err != nil {
+		s.uc.logger.Warn("failed to load balances for stream",
+			logger.String("wallet_id", s.input.WalletID.String()),
+			logger.Error(err))
+		return nil
+	}
+
+	if s.balances != nil && reflect.DeepEqual(s.balances, output.Balances) {
+		return nil
+	}
+	s.balances = output.Balances
+
+	return send(StreamMessage{Type: MessageBalance, Data: output})
+}
+
+func toStreamMessage(e *event.Event) StreamMessage {
+	return StreamMessage{
+		ID:   e.ID.String(),
+		Type: string(e.Type),
+		Data: ToEventOutput(e),
+	}
+}
@@ -0,0 +1,61 @@
+package federation
+
+import (
+	"context"
+	"strings"
+
+	"quasarflow-api/internal/domain/federation"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+)
+
+// AddressResolver resolves federation addresses. Names on our own federation
+// domain are answered from the database; all others go to the remote resolver.
+type AddressResolver struct {
+	names   federation.Repository
+	wallets wallet.Repository
+	remote  federation.Resolver
+	domain  string
+}
+
+// NewAddressResolver creates a resolver for payments and the resolve endpoint
+func NewAddressResolver(
+	names federation.Repository,
+	wallets wallet.Repository,
+	remote federation.Resolver,
+	domain string,
+) *AddressResolver {
+	return &AddressResolver{
+		names:   names,
+		wallets: wallets,
+		remote:  remote,
+		domain:  strings.ToLower(domain),
+	}
+}
+
+// Resolve implements federation.Resolver
+func (r *AddressResolver) Resolve(ctx context.Context, address string) (*federation.Record, error) {
+	name, domain, err := federation.SplitAddress(address)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid federation address", err.Error())
+	}
+
+	if r.domain == "" || domain != r.domain {
+		return r.remote.Resolve(ctx, address)
+	}
+
+	n, err := r.names.FindByName(ctx, strings.ToLower(name))
+	if err != nil {
+		return nil, errors.NewNotFoundError("Federation address not found: " + address)
+	}
+
+	w, err := r.wallets.FindByID(ctx, n.WalletID)
+	if err != nil || w.IsClosed() {
+		return nil, errors.NewNotFoundError("Federation address not found: " + address)
+	}
+
+	return &federation.Record{
+		StellarAddress: n.Name + "*" + r.domain,
+		AccountID:      w.PublicKey,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package federation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"quasarflow-api/internal/domain/federation"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// AssignNameInput represents a request to give a managed wallet a federation name
+type AssignNameInput struct {
+	WalletID uuid.UUID `json:"wallet_id"`
+	Name     string    `json:"name" validate:"required"`
+}
+
+// AssignNameOutput represents the assigned federation address
+type AssignNameOutput struct {
+	WalletID       string `json:"wallet_id"`
+	StellarAddress string `json:"stellar_address"`
+	AccountID      string `json:"account_id"`
+}
+
+// AssignNameUseCase assigns a federation name on our domain to a managed wallet
+type AssignNameUseCase struct {
+	names   federation.Repository
+	wallets wallet.Repository
+	domain  string
+	logger  logger.Logger
+}
+
+// NewAssignNameUseCase creates a new assign name use case
+func NewAssignNameUseCase(names federation.Repository, wallets wallet.Repository, domain string, logger logger.Logger) *AssignNameUseCase {
+	return &AssignNameUseCase{
+		names:   names,
+		wallets: wallets,
+		domain:  strings.ToLower(domain),
+		logger:  logger,
+	}
+}
+
+// Execute assigns the name; each wallet has at most one name and names are unique
+func (uc *AssignNameUseCase) Execute(ctx context.Context, input AssignNameInput) (*AssignNameOutput, error) {
+	if uc.domain == "" {
+		return nil, errors.NewValidationError("Federation is not enabled", "Set FEDERATION_DOMAIN to assign federation names")
+	}
+
+	w, err := uc.wallets.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Wallet not found")
+	}
+	if w.IsClosed() {
+		return nil, errors.ErrWalletClosed
+	}
+
+	n, err := federation.NewName(input.Name, w.ID)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid federation name", err.Error())
+	}
+
+	if existing, err := uc.names.FindByWalletID(ctx, w.ID); err == nil {
+		return nil, errors.NewConflictError("Wallet already has a federation name", existing.Name+"*"+uc.domain)
+	}
+
+	if _, err := uc.names.FindByName(ctx, n.Name); err == nil {
+		return nil, errors.NewConflictError("Federation name already taken", n.Name+"*"+uc.domain)
+	}
+
+	if err := uc.names.Create(ctx, n); err != nil {
+		uc.logger.Error("failed to save federation name", logger.Error(err))
+		return nil, fmt.Errorf("failed to save federation name: %w", err)
+	}
+
+	uc.logger.Info("federation name assigned",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("name", n.Name))
+
+	return &AssignNameOutput{
+		WalletID:       w.ID.String(),
+		StellarAddress: n.Name + "*" + uc.domain,
+		AccountID:      w.PublicKey,
+	}, nil
+}
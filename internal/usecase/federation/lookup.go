@@ -0,0 +1,99 @@
+package federation
+
+import (
+	"context"
+	"strings"
+
+	"quasarflow-api/internal/domain/federation"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+)
+
+// Federation server lookup types (SEP-2)
+const (
+	LookupTypeName = "name"
+	LookupTypeID   = "id"
+)
+
+// LookupOutput is the SEP-2 federation server response
+type LookupOutput struct {
+	StellarAddress string `json:"stellar_address"`
+	AccountID      string `json:"account_id"`
+	MemoType       string `json:"memo_type,omitempty"`
+	Memo           string `json:"memo,omitempty"`
+}
+
+// LookupUseCase answers federation server queries for managed wallets on our domain
+type LookupUseCase struct {
+	names   federation.Repository
+	wallets wallet.Repository
+	domain  string
+}
+
+// NewLookupUseCase creates a new federation lookup use case
+func NewLookupUseCase(names federation.Repository, wallets wallet.Repository, domain string) *LookupUseCase {
+	return &LookupUseCase{
+		names:   names,
+		wallets: wallets,
+		domain:  strings.ToLower(domain),
+	}
+}
+
+// Execute handles a federation query of type "name" (name*domain) or "id" (account ID)
+func (uc *LookupUseCase) Execute(ctx context.Context, q, lookupType string) (*LookupOutput, error) {
+	if uc.domain == "" {
+		return nil, errors.NewNotFoundError("Federation is not enabled")
+	}
+
+	switch lookupType {
+	case LookupTypeName:
+		return uc.byName(ctx, q)
+	case LookupTypeID:
+		return uc.byAccountID(ctx, q)
+	default:
+		return nil, errors.NewValidationError("Unsupported federation query type", "type must be name or id")
+	}
+}
+
+func (uc *LookupUseCase) byName(ctx context.Context, address string) (*LookupOutput, error) {
+	name, domain, err := federation.SplitAddress(address)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid federation address", err.Error())
+	}
+	if domain != uc.domain {
+		return nil, errors.NewNotFoundError("Unknown federation domain")
+	}
+
+	n, err := uc.names.FindByName(ctx, strings.ToLower(name))
+	if err != nil {
+		return nil, errors.NewNotFoundError("Federation address not found")
+	}
+
+	// A closed wallet's account was merged away; it must not be offered as a destination
+	w, err := uc.wallets.FindByID(ctx, n.WalletID)
+	if err != nil || w.IsClosed() {
+		return nil, errors.NewNotFoundError("Federation address not found")
+	}
+
+	return &LookupOutput{
+		StellarAddress: n.Name + "*" + uc.domain,
+		AccountID:      w.PublicKey,
+	}, nil
+}
+
+func (uc *LookupUseCase) byAccountID(ctx context.Context, accountID string) (*LookupOutput, error) {
+	w, err := uc.wallets.FindByPublicKey(ctx, accountID)
+	if err != nil || w.IsClosed() {
+		return nil, errors.NewNotFoundError("Account not found")
+	}
+
+	n, err := uc.names.FindByWalletID(ctx, w.ID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Account has no federation address")
+	}
+
+	return &LookupOutput{
+		StellarAddress: n.Name + "*" + uc.domain,
+		AccountID:      w.PublicKey,
+	}, nil
+}
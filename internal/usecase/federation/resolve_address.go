@@ -0,0 +1,46 @@
+package federation
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/federation"
+	"quasarflow-api/pkg/logger"
+)
+
+// ResolveAddressOutput represents a resolved federation address
+type ResolveAddressOutput struct {
+	StellarAddress string `json:"stellar_address"`
+	AccountID      string `json:"account_id"`
+	MemoType       string `json:"memo_type,omitempty"`
+	Memo           string `json:"memo,omitempty"`
+}
+
+// ResolveAddressUseCase resolves name*domain addresses to account IDs
+type ResolveAddressUseCase struct {
+	resolver federation.Resolver
+	logger   logger.Logger
+}
+
+// NewResolveAddressUseCase creates a new resolve address use case
+func NewResolveAddressUseCase(resolver federation.Resolver, logger logger.Logger) *ResolveAddressUseCase {
+	return &ResolveAddressUseCase{
+		resolver: resolver,
+		logger:   logger,
+	}
+}
+
+// Execute resolves a federation address
+func (uc *ResolveAddressUseCase) Execute(ctx context.Context, address string) (*ResolveAddressOutput, error) {
+	record, err := uc.resolver.Resolve(ctx, address)
+	if err != nil {
+		uc.logger.Warn("failed to resolve federation address", logger.Error(err), logger.String("address", address))
+		return nil, err
+	}
+
+	return &ResolveAddressOutput{
+		StellarAddress: record.StellarAddress,
+		AccountID:      record.AccountID,
+		MemoType:       record.MemoType,
+		Memo:           record.Memo,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package fee
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/pkg/logger"
+)
+
+// FeeDistributionOutput represents recent fee percentiles in stroops
+type FeeDistributionOutput struct {
+	Min  int64 `json:"min"`
+	Mode int64 `json:"mode"`
+	P10  int64 `json:"p10"`
+	P20  int64 `json:"p20"`
+	P30  int64 `json:"p30"`
+	P40  int64 `json:"p40"`
+	P50  int64 `json:"p50"`
+	P60  int64 `json:"p60"`
+	P70  int64 `json:"p70"`
+	P80  int64 `json:"p80"`
+	P90  int64 `json:"p90"`
+	P95  int64 `json:"p95"`
+	P99  int64 `json:"p99"`
+	Max  int64 `json:"max"`
+}
+
+// GetFeeEstimateOutput represents the current base fee estimate
+type GetFeeEstimateOutput struct {
+	BaseFee             int64                 `json:"base_fee"`
+	Percentile          int                   `json:"percentile"`
+	MaxFee              int64                 `json:"max_fee"`
+	Capped              bool                  `json:"capped"`
+	LastLedger          uint32                `json:"last_ledger"`
+	LastLedgerBaseFee   int64                 `json:"last_ledger_base_fee"`
+	LedgerCapacityUsage float64               `json:"ledger_capacity_usage"`
+	FeeCharged          FeeDistributionOutput `json:"fee_charged"`
+	FetchedAt           string                `json:"fetched_at"`
+}
+
+// GetFeeEstimateUseCase exposes the fee estimate used when building transactions
+type GetFeeEstimateUseCase struct {
+	feeEstimator domainStellar.FeeEstimator
+	logger       logger.Logger
+}
+
+// NewGetFeeEstimateUseCase creates a new get fee estimate use case
+func NewGetFeeEstimateUseCase(feeEstimator domainStellar.FeeEstimator, logger logger.Logger) *GetFeeEstimateUseCase {
+	return &GetFeeEstimateUseCase{
+		feeEstimator: feeEstimator,
+		logger:       logger,
+	}
+}
+
+// Execute returns the base fee that would be used for a transaction capped at maxFee
+func (uc *GetFeeEstimateUseCase) Execute(ctx context.Context, maxFee int64) (*GetFeeEstimateOutput, error) {
+	estimate, err := uc.feeEstimator.EstimateFee(ctx, maxFee)
+	if err != nil {
+		uc.logger.Error("failed to estimate fee", logger.Error(err))
+		return nil, fmt.Errorf("failed to estimate fee: %w", err)
+	}
+
+	charged := estimate.Stats.FeeCharged
+	return &GetFeeEstimateOutput{
+		BaseFee:             estimate.BaseFee,
+		Percentile:          estimate.Percentile,
+		MaxFee:              estimate.MaxFee,
+		Capped:              estimate.Capped,
+		LastLedger:          estimate.Stats.LastLedger,
+		LastLedgerBaseFee:   estimate.Stats.LastLedgerBaseFee,
+		LedgerCapacityUsage: estimate.Stats.LedgerCapacityUsage,
+		FeeCharged: FeeDistributionOutput{
+			Min:  charged.Min,
+			Mode: charged.Mode,
+			P10:  charged.P10,
+			P20:  charged.P20,
+			P30:  charged.P30,
+			P40:  charged.P40,
+			P50:  charged.P50,
+			P60:  charged.P60,
+			P70:  charged.P70,
+			P80:  charged.P80,
+			P90:  charged.P90,
+			P95:  charged.P95,
+			P99:  charged.P99,
+			Max:  charged.Max,
+		},
+		FetchedAt: estimate.Stats.FetchedAt.Format(time.RFC3339),
+	}, nil
+}
@@ -0,0 +1,190 @@
+package liquiditypool
+
+import (
+	"context"
+	"fmt"
+
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+// DepositInput represents a request to add liquidity to the constant product pool of two assets.
+// Prices are amounts of asset A per unit of asset B; the deposit fails on the ledger if the
+// pool price is outside the bounds when the transaction is applied.
+type DepositInput struct {
+	WalletID     uuid.UUID `json:"wallet_id"`
+	AssetACode   string    `json:"asset_a_code,omitempty"` // Omit code and issuer (or use XLM) for the native asset
+	AssetAIssuer string    `json:"asset_a_issuer,omitempty"`
+	AssetBCode   string    `json:"asset_b_code,omitempty"`
+	AssetBIssuer string    `json:"asset_b_issuer,omitempty"`
+	MaxAmountA   string    `json:"max_amount_a" validate:"required"`
+	MaxAmountB   string    `json:"max_amount_b" validate:"required"`
+	MinPrice     string    `json:"min_price" validate:"required"`
+	MaxPrice     string    `json:"max_price" validate:"required"`
+	MaxFee       int64     `json:"max_fee,omitempty"`
+}
+
+// DepositUseCase deposits into a liquidity pool from a managed wallet
+type DepositUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	txBuilder     *walletUC.TransactionBuilder
+	logger        logger.Logger
+}
+
+// NewDepositUseCase creates a new liquidity pool deposit use case
+func NewDepositUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *DepositUseCase {
+	return &DepositUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		txBuilder:     txBuilder,
+		logger:        logger,
+	}
+}
+
+// Execute submits LiquidityPoolDeposit, preceded in the same transaction by a ChangeTrust
+// for the pool shares when the wallet does not hold them yet. The pool is created by
+// that trustline if it does not exist.
+func (uc *DepositUseCase) Execute(ctx context.Context, input DepositInput) (*PoolOperationOutput, error) {
+	// 1. Validate assets, amounts and price bounds
+	assetA, err := parseAsset(input.AssetACode, input.AssetAIssuer)
+	if err != nil {
+		return nil, err
+	}
+	assetB, err := parseAsset(input.AssetBCode, input.AssetBIssuer)
+	if err != nil {
+		return nil, err
+	}
+	if assetA.GetCode() == assetB.GetCode() && assetA.GetIssuer() == assetB.GetIssuer() {
+		return nil, errors.ErrLiquidityPoolSameAsset
+	}
+
+	maxA, err := domainStellar.ParseAmount(input.MaxAmountA)
+	if err != nil {
+		return nil, errors.ErrInvalidAmount
+	}
+	maxB, err := domainStellar.ParseAmount(input.MaxAmountB)
+	if err != nil {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	minDec, minPrice, err := parsePriceBound(input.MinPrice)
+	if err != nil {
+		return nil, err
+	}
+	maxDec, maxPrice, err := parsePriceBound(input.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+	if minDec.GreaterThan(maxDec) {
+		return nil, errors.ErrInvalidPriceBounds
+	}
+
+	amountA := maxA.StringFixed(domainStellar.AmountDecimals)
+	amountB := maxB.StringFixed(domainStellar.AmountDecimals)
+
+	// 2. The ledger orders pool assets; swap sides and invert prices if needed
+	ordered, err := lessThan(assetA, assetB)
+	if err != nil {
+		return nil, err
+	}
+	if !ordered {
+		assetA, assetB = assetB, assetA
+		amountA, amountB = amountB, amountA
+		minPrice, maxPrice = invertPrice(maxPrice), invertPrice(minPrice)
+	}
+
+	params := txnbuild.LiquidityPoolParameters{
+		AssetA: assetA,
+		AssetB: assetB,
+		Fee:    txnbuild.LiquidityPoolFeeV18,
+	}
+	poolID, err := txnbuild.NewLiquidityPoolId(assetA, assetB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive liquidity pool id: %w", err)
+	}
+
+	// 3. Find wallet; it must hold both assets
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	account, err := uc.horizonClient.AccountDetail(horizonclient.AccountRequest{AccountID: w.PublicKey})
+	if err != nil {
+		uc.logger.Error("failed to load wallet account", logger.Error(err))
+		return nil, fmt.Errorf("failed to load wallet account: %w", err)
+	}
+
+	if !holdsAsset(&account, assetA) || !holdsAsset(&account, assetB) {
+		return nil, errors.ErrTrustlineNotFound
+	}
+
+	// 4. Establish the pool share trustline in the same transaction when missing
+	var ops []txnbuild.Operation
+	trustlineCreated := findPoolShares(&account, poolIDString(poolID)) == nil
+	if trustlineCreated {
+		ops = append(ops, &txnbuild.ChangeTrust{
+			Line:  txnbuild.LiquidityPoolShareChangeTrustAsset{LiquidityPoolParameters: params},
+			Limit: txnbuild.MaxTrustlineLimit,
+		})
+	}
+	ops = append(ops, &txnbuild.LiquidityPoolDeposit{
+		LiquidityPoolID: poolID,
+		MaxAmountA:      amountA,
+		MaxAmountB:      amountB,
+		MinPrice:        minPrice,
+		MaxPrice:        maxPrice,
+	})
+
+	// 5. Build, sign and submit
+	signed, err := uc.txBuilder.Build(ctx, walletUC.BuildTransactionParams{
+		Wallet:     w,
+		Kind:       submission.KindLiquidityPoolDeposit,
+		Operations: ops,
+		MaxFee:     input.MaxFee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.txBuilder.Submit(ctx, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("liquidity deposited",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("pool_id", poolIDString(poolID)),
+		logger.Bool("trustline_created", trustlineCreated),
+		logger.String("hash", resp.Hash))
+
+	return &PoolOperationOutput{
+		WalletID:         w.ID.String(),
+		PoolID:           poolIDString(poolID),
+		SubmissionID:     resp.SubmissionID.String(),
+		TransactionHash:  resp.Hash,
+		Ledger:           resp.Ledger,
+		FeeCharged:       resp.FeeCharged,
+		TrustlineCreated: trustlineCreated,
+	}, nil
+}
+
+// invertPrice returns 1/p, used when the caller's asset order is the reverse of the pool's
+func invertPrice(p xdr.Price) xdr.Price {
+	return xdr.Price{N: p.D, D: p.N}
+}
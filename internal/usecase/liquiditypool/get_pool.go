@@ -0,0 +1,33 @@
+package liquiditypool
+
+import (
+	"context"
+
+	"quasarflow-api/internal/infrastructure/stellar"
+)
+
+// GetPoolUseCase returns a liquidity pool's reserves, e.g. to choose deposit price bounds
+type GetPoolUseCase struct {
+	stellarClient *stellar.Client
+}
+
+// NewGetPoolUseCase creates a new get pool use case
+func NewGetPoolUseCase(stellarClient *stellar.Client) *GetPoolUseCase {
+	return &GetPoolUseCase{
+		stellarClient: stellarClient,
+	}
+}
+
+// Execute loads the pool from Horizon
+func (uc *GetPoolUseCase) Execute(ctx context.Context, poolID string) (*PoolOutput, error) {
+	if _, err := parsePoolID(poolID); err != nil {
+		return nil, err
+	}
+
+	pool, err := uc.stellarClient.GetLiquidityPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPoolOutput(pool), nil
+}
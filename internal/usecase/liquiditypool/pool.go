@@ -0,0 +1,155 @@
+package liquiditypool
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/price"
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+// ReserveOutput is an amount of one pool asset
+type ReserveOutput struct {
+	Asset  string `json:"asset"` // "native" or "CODE:ISSUER"
+	Amount string `json:"amount"`
+}
+
+// PoolOutput represents a liquidity pool and its reserves
+type PoolOutput struct {
+	ID              string          `json:"id"`
+	FeeBP           uint32          `json:"fee_bp"`
+	TotalTrustlines uint64          `json:"total_trustlines"`
+	TotalShares     string          `json:"total_shares"`
+	Reserves        []ReserveOutput `json:"reserves"`
+}
+
+// PoolOperationOutput is returned by deposit and withdraw
+type PoolOperationOutput struct {
+	WalletID         string `json:"wallet_id"`
+	PoolID           string `json:"pool_id"`
+	SubmissionID     string `json:"submission_id"`
+	TransactionHash  string `json:"transaction_hash"`
+	Ledger           int32  `json:"ledger"`
+	FeeCharged       int64  `json:"fee_charged"`
+	TrustlineCreated bool   `json:"trustline_created,omitempty"` // Pool share trustline was added in the same transaction
+}
+
+func toPoolOutput(p *domainStellar.LiquidityPool) *PoolOutput {
+	reserves := make([]ReserveOutput, 0, len(p.Reserves))
+	for _, r := range p.Reserves {
+		reserves = append(reserves, ReserveOutput{Asset: r.Asset, Amount: r.Amount.StringFixed(domainStellar.AmountDecimals)})
+	}
+
+	return &PoolOutput{
+		ID:              p.ID,
+		FeeBP:           p.FeeBP,
+		TotalTrustlines: p.TotalTrustlines,
+		TotalShares:     p.TotalShares.StringFixed(domainStellar.AmountDecimals),
+		Reserves:        reserves,
+	}
+}
+
+// parsePoolID validates a hex pool ID and returns its ledger form
+func parsePoolID(value string) (txnbuild.LiquidityPoolId, error) {
+	var id txnbuild.LiquidityPoolId
+
+	raw, err := hex.DecodeString(value)
+	if err != nil || len(raw) != len(id) {
+		return id, errors.ErrInvalidLiquidityPoolID
+	}
+	copy(id[:], raw)
+
+	return id, nil
+}
+
+// poolIDString formats a pool ID the way Horizon reports it
+func poolIDString(id txnbuild.LiquidityPoolId) string {
+	return hex.EncodeToString(id[:])
+}
+
+// parseAsset validates an asset given as code and issuer; an empty code or XLM without issuer is the native asset
+func parseAsset(code, issuer string) (txnbuild.Asset, error) {
+	if issuer == "" && (code == "" || strings.EqualFold(code, "XLM")) {
+		return txnbuild.NativeAsset{}, nil
+	}
+	if !domainStellar.IsValidAssetCode(code) {
+		return nil, errors.ErrInvalidAssetCode
+	}
+	if !strkey.IsValidEd25519PublicKey(issuer) {
+		return nil, errors.ErrInvalidIssuer
+	}
+
+	return txnbuild.CreditAsset{Code: code, Issuer: issuer}, nil
+}
+
+// lessThan reports whether asset a sorts before b in the ledger's pool asset order
+func lessThan(a, b txnbuild.Asset) (bool, error) {
+	xdrA, err := a.ToXDR()
+	if err != nil {
+		return false, fmt.Errorf("failed to encode asset: %w", err)
+	}
+	xdrB, err := b.ToXDR()
+	if err != nil {
+		return false, fmt.Errorf("failed to encode asset: %w", err)
+	}
+	return xdrA.LessThan(xdrB), nil
+}
+
+// parsePriceBound converts a positive decimal price into the rational price carried on the ledger
+func parsePriceBound(value string) (decimal.Decimal, xdr.Price, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil || !d.IsPositive() {
+		return decimal.Decimal{}, xdr.Price{}, errors.ErrInvalidPriceBounds
+	}
+
+	p, err := price.Parse(value)
+	if err != nil {
+		return decimal.Decimal{}, xdr.Price{}, errors.ErrInvalidPriceBounds
+	}
+
+	return d, p, nil
+}
+
+// parseMinimum validates a withdraw minimum, which may be zero
+func parseMinimum(value string) (string, error) {
+	if value == "" {
+		return "", errors.ErrInvalidWithdrawMinimum
+	}
+	d, err := decimal.NewFromString(value)
+	if err != nil || d.IsNegative() || d.Exponent() < -domainStellar.AmountDecimals {
+		return "", errors.ErrInvalidWithdrawMinimum
+	}
+	return d.StringFixed(domainStellar.AmountDecimals), nil
+}
+
+// findPoolShares returns the account's balance line for a pool's shares, or nil if it holds no trustline
+func findPoolShares(account *horizon.Account, poolID string) *horizon.Balance {
+	for i := range account.Balances {
+		b := &account.Balances[i]
+		if b.Asset.Type == domainStellar.AssetTypePoolShares && b.LiquidityPoolId == poolID {
+			return b
+		}
+	}
+	return nil
+}
+
+// holdsAsset reports whether the account can hold an asset; native is always held
+func holdsAsset(account *horizon.Account, asset txnbuild.Asset) bool {
+	if asset.IsNative() {
+		return true
+	}
+	for _, b := range account.Balances {
+		if b.Asset.Code == asset.GetCode() && b.Asset.Issuer == asset.GetIssuer() {
+			return true
+		}
+	}
+	return false
+}
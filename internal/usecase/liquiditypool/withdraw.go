@@ -0,0 +1,134 @@
+package liquiditypool
+
+import (
+	"context"
+	"fmt"
+
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/txnbuild"
+)
+
+// WithdrawInput represents a request to redeem pool shares. The minimums protect against
+// the pool price moving before the transaction is applied; A and B follow the pool's order.
+type WithdrawInput struct {
+	WalletID   uuid.UUID `json:"wallet_id"`
+	PoolID     string    `json:"pool_id"`
+	Amount     string    `json:"amount" validate:"required"` // Pool shares to redeem
+	MinAmountA string    `json:"min_amount_a" validate:"required"`
+	MinAmountB string    `json:"min_amount_b" validate:"required"`
+	MaxFee     int64     `json:"max_fee,omitempty"`
+}
+
+// WithdrawUseCase withdraws from a liquidity pool into a managed wallet
+type WithdrawUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	txBuilder     *walletUC.TransactionBuilder
+	logger        logger.Logger
+}
+
+// NewWithdrawUseCase creates a new liquidity pool withdraw use case
+func NewWithdrawUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *WithdrawUseCase {
+	return &WithdrawUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		txBuilder:     txBuilder,
+		logger:        logger,
+	}
+}
+
+// Execute validates the share balance and submits LiquidityPoolWithdraw. The pool share
+// trustline is kept so the wallet can deposit again.
+func (uc *WithdrawUseCase) Execute(ctx context.Context, input WithdrawInput) (*PoolOperationOutput, error) {
+	// 1. Validate pool, amount and minimums
+	poolID, err := parsePoolID(input.PoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := domainStellar.ParseAmount(input.Amount)
+	if err != nil {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	minA, err := parseMinimum(input.MinAmountA)
+	if err != nil {
+		return nil, err
+	}
+	minB, err := parseMinimum(input.MinAmountB)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Find wallet; it must hold enough shares
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	account, err := uc.horizonClient.AccountDetail(horizonclient.AccountRequest{AccountID: w.PublicKey})
+	if err != nil {
+		uc.logger.Error("failed to load wallet account", logger.Error(err))
+		return nil, fmt.Errorf("failed to load wallet account: %w", err)
+	}
+
+	shares := findPoolShares(&account, poolIDString(poolID))
+	if shares == nil {
+		return nil, errors.ErrLiquidityPoolNotFound
+	}
+	if decimal.RequireFromString(shares.Balance).LessThan(amount) {
+		return nil, errors.ErrInsufficientPoolShares
+	}
+
+	// 3. Build, sign and submit
+	signed, err := uc.txBuilder.Build(ctx, walletUC.BuildTransactionParams{
+		Wallet: w,
+		Kind:   submission.KindLiquidityPoolWithdraw,
+		Operations: []txnbuild.Operation{
+			&txnbuild.LiquidityPoolWithdraw{
+				LiquidityPoolID: poolID,
+				Amount:          amount.StringFixed(domainStellar.AmountDecimals),
+				MinAmountA:      minA,
+				MinAmountB:      minB,
+			},
+		},
+		MaxFee: input.MaxFee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.txBuilder.Submit(ctx, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("liquidity withdrawn",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("pool_id", poolIDString(poolID)),
+		logger.String("shares", amount.String()),
+		logger.String("hash", resp.Hash))
+
+	return &PoolOperationOutput{
+		WalletID:        w.ID.String(),
+		PoolID:          poolIDString(poolID),
+		SubmissionID:    resp.SubmissionID.String(),
+		TransactionHash: resp.Hash,
+		Ledger:          resp.Ledger,
+		FeeCharged:      resp.FeeCharged,
+	}, nil
+}
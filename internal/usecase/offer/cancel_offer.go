@@ -0,0 +1,88 @@
+package offer
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+// CancelOfferInput represents a request to cancel an open offer
+type CancelOfferInput struct {
+	WalletID uuid.UUID `json:"wallet_id"`
+	OfferID  string    `json:"offer_id"`
+	MaxFee   int64     `json:"max_fee,omitempty"`
+}
+
+// CancelOfferUseCase deletes an open offer of a managed wallet
+type CancelOfferUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	txBuilder     *walletUC.TransactionBuilder
+	logger        logger.Logger
+}
+
+// NewCancelOfferUseCase creates a new cancel offer use case
+func NewCancelOfferUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *CancelOfferUseCase {
+	return &CancelOfferUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		txBuilder:     txBuilder,
+		logger:        logger,
+	}
+}
+
+// Execute submits ManageSellOffer with a zero amount, which deletes the offer and
+// releases its liabilities
+func (uc *CancelOfferUseCase) Execute(ctx context.Context, input CancelOfferInput) (*OfferOperationOutput, error) {
+	// 1. Validate offer ID
+	offerID, err := parseOfferID(input.OfferID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Find wallet and its open offer
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	o, err := loadOpenOffer(uc.horizonClient, w, offerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Build, sign and submit the delete
+	op := &txnbuild.ManageSellOffer{
+		Selling: offerAsset(o.Selling),
+		Buying:  offerAsset(o.Buying),
+		Amount:  "0",
+		Price:   xdr.Price{N: xdr.Int32(o.PriceR.N), D: xdr.Int32(o.PriceR.D)},
+		OfferID: offerID,
+	}
+
+	output, err := submitOffer(ctx, uc.txBuilder, w, submission.KindCancelOffer, op, offerID, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("offer cancelled",
+		logger.String("wallet_id", w.ID.String()),
+		logger.Int64("offer_id", offerID),
+		logger.String("hash", output.TransactionHash))
+
+	return output, nil
+}
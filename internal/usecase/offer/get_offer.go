@@ -0,0 +1,131 @@
+package offer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+// maxOfferTrades caps the fills returned with an offer; use the trades endpoint to page further
+const maxOfferTrades = 50
+
+// OfferDetailOutput represents an offer's status, its tracked submissions and its fills
+type OfferDetailOutput struct {
+	OfferID     int64                   `json:"offer_id"`
+	Status      string                  `json:"status"`
+	Offer       *OfferOutput            `json:"offer,omitempty"` // Present while the offer is open
+	Submissions []OfferSubmissionOutput `json:"submissions"`
+	Trades      []TradeOutput           `json:"trades"`
+}
+
+// GetOfferUseCase reports the status of an offer placed by a managed wallet
+type GetOfferUseCase struct {
+	repo          wallet.Repository
+	submissions   submission.Repository
+	horizonClient *horizonclient.Client
+	logger        logger.Logger
+}
+
+// NewGetOfferUseCase creates a new get offer use case
+func NewGetOfferUseCase(
+	repo wallet.Repository,
+	submissions submission.Repository,
+	horizonClient *horizonclient.Client,
+	logger logger.Logger,
+) *GetOfferUseCase {
+	return &GetOfferUseCase{
+		repo:          repo,
+		submissions:   submissions,
+		horizonClient: horizonClient,
+		logger:        logger,
+	}
+}
+
+// Execute combines the order book with the wallet's submissions. An offer still on
+// the book is open; one that left it is cancelled if the wallet's last successful
+// submission for it was a cancel, and filled otherwise.
+func (uc *GetOfferUseCase) Execute(ctx context.Context, walletID uuid.UUID, offerIDParam string) (*OfferDetailOutput, error) {
+	// 1. Validate offer ID
+	offerID, err := parseOfferID(offerIDParam)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Find wallet
+	w, err := uc.repo.FindByID(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	// 3. Load tracked submissions for the offer
+	records, err := uc.submissions.ListByOffer(ctx, w.ID, offerID)
+	if err != nil {
+		uc.logger.Error("failed to list offer submissions", logger.Error(err))
+		return nil, fmt.Errorf("failed to list offer submissions: %w", err)
+	}
+
+	output := &OfferDetailOutput{
+		OfferID:     offerID,
+		Submissions: make([]OfferSubmissionOutput, 0, len(records)),
+	}
+	for _, s := range records {
+		output.Submissions = append(output.Submissions, OfferSubmissionOutput{
+			SubmissionID: s.ID.String(),
+			Kind:         s.Kind,
+			Hash:         s.Hash,
+			Status:       string(s.Status),
+			Ledger:       s.Ledger,
+			ResultCode:   s.ResultCode,
+			CreatedAt:    s.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	// 4. Derive the status from the order book
+	o, err := loadOpenOffer(uc.horizonClient, w, offerID)
+	switch {
+	case err == nil:
+		offer := toOfferOutput(*o)
+		output.Offer = &offer
+		output.Status = StatusOpen
+	case err == errors.ErrOfferNotFound:
+		if len(records) == 0 {
+			return nil, err
+		}
+		output.Status = StatusFilled
+		if lastSucceeded(records) == submission.KindCancelOffer {
+			output.Status = StatusCancelled
+		}
+	default:
+		uc.logger.Error("failed to load offer", logger.Error(err))
+		return nil, err
+	}
+
+	// 5. Attach the most recent fills
+	trades, err := fetchTrades(uc.horizonClient, w.PublicKey, strconv.FormatInt(offerID, 10), "", maxOfferTrades)
+	if err != nil {
+		uc.logger.Warn("failed to fetch offer trades", logger.Error(err), logger.Int64("offer_id", offerID))
+		trades = []TradeOutput{}
+	}
+	output.Trades = trades
+
+	return output, nil
+}
+
+// lastSucceeded returns the kind of the newest successful submission; records are newest first
+func lastSucceeded(records []*submission.Submission) string {
+	for _, s := range records {
+		if s.Status == submission.StatusSuccess {
+			return s.Kind
+		}
+	}
+	return ""
+}
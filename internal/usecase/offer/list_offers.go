@@ -0,0 +1,79 @@
+package offer
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+// ListOffersInput represents a request for a wallet's open offers
+type ListOffersInput struct {
+	WalletID uuid.UUID
+	Cursor   string
+	Limit    uint
+}
+
+// ListOffersOutput represents a page of a wallet's open offers
+type ListOffersOutput struct {
+	WalletID   string        `json:"wallet_id"`
+	PublicKey  string        `json:"public_key"`
+	Offers     []OfferOutput `json:"offers"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+// ListOffersUseCase lists the offers a wallet has resting on the order book
+type ListOffersUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	logger        logger.Logger
+}
+
+// NewListOffersUseCase creates a new list offers use case
+func NewListOffersUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	logger logger.Logger,
+) *ListOffersUseCase {
+	return &ListOffersUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		logger:        logger,
+	}
+}
+
+// Execute returns a page of open offers from Horizon
+func (uc *ListOffersUseCase) Execute(ctx context.Context, input ListOffersInput) (*ListOffersOutput, error) {
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	page, err := uc.horizonClient.Offers(horizonclient.OfferRequest{
+		ForAccount: w.PublicKey,
+		Cursor:     input.Cursor,
+		Limit:      input.Limit,
+	})
+	if err != nil {
+		uc.logger.Error("failed to fetch offers", logger.Error(err), logger.String("wallet_id", w.ID.String()))
+		return nil, fmt.Errorf("failed to fetch offers: %w", err)
+	}
+
+	output := &ListOffersOutput{
+		WalletID:  w.ID.String(),
+		PublicKey: w.PublicKey,
+		Offers:    make([]OfferOutput, 0, len(page.Embedded.Records)),
+	}
+	for _, o := range page.Embedded.Records {
+		output.Offers = append(output.Offers, toOfferOutput(o))
+	}
+	if n := len(page.Embedded.Records); n > 0 && uint(n) == input.Limit {
+		output.NextCursor = page.Embedded.Records[n-1].PT
+	}
+
+	return output, nil
+}
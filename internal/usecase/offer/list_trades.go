@@ -0,0 +1,177 @@
+package offer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/protocols/horizon"
+)
+
+// TradeOutput is a fill seen from the wallet's side of the trade
+type TradeOutput struct {
+	ID              string    `json:"id"`
+	PagingToken     string    `json:"paging_token"`
+	LedgerCloseTime time.Time `json:"ledger_close_time"`
+	OfferID         string    `json:"offer_id,omitempty"` // The wallet's offer, empty for path payments
+	Maker           bool      `json:"maker"`              // The wallet's offer was resting on the book
+	SoldAsset       string    `json:"sold_asset"`
+	SoldAmount      string    `json:"sold_amount"`
+	BoughtAsset     string    `json:"bought_asset"`
+	BoughtAmount    string    `json:"bought_amount"`
+	Counterparty    string    `json:"counterparty"` // Account or liquidity pool on the other side
+}
+
+// ListTradesInput represents a request for a wallet's trades
+type ListTradesInput struct {
+	WalletID uuid.UUID
+	OfferID  string // Optional, only trades that filled this offer
+	Cursor   string
+	Limit    uint
+}
+
+// ListTradesOutput represents a page of a wallet's trades, newest first
+type ListTradesOutput struct {
+	WalletID   string        `json:"wallet_id"`
+	PublicKey  string        `json:"public_key"`
+	Trades     []TradeOutput `json:"trades"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+// ListTradesUseCase lists the fills of a wallet's offers from Horizon
+type ListTradesUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	logger        logger.Logger
+}
+
+// NewListTradesUseCase creates a new list trades use case
+func NewListTradesUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	logger logger.Logger,
+) *ListTradesUseCase {
+	return &ListTradesUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		logger:        logger,
+	}
+}
+
+// Execute returns a page of trades for the wallet, optionally restricted to one offer
+func (uc *ListTradesUseCase) Execute(ctx context.Context, input ListTradesInput) (*ListTradesOutput, error) {
+	if input.OfferID != "" {
+		if _, err := parseOfferID(input.OfferID); err != nil {
+			return nil, err
+		}
+	}
+
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	trades, err := fetchTrades(uc.horizonClient, w.PublicKey, input.OfferID, input.Cursor, input.Limit)
+	if err != nil {
+		uc.logger.Error("failed to fetch trades", logger.Error(err), logger.String("wallet_id", w.ID.String()))
+		return nil, err
+	}
+
+	output := &ListTradesOutput{
+		WalletID:  w.ID.String(),
+		PublicKey: w.PublicKey,
+		Trades:    trades,
+	}
+	if len(trades) > 0 && uint(len(trades)) == input.Limit {
+		output.NextCursor = trades[len(trades)-1].PagingToken
+	}
+
+	return output, nil
+}
+
+// fetchTrades loads a page of an account's trades, newest first. Offer trades are
+// requested by offer ID alone since Horizon does not combine the two filters.
+func fetchTrades(horizonClient *horizonclient.Client, publicKey, offerID, cursor string, limit uint) ([]TradeOutput, error) {
+	req := horizonclient.TradeRequest{
+		Cursor: cursor,
+		Limit:  limit,
+		Order:  horizonclient.OrderDesc,
+	}
+	if offerID != "" {
+		req.ForOfferID = offerID
+	} else {
+		req.ForAccount = publicKey
+	}
+
+	page, err := horizonClient.Trades(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch trades: %w", err)
+	}
+
+	trades := make([]TradeOutput, 0, len(page.Embedded.Records))
+	for _, t := range page.Embedded.Records {
+		if t.BaseAccount != publicKey && t.CounterAccount != publicKey {
+			continue
+		}
+		trades = append(trades, toTradeOutput(t, publicKey))
+	}
+
+	return trades, nil
+}
+
+// toTradeOutput orients a trade to the wallet. Horizon moves base_amount of the base
+// asset from the base party to the counter party, and counter_amount the other way.
+func toTradeOutput(t horizon.Trade, publicKey string) TradeOutput {
+	base := assetString(t.BaseAssetType, t.BaseAssetCode, t.BaseAssetIssuer)
+	counter := assetString(t.CounterAssetType, t.CounterAssetCode, t.CounterAssetIssuer)
+
+	output := TradeOutput{
+		ID:              t.ID,
+		PagingToken:     t.PT,
+		LedgerCloseTime: t.LedgerCloseTime,
+	}
+
+	if t.BaseAccount == publicKey {
+		output.OfferID = t.BaseOfferID
+		output.Maker = t.BaseIsSeller
+		output.SoldAsset, output.SoldAmount = base, t.BaseAmount
+		output.BoughtAsset, output.BoughtAmount = counter, t.CounterAmount
+		output.Counterparty = firstNonEmpty(t.CounterAccount, t.CounterLiquidityPoolID)
+	} else {
+		output.OfferID = t.CounterOfferID
+		output.Maker = !t.BaseIsSeller
+		output.SoldAsset, output.SoldAmount = counter, t.CounterAmount
+		output.BoughtAsset, output.BoughtAmount = base, t.BaseAmount
+		output.Counterparty = firstNonEmpty(t.BaseAccount, t.BaseLiquidityPoolID)
+	}
+
+	// Horizon synthesizes offer IDs for path payment takers; they are not the wallet's offers
+	if id, err := strconv.ParseInt(output.OfferID, 10, 64); err != nil || id <= 0 || id >= 1<<62 {
+		output.OfferID = ""
+	}
+
+	return output
+}
+
+// assetString formats an asset as "native" or "CODE:ISSUER"
+func assetString(assetType, code, issuer string) string {
+	if assetType == "native" {
+		return "native"
+	}
+	return code + ":" + issuer
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
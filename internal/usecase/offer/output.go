@@ -0,0 +1,216 @@
+package offer
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/price"
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+// Offer types accepted when placing an offer
+const (
+	TypeSell    = "sell"
+	TypeBuy     = "buy"
+	TypePassive = "passive"
+)
+
+// Offer statuses derived from the order book and the wallet's submissions
+const (
+	StatusOpen      = "open"      // Resting on the order book
+	StatusFilled    = "filled"    // Removed from the book by trades
+	StatusCancelled = "cancelled" // Deleted by the wallet
+)
+
+// OfferOutput represents an open offer on the order book
+type OfferOutput struct {
+	OfferID            int64  `json:"offer_id"`
+	Seller             string `json:"seller"`
+	SellingAssetType   string `json:"selling_asset_type"`
+	SellingAssetCode   string `json:"selling_asset_code,omitempty"`
+	SellingAssetIssuer string `json:"selling_asset_issuer,omitempty"`
+	BuyingAssetType    string `json:"buying_asset_type"`
+	BuyingAssetCode    string `json:"buying_asset_code,omitempty"`
+	BuyingAssetIssuer  string `json:"buying_asset_issuer,omitempty"`
+	Amount             string `json:"amount"`
+	Price              string `json:"price"`
+	LastModifiedLedger int32  `json:"last_modified_ledger"`
+}
+
+// OfferOperationOutput is returned by every use case that places, updates or cancels an offer
+type OfferOperationOutput struct {
+	OfferID         int64  `json:"offer_id"`
+	Status          string `json:"status"`
+	Kind            string `json:"kind"`
+	SubmissionID    string `json:"submission_id"`
+	TransactionHash string `json:"transaction_hash"`
+	Ledger          int32  `json:"ledger"`
+}
+
+// OfferSubmissionOutput is a tracked transaction that touched an offer
+type OfferSubmissionOutput struct {
+	SubmissionID string `json:"submission_id"`
+	Kind         string `json:"kind"`
+	Hash         string `json:"hash"`
+	Status       string `json:"status"`
+	Ledger       int32  `json:"ledger,omitempty"`
+	ResultCode   string `json:"result_code,omitempty"`
+	CreatedAt    string `json:"created_at"`
+}
+
+func toOfferOutput(o horizon.Offer) OfferOutput {
+	return OfferOutput{
+		OfferID:            o.ID,
+		Seller:             o.Seller,
+		SellingAssetType:   o.Selling.Type,
+		SellingAssetCode:   o.Selling.Code,
+		SellingAssetIssuer: o.Selling.Issuer,
+		BuyingAssetType:    o.Buying.Type,
+		BuyingAssetCode:    o.Buying.Code,
+		BuyingAssetIssuer:  o.Buying.Issuer,
+		Amount:             o.Amount,
+		Price:              o.Price,
+		LastModifiedLedger: o.LastModifiedLedger,
+	}
+}
+
+// offerAsset returns the txnbuild asset of an offer side as reported by Horizon
+func offerAsset(a horizon.Asset) txnbuild.Asset {
+	if a.Type == "native" {
+		return txnbuild.NativeAsset{}
+	}
+	return txnbuild.CreditAsset{Code: a.Code, Issuer: a.Issuer}
+}
+
+// parseAsset validates an asset given as code and issuer; an empty code or XLM without issuer is the native asset
+func parseAsset(code, issuer string) (txnbuild.Asset, error) {
+	if issuer == "" && (code == "" || strings.EqualFold(code, "XLM")) {
+		return txnbuild.NativeAsset{}, nil
+	}
+	if !domainStellar.IsValidAssetCode(code) {
+		return nil, errors.ErrInvalidAssetCode
+	}
+	if !strkey.IsValidEd25519PublicKey(issuer) {
+		return nil, errors.ErrInvalidIssuer
+	}
+
+	return txnbuild.CreditAsset{Code: code, Issuer: issuer}, nil
+}
+
+// parseAssetPair validates both sides of an offer
+func parseAssetPair(sellingCode, sellingIssuer, buyingCode, buyingIssuer string) (selling, buying txnbuild.Asset, err error) {
+	selling, err = parseAsset(sellingCode, sellingIssuer)
+	if err != nil {
+		return nil, nil, err
+	}
+	buying, err = parseAsset(buyingCode, buyingIssuer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if selling.GetCode() == buying.GetCode() && selling.GetIssuer() == buying.GetIssuer() {
+		return nil, nil, errors.ErrOfferSameAsset
+	}
+
+	return selling, buying, nil
+}
+
+// parseOfferAmount validates an offer amount
+func parseOfferAmount(value string) (string, error) {
+	amount, err := domainStellar.ParseAmount(value)
+	if err != nil {
+		return "", errors.ErrInvalidAmount
+	}
+	return amount.StringFixed(domainStellar.AmountDecimals), nil
+}
+
+// parsePrice converts a positive decimal price into the rational price carried on the ledger
+func parsePrice(value string) (xdr.Price, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil || !d.IsPositive() {
+		return xdr.Price{}, errors.ErrInvalidOfferPrice
+	}
+
+	p, err := price.Parse(value)
+	if err != nil {
+		return xdr.Price{}, errors.ErrInvalidOfferPrice
+	}
+
+	return p, nil
+}
+
+// parseOfferID validates an offer ID taken from a path parameter
+func parseOfferID(value string) (int64, error) {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, errors.ErrOfferNotFound
+	}
+	return id, nil
+}
+
+// checkOutflow refuses offers from wallets whose allow-list, spending limits or approval
+// policy on the selling asset cannot be applied to trades with unknown counterparties
+func checkOutflow(ctx context.Context, guard *walletUC.OutflowGuard, w *wallet.Wallet, userID string, selling txnbuild.Asset) error {
+	if err := guard.CheckNoAllowList(ctx, w.ID, userID); err != nil {
+		return err
+	}
+	return guard.CheckUnlimited(ctx, w.ID, userID, walletUC.AssetKey(selling))
+}
+
+// submitOffer builds, signs and submits a single manage offer operation and derives the resulting status
+func submitOffer(
+	ctx context.Context,
+	txBuilder *walletUC.TransactionBuilder,
+	w *wallet.Wallet,
+	kind string,
+	op txnbuild.Operation,
+	offerID int64,
+	maxFee int64,
+) (*OfferOperationOutput, error) {
+	signed, err := txBuilder.Build(ctx, walletUC.BuildTransactionParams{
+		Wallet:     w,
+		Kind:       kind,
+		Operations: []txnbuild.Operation{op},
+		MaxFee:     maxFee,
+		OfferID:    offerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := txBuilder.Submit(ctx, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	// An offer that crossed the book completely is never created on the ledger
+	status := StatusOpen
+	switch {
+	case kind == submission.KindCancelOffer:
+		status = StatusCancelled
+	case resp.OfferID == 0:
+		status = StatusFilled
+	default:
+		offerID = resp.OfferID
+	}
+
+	return &OfferOperationOutput{
+		OfferID:         offerID,
+		Status:          status,
+		Kind:            kind,
+		SubmissionID:    resp.SubmissionID.String(),
+		TransactionHash: resp.Hash,
+		Ledger:          resp.Ledger,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package offer
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/txnbuild"
+)
+
+// PlaceOfferInput represents a request to place a new offer on the DEX.
+// For sell and passive offers amount is the selling amount; for buy offers it is
+// the buying amount. Price is always buying units per selling unit for sell
+// offers and selling units per buying unit for buy offers, as on the ledger.
+type PlaceOfferInput struct {
+	WalletID           uuid.UUID `json:"wallet_id"`
+	Type               string    `json:"type" validate:"required,oneof=sell buy passive"`
+	SellingAssetCode   string    `json:"selling_asset_code,omitempty"`
+	SellingAssetIssuer string    `json:"selling_asset_issuer,omitempty"`
+	BuyingAssetCode    string    `json:"buying_asset_code,omitempty"`
+	BuyingAssetIssuer  string    `json:"buying_asset_issuer,omitempty"`
+	Amount             string    `json:"amount" validate:"required"`
+	Price              string    `json:"price" validate:"required"`
+	MaxFee             int64     `json:"max_fee,omitempty"`
+	UserID             string    `json:"-"` // Authenticated caller, checked against the address book and spending limits
+}
+
+// PlaceOfferUseCase places sell, buy and passive sell offers from a managed wallet
+type PlaceOfferUseCase struct {
+	repo      wallet.Repository
+	txBuilder *walletUC.TransactionBuilder
+	guard     *walletUC.OutflowGuard
+	logger    logger.Logger
+}
+
+// NewPlaceOfferUseCase creates a new place offer use case
+func NewPlaceOfferUseCase(
+	repo wallet.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	guard *walletUC.OutflowGuard,
+	logger logger.Logger,
+) *PlaceOfferUseCase {
+	return &PlaceOfferUseCase{
+		repo:      repo,
+		txBuilder: txBuilder,
+		guard:     guard,
+		logger:    logger,
+	}
+}
+
+// Execute validates the offer and submits it. The returned status is filled when
+// the offer crossed existing offers completely and never rested on the book.
+func (uc *PlaceOfferUseCase) Execute(ctx context.Context, input PlaceOfferInput) (*OfferOperationOutput, error) {
+	// 1. Validate offer terms
+	selling, buying, err := parseAssetPair(input.SellingAssetCode, input.SellingAssetIssuer, input.BuyingAssetCode, input.BuyingAssetIssuer)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parseOfferAmount(input.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := parsePrice(input.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Build the operation for the offer type
+	var (
+		kind string
+		op   txnbuild.Operation
+	)
+	switch input.Type {
+	case TypeSell:
+		kind = submission.KindManageSellOffer
+		op = &txnbuild.ManageSellOffer{Selling: selling, Buying: buying, Amount: amount, Price: p}
+	case TypeBuy:
+		kind = submission.KindManageBuyOffer
+		op = &txnbuild.ManageBuyOffer{Selling: selling, Buying: buying, Amount: amount, Price: p}
+	case TypePassive:
+		kind = submission.KindPassiveSellOffer
+		op = &txnbuild.CreatePassiveSellOffer{Selling: selling, Buying: buying, Amount: amount, Price: p}
+	default:
+		return nil, errors.ErrInvalidOfferType
+	}
+
+	// 3. Find wallet
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	if err := checkOutflow(ctx, uc.guard, w, input.UserID, selling); err != nil {
+		return nil, err
+	}
+
+	// 4. Build, sign and submit
+	output, err := submitOffer(ctx, uc.txBuilder, w, kind, op, 0, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("offer placed",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("type", input.Type),
+		logger.Int64("offer_id", output.OfferID),
+		logger.String("status", output.Status),
+		logger.String("hash", output.TransactionHash))
+
+	return output, nil
+}
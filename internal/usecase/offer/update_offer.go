@@ -0,0 +1,131 @@
+package offer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/txnbuild"
+)
+
+// UpdateOfferInput represents a request to change the amount and price of an open offer.
+// The ledger stores every offer as a sell offer, so amount is the selling amount and
+// price is buying units per selling unit, whichever type the offer was placed as.
+type UpdateOfferInput struct {
+	WalletID uuid.UUID `json:"wallet_id"`
+	OfferID  string    `json:"offer_id"`
+	Amount   string    `json:"amount" validate:"required"`
+	Price    string    `json:"price" validate:"required"`
+	MaxFee   int64     `json:"max_fee,omitempty"`
+	UserID   string    `json:"-"` // Authenticated caller, checked against the address book and spending limits
+}
+
+// UpdateOfferUseCase changes an open offer of a managed wallet
+type UpdateOfferUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	txBuilder     *walletUC.TransactionBuilder
+	guard         *walletUC.OutflowGuard
+	logger        logger.Logger
+}
+
+// NewUpdateOfferUseCase creates a new update offer use case
+func NewUpdateOfferUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	txBuilder *walletUC.TransactionBuilder,
+	guard *walletUC.OutflowGuard,
+	logger logger.Logger,
+) *UpdateOfferUseCase {
+	return &UpdateOfferUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		txBuilder:     txBuilder,
+		guard:         guard,
+		logger:        logger,
+	}
+}
+
+// Execute submits ManageSellOffer against the existing offer ID. Passive offers stay
+// passive; the flag is kept by the ledger when an offer is updated.
+func (uc *UpdateOfferUseCase) Execute(ctx context.Context, input UpdateOfferInput) (*OfferOperationOutput, error) {
+	// 1. Validate new terms
+	offerID, err := parseOfferID(input.OfferID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parseOfferAmount(input.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := parsePrice(input.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Find wallet and its open offer
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	o, err := loadOpenOffer(uc.horizonClient, w, offerID)
+	if err != nil {
+		return nil, err
+	}
+
+	selling := offerAsset(o.Selling)
+	if err := checkOutflow(ctx, uc.guard, w, input.UserID, selling); err != nil {
+		return nil, err
+	}
+
+	// 3. Build, sign and submit
+	op := &txnbuild.ManageSellOffer{
+		Selling: selling,
+		Buying:  offerAsset(o.Buying),
+		Amount:  amount,
+		Price:   p,
+		OfferID: offerID,
+	}
+
+	output, err := submitOffer(ctx, uc.txBuilder, w, submission.KindManageSellOffer, op, offerID, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("offer updated",
+		logger.String("wallet_id", w.ID.String()),
+		logger.Int64("offer_id", offerID),
+		logger.String("status", output.Status),
+		logger.String("hash", output.TransactionHash))
+
+	return output, nil
+}
+
+// loadOpenOffer returns an offer from the order book if it belongs to the wallet
+func loadOpenOffer(horizonClient *horizonclient.Client, w *wallet.Wallet, offerID int64) (*horizon.Offer, error) {
+	o, err := horizonClient.OfferDetails(strconv.FormatInt(offerID, 10))
+	if err != nil {
+		if horizonclient.IsNotFoundError(err) {
+			return nil, errors.ErrOfferNotFound
+		}
+		return nil, fmt.Errorf("failed to load offer: %w", err)
+	}
+
+	if o.Seller != w.PublicKey {
+		return nil, errors.ErrOfferNotFound
+	}
+
+	return &o, nil
+}
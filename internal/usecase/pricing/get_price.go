@@ -0,0 +1,187 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"quasarflow-api/internal/domain/pricing"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/shopspring/decimal"
+)
+
+const defaultPriceCacheTTL = time.Minute
+
+// PriceOutput is the price of one unit of an asset in a reference currency
+type PriceOutput struct {
+	Asset      string    `json:"asset"`
+	Currency   string    `json:"currency"`
+	Price      string    `json:"price"`
+	Source     string    `json:"source"`      // e.g. orderbook, or "trades via XLM" for cross rates
+	ObservedAt time.Time `json:"observed_at"` // When the source observed the price
+	FetchedAt  time.Time `json:"fetched_at"`  // When this API fetched it; cached until fetched_at + TTL
+}
+
+type cachedQuote struct {
+	quote     *pricing.Quote
+	fetchedAt time.Time
+}
+
+// GetPriceUseCase prices assets from an ordered list of sources with an in-memory cache.
+// When no source prices an asset directly in USD it is priced in XLM and converted.
+type GetPriceUseCase struct {
+	sources  []pricing.Source
+	cacheTTL time.Duration
+	logger   logger.Logger
+
+	mu    sync.Mutex
+	cache map[string]cachedQuote
+}
+
+// NewGetPriceUseCase creates a new get price use case; earlier sources take precedence
+func NewGetPriceUseCase(sources []pricing.Source, cacheTTL time.Duration, logger logger.Logger) *GetPriceUseCase {
+	if cacheTTL <= 0 {
+		cacheTTL = defaultPriceCacheTTL
+	}
+
+	return &GetPriceUseCase{
+		sources:  sources,
+		cacheTTL: cacheTTL,
+		logger:   logger,
+		cache:    map[string]cachedQuote{},
+	}
+}
+
+// Execute returns the price of asset ("native", "XLM" or "CODE:ISSUER") in currency
+func (uc *GetPriceUseCase) Execute(ctx context.Context, asset, currency string) (*PriceOutput, error) {
+	currency = strings.ToUpper(currency)
+	if !pricing.IsSupportedCurrency(currency) {
+		return nil, errors.ErrUnsupportedCurrency
+	}
+
+	asset, err := normalizeAsset(asset)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Direct price, from cache or the first source that has one
+	quote, fetchedAt, err := uc.quote(ctx, asset, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Cross rate through XLM
+	if quote == nil && currency != pricing.CurrencyXLM && asset != pricing.NativeAsset {
+		quote, fetchedAt, err = uc.crossQuote(ctx, asset, currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if quote == nil {
+		return nil, errors.ErrPriceUnavailable
+	}
+
+	return &PriceOutput{
+		Asset:      quote.Asset,
+		Currency:   quote.Currency,
+		Price:      quote.Price.StringFixed(domainStellar.AmountDecimals),
+		Source:     quote.Source,
+		ObservedAt: quote.ObservedAt,
+		FetchedAt:  fetchedAt,
+	}, nil
+}
+
+// quote returns a cached or freshly fetched direct quote, or nil if no source has one
+func (uc *GetPriceUseCase) quote(ctx context.Context, asset, currency string) (*pricing.Quote, time.Time, error) {
+	if asset == pricing.NativeAsset && currency == pricing.CurrencyXLM {
+		now := time.Now()
+		return &pricing.Quote{Asset: asset, Currency: currency, Price: decimal.NewFromInt(1), Source: "identity", ObservedAt: now}, now, nil
+	}
+
+	key := asset + "|" + currency
+	uc.mu.Lock()
+	cached, ok := uc.cache[key]
+	uc.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < uc.cacheTTL {
+		return cached.quote, cached.fetchedAt, nil
+	}
+
+	for _, source := range uc.sources {
+		q, err := source.Quote(ctx, asset, currency)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, time.Time{}, ctx.Err()
+			}
+			uc.logger.Warn("price source failed",
+				logger.Error(err),
+				logger.String("source", source.Name()),
+				logger.String("asset", asset),
+				logger.String("currency", currency))
+			continue
+		}
+		if q == nil {
+			continue
+		}
+
+		fetchedAt := time.Now()
+		uc.mu.Lock()
+		uc.cache[key] = cachedQuote{quote: q, fetchedAt: fetchedAt}
+		uc.mu.Unlock()
+		return q, fetchedAt, nil
+	}
+
+	return nil, time.Time{}, nil
+}
+
+// crossQuote prices asset in XLM and converts with the XLM price in currency
+func (uc *GetPriceUseCase) crossQuote(ctx context.Context, asset, currency string) (*pricing.Quote, time.Time, error) {
+	inXLM, fetchedA, err := uc.quote(ctx, asset, pricing.CurrencyXLM)
+	if err != nil || inXLM == nil {
+		return nil, time.Time{}, err
+	}
+
+	xlm, fetchedB, err := uc.quote(ctx, pricing.NativeAsset, currency)
+	if err != nil || xlm == nil {
+		return nil, time.Time{}, err
+	}
+
+	observedAt := inXLM.ObservedAt
+	if xlm.ObservedAt.Before(observedAt) {
+		observedAt = xlm.ObservedAt
+	}
+	fetchedAt := fetchedA
+	if fetchedB.Before(fetchedAt) {
+		fetchedAt = fetchedB
+	}
+
+	return &pricing.Quote{
+		Asset:      asset,
+		Currency:   currency,
+		Price:      inXLM.Price.Mul(xlm.Price),
+		Source:     fmt.Sprintf("%s via XLM", inXLM.Source),
+		ObservedAt: observedAt,
+	}, fetchedAt, nil
+}
+
+// normalizeAsset validates an asset identifier and maps XLM to "native"
+func normalizeAsset(asset string) (string, error) {
+	if asset == pricing.NativeAsset || strings.EqualFold(asset, pricing.CurrencyXLM) {
+		return pricing.NativeAsset, nil
+	}
+
+	code, issuer, ok := strings.Cut(asset, ":")
+	if !ok || !domainStellar.IsValidAssetCode(code) {
+		return "", errors.ErrInvalidAssetCode
+	}
+	if !strings.HasPrefix(issuer, "G") || len(issuer) != 56 {
+		return "", errors.ErrInvalidIssuer
+	}
+
+	return asset, nil
+}
@@ -0,0 +1,142 @@
+package pricing
+
+import (
+	"context"
+	"time"
+
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/shopspring/decimal"
+)
+
+// Holding is an amount of an asset to value. Pool shares are valued through their
+// underlying reserves, given as Components.
+type Holding struct {
+	Asset      string // "native", "CODE:ISSUER" or "liquidity_pool:{id}"
+	Amount     decimal.Decimal
+	Components []Holding
+}
+
+// AssetValuationOutput is the value of one holding
+type AssetValuationOutput struct {
+	Asset      string    `json:"asset"`
+	Amount     string    `json:"amount"`
+	Price      string    `json:"price"`
+	Value      string    `json:"value"`
+	Source     string    `json:"source"`
+	ObservedAt time.Time `json:"observed_at"`
+}
+
+// ValuationOutput is the value of a set of holdings in a reference currency
+type ValuationOutput struct {
+	Currency string                 `json:"currency"`
+	Total    string                 `json:"total"` // Sum of the priced holdings
+	Assets   []AssetValuationOutput `json:"assets"`
+	Unpriced []string               `json:"unpriced,omitempty"` // Holdings no source could price, excluded from total
+	ValuedAt time.Time              `json:"valued_at"`
+}
+
+// ValuePortfolioUseCase values holdings in USD or XLM
+type ValuePortfolioUseCase struct {
+	prices *GetPriceUseCase
+	logger logger.Logger
+}
+
+// NewValuePortfolioUseCase creates a new value portfolio use case
+func NewValuePortfolioUseCase(prices *GetPriceUseCase, logger logger.Logger) *ValuePortfolioUseCase {
+	return &ValuePortfolioUseCase{
+		prices: prices,
+		logger: logger,
+	}
+}
+
+// Execute values every holding. Holdings that cannot be priced are listed as unpriced
+// rather than failing the valuation.
+func (uc *ValuePortfolioUseCase) Execute(ctx context.Context, currency string, holdings []Holding) (*ValuationOutput, error) {
+	output := &ValuationOutput{
+		Currency: currency,
+		Assets:   make([]AssetValuationOutput, 0, len(holdings)),
+		ValuedAt: time.Now(),
+	}
+
+	total := decimal.Zero
+	for _, h := range holdings {
+		valuation, ok, err := uc.value(ctx, currency, h)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			output.Unpriced = append(output.Unpriced, h.Asset)
+			continue
+		}
+
+		total = total.Add(valuation.value)
+		output.Assets = append(output.Assets, valuation.output(h))
+	}
+	output.Total = total.StringFixed(domainStellar.AmountDecimals)
+
+	return output, nil
+}
+
+type holdingValue struct {
+	value      decimal.Decimal
+	source     string
+	observedAt time.Time
+}
+
+func (v holdingValue) output(h Holding) AssetValuationOutput {
+	price := decimal.Zero
+	if h.Amount.IsPositive() {
+		price = v.value.Div(h.Amount)
+	}
+
+	return AssetValuationOutput{
+		Asset:      h.Asset,
+		Amount:     h.Amount.StringFixed(domainStellar.AmountDecimals),
+		Price:      price.StringFixed(domainStellar.AmountDecimals),
+		Value:      v.value.StringFixed(domainStellar.AmountDecimals),
+		Source:     v.source,
+		ObservedAt: v.observedAt,
+	}
+}
+
+// value prices a holding; ok is false when it or any of its components has no price
+func (uc *ValuePortfolioUseCase) value(ctx context.Context, currency string, h Holding) (holdingValue, bool, error) {
+	if len(h.Components) > 0 {
+		result := holdingValue{value: decimal.Zero, source: "underlying"}
+		for _, c := range h.Components {
+			v, ok, err := uc.value(ctx, currency, c)
+			if err != nil || !ok {
+				return holdingValue{}, false, err
+			}
+			result.value = result.value.Add(v.value)
+			if result.observedAt.IsZero() || v.observedAt.Before(result.observedAt) {
+				result.observedAt = v.observedAt
+			}
+		}
+		return result, true, nil
+	}
+
+	if h.Amount.IsZero() {
+		return holdingValue{value: decimal.Zero, source: "none", observedAt: time.Now()}, true, nil
+	}
+
+	price, err := uc.prices.Execute(ctx, h.Asset, currency)
+	if err != nil {
+		if ctx.Err() != nil {
+			return holdingValue{}, false, ctx.Err()
+		}
+		uc.logger.Debug("holding not priced",
+			logger.Error(err),
+			logger.String("asset", h.Asset),
+			logger.String("currency", currency))
+		return holdingValue{}, false, nil
+	}
+
+	return holdingValue{
+		value:      h.Amount.Mul(decimal.RequireFromString(price.Price)),
+		source:     price.Source,
+		observedAt: price.ObservedAt,
+	}, true, nil
+}
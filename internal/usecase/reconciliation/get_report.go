@@ -0,0 +1,31 @@
+package reconciliation
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/reconciliation"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetReportUseCase retrieves a reconciliation report with its discrepancies
+type GetReportUseCase struct {
+	reports reconciliation.Repository
+}
+
+// NewGetReportUseCase creates a new get report use case
+func NewGetReportUseCase(reports reconciliation.Repository) *GetReportUseCase {
+	return &GetReportUseCase{reports: reports}
+}
+
+// Execute retrieves a report by ID
+func (uc *GetReportUseCase) Execute(ctx context.Context, id uuid.UUID) (*ReportOutput, error) {
+	report, err := uc.reports.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Reconciliation report not found")
+	}
+
+	output := toReportOutput(report)
+	return &output, nil
+}
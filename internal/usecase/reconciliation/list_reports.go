@@ -0,0 +1,83 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/reconciliation"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ListReportsInput represents a request for reconciliation reports
+type ListReportsInput struct {
+	WalletID uuid.UUID // Optional filter
+	Status   string    // Optional filter: clean, discrepancies or failed
+	Limit    int
+	Offset   int
+}
+
+// ListReportsOutput represents a page of reports, without their discrepancies
+type ListReportsOutput struct {
+	Reports []ReportOutput `json:"reports"`
+	Total   int64          `json:"total"`
+	Limit   int            `json:"limit"`
+	Offset  int            `json:"offset"`
+}
+
+// ListReportsUseCase lists stored reconciliation reports
+type ListReportsUseCase struct {
+	reports reconciliation.Repository
+}
+
+// NewListReportsUseCase creates a new list reports use case
+func NewListReportsUseCase(reports reconciliation.Repository) *ListReportsUseCase {
+	return &ListReportsUseCase{reports: reports}
+}
+
+// Execute retrieves a paginated list of reports, newest first
+func (uc *ListReportsUseCase) Execute(ctx context.Context, input ListReportsInput) (*ListReportsOutput, error) {
+	filter := reconciliation.Filter{
+		WalletID: input.WalletID,
+		Status:   reconciliation.Status(input.Status),
+	}
+	switch filter.Status {
+	case "", reconciliation.StatusClean, reconciliation.StatusDiscrepancies, reconciliation.StatusFailed:
+	default:
+		return nil, errors.NewValidationError("Invalid status", "status must be clean, discrepancies or failed")
+	}
+
+	limit, offset := input.Limit, input.Offset
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	reports, err := uc.reports.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reconciliation reports: %w", err)
+	}
+
+	total, err := uc.reports.Count(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reconciliation reports: %w", err)
+	}
+
+	items := make([]ReportOutput, 0, len(reports))
+	for _, r := range reports {
+		items = append(items, toReportOutput(r))
+	}
+
+	return &ListReportsOutput{
+		Reports: items,
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package reconciliation
+
+import (
+	"time"
+
+	"quasarflow-api/internal/domain/reconciliation"
+)
+
+// ReportOutput represents a reconciliation report
+type ReportOutput struct {
+	ID                 string              `json:"id"`
+	WalletID           string              `json:"wallet_id"`
+	Status             string              `json:"status"`
+	WindowStart        string              `json:"window_start"`
+	Cursor             string              `json:"cursor,omitempty"`
+	OperationsChecked  int                 `json:"operations_checked"`
+	SubmissionsChecked int                 `json:"submissions_checked"`
+	BalancesChecked    bool                `json:"balances_checked"`
+	DiscrepancyCount   int                 `json:"discrepancy_count"`
+	Discrepancies      []DiscrepancyOutput `json:"discrepancies,omitempty"`
+	Error              string              `json:"error,omitempty"`
+	StartedAt          string              `json:"started_at"`
+	FinishedAt         string              `json:"finished_at"`
+}
+
+// DiscrepancyOutput represents one difference between our records and the ledger
+type DiscrepancyOutput struct {
+	Kind      string `json:"kind"`
+	Record    string `json:"record"`
+	Reference string `json:"reference"`
+	Field     string `json:"field,omitempty"`
+	Expected  string `json:"expected,omitempty"`
+	Actual    string `json:"actual,omitempty"`
+}
+
+func toReportOutput(r *reconciliation.Report) ReportOutput {
+	output := ReportOutput{
+		ID:                 r.ID.String(),
+		WalletID:           r.WalletID.String(),
+		Status:             string(r.Status),
+		WindowStart:        r.WindowStart.UTC().Format(time.RFC3339),
+		Cursor:             r.Cursor,
+		OperationsChecked:  r.OperationsChecked,
+		SubmissionsChecked: r.SubmissionsChecked,
+		BalancesChecked:    r.BalancesChecked,
+		DiscrepancyCount:   r.DiscrepancyCount,
+		Error:              r.Error,
+		StartedAt:          r.StartedAt.UTC().Format(time.RFC3339),
+		FinishedAt:         r.FinishedAt.UTC().Format(time.RFC3339),
+	}
+
+	if r.Discrepancies != nil {
+		output.Discrepancies = make([]DiscrepancyOutput, 0, len(r.Discrepancies))
+		for _, d := range r.Discrepancies {
+			output.Discrepancies = append(output.Discrepancies, DiscrepancyOutput{
+				Kind:      d.Kind,
+				Record:    d.Record,
+				Reference: d.Reference,
+				Field:     d.Field,
+				Expected:  d.Expected,
+				Actual:    d.Actual,
+			})
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,457 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/ledger"
+	"quasarflow-api/internal/domain/reconciliation"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/internal/infrastructure/stellar"
+	"quasarflow-api/internal/usecase/balance"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+// pageSize is how many operations are read per request from Horizon and the index
+const pageSize = 200
+
+// Config controls what a reconciliation run compares
+type Config struct {
+	Network string        // Only active wallets on this network are reconciled by the job
+	Window  time.Duration // Operations and submissions this recent are compared
+}
+
+// ReconcileOutput summarizes one run of the reconciliation job
+type ReconcileOutput struct {
+	Clean         int
+	Discrepancies int
+	Failed        int
+}
+
+// ReconcileUseCase compares each wallet's stored records against Horizon: indexed
+// operations and transactions, submissions we recorded as settled, and the balances
+// derived from the index. Every run stores a report; discrepancies are logged and
+// published as reconciliation.discrepancy events, which reach subscribed webhooks.
+type ReconcileUseCase struct {
+	wallets       wallet.Repository
+	index         ledger.Repository
+	submissions   submission.Repository
+	reports       reconciliation.Repository
+	history       *stellar.HistoryReader
+	horizonClient *horizonclient.Client
+	publisher     event.Publisher
+	config        Config
+	logger        logger.Logger
+}
+
+// NewReconcileUseCase creates a new reconcile use case
+func NewReconcileUseCase(
+	wallets wallet.Repository,
+	index ledger.Repository,
+	submissions submission.Repository,
+	reports reconciliation.Repository,
+	horizonClient *horizonclient.Client,
+	publisher event.Publisher,
+	config Config,
+	logger logger.Logger,
+) *ReconcileUseCase {
+	return &ReconcileUseCase{
+		wallets:       wallets,
+		index:         index,
+		submissions:   submissions,
+		reports:       reports,
+		history:       stellar.NewHistoryReader(horizonClient),
+		horizonClient: horizonClient,
+		publisher:     publisher,
+		config:        config,
+		logger:        logger,
+	}
+}
+
+// Execute reconciles every active wallet on the network. A failing wallet gets a failed
+// report and does not stop the others.
+func (uc *ReconcileUseCase) Execute(ctx context.Context) (*ReconcileOutput, error) {
+	wallets, err := uc.wallets.ListActive(ctx, uc.config.Network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	output := &ReconcileOutput{}
+	for _, w := range wallets {
+		if ctx.Err() != nil {
+			return output, ctx.Err()
+		}
+
+		report, err := uc.reconcile(ctx, w)
+		if err != nil {
+			return output, err
+		}
+
+		switch report.Status {
+		case reconciliation.StatusClean:
+			output.Clean++
+		case reconciliation.StatusDiscrepancies:
+			output.Discrepancies++
+		default:
+			output.Failed++
+		}
+	}
+
+	uc.logger.Info("wallets reconciled",
+		logger.Int("clean", output.Clean),
+		logger.Int("discrepancies", output.Discrepancies),
+		logger.Int("failed", output.Failed))
+
+	return output, nil
+}
+
+// ReconcileWallet reconciles one wallet on demand and returns its report
+func (uc *ReconcileUseCase) ReconcileWallet(ctx context.Context, walletID uuid.UUID) (*ReportOutput, error) {
+	w, err := uc.wallets.FindByID(ctx, walletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Wallet not found")
+	}
+
+	report, err := uc.reconcile(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	output := toReportOutput(report)
+	return &output, nil
+}
+
+// reconcile compares the wallet's records, stores the report and raises alerts. Only
+// failing to store the report is returned as an error; comparison errors fail the report.
+func (uc *ReconcileUseCase) reconcile(ctx context.Context, w *wallet.Wallet) (*reconciliation.Report, error) {
+	report := reconciliation.NewReport(w.ID, time.Now().Add(-uc.config.Window).UTC())
+	report.Finish(uc.compare(ctx, w, report))
+
+	if err := uc.reports.Save(ctx, report); err != nil {
+		return nil, err
+	}
+
+	uc.alert(ctx, w, report)
+	return report, nil
+}
+
+func (uc *ReconcileUseCase) compare(ctx context.Context, w *wallet.Wallet, report *reconciliation.Report) error {
+	state, err := uc.index.GetState(ctx, w.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get index state: %w", err)
+	}
+
+	if state != nil && state.Cursor != "" {
+		report.Cursor = state.Cursor
+		cursor, err := strconv.ParseInt(state.Cursor, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid index cursor %q: %w", state.Cursor, err)
+		}
+
+		if err := uc.compareOperations(ctx, w, report, cursor); err != nil {
+			return err
+		}
+		if state.BackfilledAt != nil {
+			if err := uc.compareBalances(ctx, w, report); err != nil {
+				return err
+			}
+		}
+	}
+
+	return uc.compareSubmissions(ctx, w, report)
+}
+
+// compareOperations compares the operations in the window up to the index cursor, and
+// their transactions. Operations after the cursor are not indexed yet and are skipped.
+func (uc *ReconcileUseCase) compareOperations(ctx context.Context, w *wallet.Wallet, report *reconciliation.Report, cursor int64) error {
+	chainOps, chainTxs, err := uc.chainOperations(w, report.WindowStart, cursor)
+	if err != nil {
+		return err
+	}
+
+	indexedOps, err := uc.indexedOperations(ctx, w, report.WindowStart, cursor)
+	if err != nil {
+		return err
+	}
+
+	// Transactions are compared when both sides have them; the rest show up as operations
+	hashes := make([]string, 0, len(chainTxs))
+	seen := make(map[string]bool)
+	for _, op := range indexedOps {
+		if _, ok := chainTxs[op.TransactionHash]; ok && !seen[op.TransactionHash] {
+			seen[op.TransactionHash] = true
+			hashes = append(hashes, op.TransactionHash)
+		}
+	}
+	indexedTxs, err := uc.index.FindTransactions(ctx, hashes)
+	if err != nil {
+		return fmt.Errorf("failed to find indexed transactions: %w", err)
+	}
+
+	ids := make([]string, 0, len(chainOps)+len(indexedOps))
+	for id := range chainOps {
+		ids = append(ids, id)
+	}
+	for id := range indexedOps {
+		if _, ok := chainOps[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		chain, indexed := chainOps[id], indexedOps[id]
+		switch {
+		case indexed == nil:
+			report.Add(reconciliation.KindMissing, reconciliation.RecordOperation, id, "", chain.TransactionHash, "")
+		case chain == nil:
+			report.Add(reconciliation.KindUnexpected, reconciliation.RecordOperation, id, "", "", indexed.TransactionHash)
+		default:
+			mismatch := func(field, expected, actual string) {
+				if expected != actual {
+					report.Add(reconciliation.KindMismatched, reconciliation.RecordOperation, id, field, expected, actual)
+				}
+			}
+			mismatch("transaction_hash", chain.TransactionHash, indexed.TransactionHash)
+			mismatch("type", chain.Type, indexed.Type)
+			mismatch("successful", strconv.FormatBool(chain.Successful), strconv.FormatBool(indexed.Successful))
+		}
+	}
+
+	sort.Slice(indexedTxs, func(i, j int) bool { return indexedTxs[i].Hash < indexedTxs[j].Hash })
+	for _, indexed := range indexedTxs {
+		chain := chainTxs[indexed.Hash]
+		mismatch := func(field, expected, actual string) {
+			if expected != actual {
+				report.Add(reconciliation.KindMismatched, reconciliation.RecordTransaction, indexed.Hash, field, expected, actual)
+			}
+		}
+		mismatch("ledger", strconv.Itoa(int(chain.Ledger)), strconv.Itoa(int(indexed.Ledger)))
+		mismatch("source_account", chain.SourceAccount, indexed.SourceAccount)
+		mismatch("successful", strconv.FormatBool(chain.Successful), strconv.FormatBool(indexed.Successful))
+		mismatch("fee_charged", strconv.FormatInt(chain.FeeCharged, 10), strconv.FormatInt(indexed.FeeCharged, 10))
+	}
+
+	report.OperationsChecked = len(ids)
+	return nil
+}
+
+// chainOperations reads the account's operations closed since start up to cursor from
+// Horizon, newest first, by ID, with their transactions by hash
+func (uc *ReconcileUseCase) chainOperations(w *wallet.Wallet, start time.Time, cursor int64) (map[string]*ledger.Operation, map[string]*ledger.Transaction, error) {
+	ops := make(map[string]*ledger.Operation)
+	txs := make(map[string]*ledger.Transaction)
+
+	// Paging tokens are operation IDs, so a descending page from cursor+1 starts at cursor
+	token := strconv.FormatInt(cursor+1, 10)
+	for {
+		page, pageTxs, err := uc.history.Headers(w.PublicKey, token, pageSize, horizonclient.OrderDesc)
+		if err != nil {
+			if horizonclient.IsNotFoundError(err) {
+				return ops, txs, nil
+			}
+			return nil, nil, fmt.Errorf("failed to read operations from horizon: %w", err)
+		}
+
+		for _, tx := range pageTxs {
+			txs[tx.Hash] = tx
+		}
+		for _, op := range page {
+			if op.ClosedAt.Before(start) {
+				return ops, txs, nil
+			}
+			ops[op.ID] = op
+		}
+
+		if len(page) < pageSize {
+			return ops, txs, nil
+		}
+		token = page[len(page)-1].ID
+	}
+}
+
+// indexedOperations reads the wallet's indexed operations closed since start up to cursor, by ID
+func (uc *ReconcileUseCase) indexedOperations(ctx context.Context, w *wallet.Wallet, start time.Time, cursor int64) (map[string]*ledger.Operation, error) {
+	ops := make(map[string]*ledger.Operation)
+	filter := ledger.Filter{
+		WalletID: w.ID,
+		From:     &start,
+		After:    cursor + 1,
+		Order:    ledger.OrderDesc,
+		Limit:    pageSize,
+	}
+
+	for {
+		page, err := uc.index.ListOperations(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list indexed operations: %w", err)
+		}
+
+		for _, op := range page {
+			ops[op.ID] = op
+		}
+
+		if len(page) < pageSize {
+			return ops, nil
+		}
+		filter.After = page[len(page)-1].Position
+	}
+}
+
+// compareBalances compares the balances derived from the index with the account's
+// balances on Horizon. It is skipped while the account has changed after the last
+// indexed operation, since the index has not caught up with those changes yet.
+func (uc *ReconcileUseCase) compareBalances(ctx context.Context, w *wallet.Wallet, report *reconciliation.Report) error {
+	account, err := uc.horizonClient.AccountDetail(horizonclient.AccountRequest{AccountID: w.PublicKey})
+	if err != nil {
+		if horizonclient.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to load account: %w", err)
+	}
+
+	last, err := uc.index.ListOperations(ctx, ledger.Filter{WalletID: w.ID, Order: ledger.OrderDesc, Limit: 1})
+	if err != nil {
+		return fmt.Errorf("failed to list indexed operations: %w", err)
+	}
+	if len(last) == 0 {
+		return nil
+	}
+	txs, err := uc.index.FindTransactions(ctx, []string{last[0].TransactionHash})
+	if err != nil {
+		return fmt.Errorf("failed to find indexed transactions: %w", err)
+	}
+	if len(txs) == 0 || int64(txs[0].Ledger) < int64(account.LastModifiedLedger) {
+		return nil
+	}
+
+	derived, err := balance.DeriveBalances(ctx, uc.index, w, time.Now().Add(time.Microsecond))
+	if err != nil {
+		return err
+	}
+
+	live := make(map[string]decimal.Decimal, len(account.Balances))
+	for _, b := range account.Balances {
+		amount, err := decimal.NewFromString(b.Balance)
+		if err != nil {
+			return fmt.Errorf("invalid balance %q: %w", b.Balance, err)
+		}
+		live[balance.AssetKey(b.Asset.Type, b.Asset.Code, b.Asset.Issuer, b.LiquidityPoolId)] = amount
+	}
+
+	assets := make([]string, 0, len(live)+len(derived))
+	for asset := range live {
+		assets = append(assets, asset)
+	}
+	for asset := range derived {
+		if _, ok := live[asset]; !ok {
+			assets = append(assets, asset)
+		}
+	}
+	sort.Strings(assets)
+
+	for _, asset := range assets {
+		if expected, actual := live[asset], derived[asset]; !expected.Equal(actual) {
+			report.Add(reconciliation.KindMismatched, reconciliation.RecordBalance, asset, "balance",
+				expected.StringFixed(domainStellar.AmountDecimals), actual.StringFixed(domainStellar.AmountDecimals))
+		}
+	}
+
+	report.BalancesChecked = true
+	return nil
+}
+
+// compareSubmissions checks the outcome recorded for each settled submission in the
+// window against the transaction on the ledger
+func (uc *ReconcileUseCase) compareSubmissions(ctx context.Context, w *wallet.Wallet, report *reconciliation.Report) error {
+	submissions, err := uc.submissions.ListSettledSince(ctx, w.ID, report.WindowStart)
+	if err != nil {
+		return fmt.Errorf("failed to list submissions: %w", err)
+	}
+
+	for _, s := range submissions {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if s.Hash == "" {
+			continue
+		}
+		report.SubmissionsChecked++
+
+		tx, err := uc.horizonClient.TransactionDetail(s.Hash)
+		if err != nil {
+			if !horizonclient.IsNotFoundError(err) {
+				return fmt.Errorf("failed to load transaction %s: %w", s.Hash, err)
+			}
+			// Failed and expired submissions may never have reached a ledger
+			if s.Status == submission.StatusSuccess {
+				report.Add(reconciliation.KindUnexpected, reconciliation.RecordSubmission, s.ID.String(), "", "", s.Hash)
+			}
+			continue
+		}
+
+		onChain := submission.StatusFailed
+		if tx.Successful {
+			onChain = submission.StatusSuccess
+		}
+		if s.Status != onChain {
+			report.Add(reconciliation.KindMismatched, reconciliation.RecordSubmission, s.ID.String(), "status", string(onChain), string(s.Status))
+		}
+		if s.Ledger != 0 && s.Ledger != tx.Ledger {
+			report.Add(reconciliation.KindMismatched, reconciliation.RecordSubmission, s.ID.String(), "ledger",
+				strconv.Itoa(int(tx.Ledger)), strconv.Itoa(int(s.Ledger)))
+		}
+	}
+
+	return nil
+}
+
+// alert logs the report's outcome and publishes an event when it found discrepancies
+func (uc *ReconcileUseCase) alert(ctx context.Context, w *wallet.Wallet, report *reconciliation.Report) {
+	switch report.Status {
+	case reconciliation.StatusFailed:
+		uc.logger.Error("wallet reconciliation failed",
+			logger.String("wallet_id", w.ID.String()),
+			logger.String("report_id", report.ID.String()),
+			logger.String("error", report.Error))
+		return
+	case reconciliation.StatusClean:
+		return
+	}
+
+	missing := report.Count(reconciliation.KindMissing)
+	unexpected := report.Count(reconciliation.KindUnexpected)
+	mismatched := report.Count(reconciliation.KindMismatched)
+
+	uc.logger.Warn("wallet records differ from the ledger",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("report_id", report.ID.String()),
+		logger.Int("missing", missing),
+		logger.Int("unexpected", unexpected),
+		logger.Int("mismatched", mismatched))
+
+	e := event.New(event.TypeReconciliationDiscrepancy, w.ID, report.ID.String())
+	e.PublicKey = w.PublicKey
+	e.Reconciliation = &event.Reconciliation{
+		ReportID:   report.ID,
+		Missing:    missing,
+		Unexpected: unexpected,
+		Mismatched: mismatched,
+	}
+	if err := uc.publisher.Publish(ctx, e); err != nil {
+		uc.logger.Error("failed to publish reconciliation event",
+			logger.String("report_id", report.ID.String()),
+			logger.Error(err))
+	}
+}
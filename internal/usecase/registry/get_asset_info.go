@@ -0,0 +1,108 @@
+package registry
+
+import (
+	"context"
+	"time"
+
+	"quasarflow-api/internal/domain/registry"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+)
+
+const nativeAssetCode = "XLM"
+
+// AnchorOutput describes the off-chain asset a token is anchored to
+type AnchorOutput struct {
+	AssetType string `json:"asset_type,omitempty"`
+	Asset     string `json:"asset,omitempty"`
+}
+
+// AssetInfoOutput is the registry view of an asset
+type AssetInfoOutput struct {
+	Code            string        `json:"code"`
+	Issuer          string        `json:"issuer,omitempty"`
+	HomeDomain      string        `json:"home_domain,omitempty"`
+	Name            string        `json:"name,omitempty"`
+	Description     string        `json:"description,omitempty"`
+	Image           string        `json:"image,omitempty"`
+	DisplayDecimals int           `json:"display_decimals"`
+	Status          string        `json:"status,omitempty"`
+	OrgName         string        `json:"org_name,omitempty"`
+	Anchor          *AnchorOutput `json:"anchor,omitempty"`
+	Listed          bool          `json:"listed"`   // Published in the issuer's stellar.toml
+	Verified        bool          `json:"verified"` // On the admin allow-list
+	FetchedAt       string        `json:"fetched_at,omitempty"`
+}
+
+// GetAssetInfoUseCase combines SEP-1 metadata with the admin allow-list
+type GetAssetInfoUseCase struct {
+	resolver registry.MetadataResolver
+	repo     registry.Repository
+	logger   logger.Logger
+}
+
+// NewGetAssetInfoUseCase creates a new get asset info use case
+func NewGetAssetInfoUseCase(resolver registry.MetadataResolver, repo registry.Repository, logger logger.Logger) *GetAssetInfoUseCase {
+	return &GetAssetInfoUseCase{
+		resolver: resolver,
+		repo:     repo,
+		logger:   logger,
+	}
+}
+
+// Execute returns registry information for an asset. XLM (empty issuer) is always verified.
+func (uc *GetAssetInfoUseCase) Execute(ctx context.Context, code, issuer string) (*AssetInfoOutput, error) {
+	if issuer == "" {
+		if code != "" && code != nativeAssetCode {
+			return nil, errors.ErrInvalidIssuer
+		}
+		return &AssetInfoOutput{
+			Code:            nativeAssetCode,
+			Name:            "Stellar Lumens",
+			DisplayDecimals: 7,
+			Listed:          true,
+			Verified:        true,
+		}, nil
+	}
+
+	if _, err := registry.NewVerifiedAsset(code, issuer, ""); err != nil {
+		return nil, errors.NewValidationError("Invalid asset", err.Error())
+	}
+
+	metadata, err := uc.resolver.Resolve(ctx, code, issuer)
+	if err != nil {
+		uc.logger.Warn("failed to resolve asset metadata",
+			logger.Error(err),
+			logger.String("code", code),
+			logger.String("issuer", issuer))
+		return nil, err
+	}
+
+	verified, err := uc.repo.IsVerified(ctx, code, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &AssetInfoOutput{
+		Code:            metadata.Code,
+		Issuer:          metadata.Issuer,
+		HomeDomain:      metadata.HomeDomain,
+		Name:            metadata.Name,
+		Description:     metadata.Description,
+		Image:           metadata.Image,
+		DisplayDecimals: metadata.DisplayDecimals,
+		Status:          metadata.Status,
+		OrgName:         metadata.OrgName,
+		Listed:          metadata.Listed,
+		Verified:        verified,
+		FetchedAt:       metadata.FetchedAt.Format(time.RFC3339),
+	}
+	if metadata.IsAssetAnchored || metadata.AnchorAsset != "" {
+		output.Anchor = &AnchorOutput{
+			AssetType: metadata.AnchorAssetType,
+			Asset:     metadata.AnchorAsset,
+		}
+	}
+
+	return output, nil
+}
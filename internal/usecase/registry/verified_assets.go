@@ -0,0 +1,102 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/registry"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+)
+
+// VerifyAssetInput represents a request to add an asset to the allow-list
+type VerifyAssetInput struct {
+	Code   string `json:"code" validate:"required"`
+	Issuer string `json:"issuer" validate:"required"`
+	Note   string `json:"note,omitempty"`
+}
+
+// VerifiedAssetOutput represents an allow-list entry
+type VerifiedAssetOutput struct {
+	Code      string `json:"code"`
+	Issuer    string `json:"issuer"`
+	Note      string `json:"note,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ListVerifiedAssetsOutput represents the full allow-list
+type ListVerifiedAssetsOutput struct {
+	Assets []VerifiedAssetOutput `json:"assets"`
+}
+
+// ManageVerifiedAssetsUseCase maintains the admin allow-list of verified assets
+type ManageVerifiedAssetsUseCase struct {
+	repo   registry.Repository
+	logger logger.Logger
+}
+
+// NewManageVerifiedAssetsUseCase creates a new manage verified assets use case
+func NewManageVerifiedAssetsUseCase(repo registry.Repository, logger logger.Logger) *ManageVerifiedAssetsUseCase {
+	return &ManageVerifiedAssetsUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Add places an asset on the allow-list, updating its note if already present
+func (uc *ManageVerifiedAssetsUseCase) Add(ctx context.Context, input VerifyAssetInput) (*VerifiedAssetOutput, error) {
+	a, err := registry.NewVerifiedAsset(input.Code, input.Issuer, input.Note)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid asset", err.Error())
+	}
+
+	if err := uc.repo.Add(ctx, a); err != nil {
+		uc.logger.Error("failed to add verified asset", logger.Error(err))
+		return nil, fmt.Errorf("failed to add verified asset: %w", err)
+	}
+
+	uc.logger.Info("asset verified",
+		logger.String("code", a.Code),
+		logger.String("issuer", a.Issuer))
+
+	output := toVerifiedAssetOutput(a)
+	return &output, nil
+}
+
+// Remove takes an asset off the allow-list
+func (uc *ManageVerifiedAssetsUseCase) Remove(ctx context.Context, code, issuer string) error {
+	if err := uc.repo.Remove(ctx, code, issuer); err != nil {
+		return errors.NewNotFoundError("Verified asset not found")
+	}
+
+	uc.logger.Info("asset verification removed",
+		logger.String("code", code),
+		logger.String("issuer", issuer))
+
+	return nil
+}
+
+// List returns the allow-list
+func (uc *ManageVerifiedAssetsUseCase) List(ctx context.Context) (*ListVerifiedAssetsOutput, error) {
+	assets, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list verified assets: %w", err)
+	}
+
+	items := make([]VerifiedAssetOutput, 0, len(assets))
+	for _, a := range assets {
+		items = append(items, toVerifiedAssetOutput(a))
+	}
+
+	return &ListVerifiedAssetsOutput{Assets: items}, nil
+}
+
+func toVerifiedAssetOutput(a *registry.VerifiedAsset) VerifiedAssetOutput {
+	return VerifiedAssetOutput{
+		Code:      a.Code,
+		Issuer:    a.Issuer,
+		Note:      a.Note,
+		CreatedAt: a.CreatedAt.Format(time.RFC3339),
+	}
+}
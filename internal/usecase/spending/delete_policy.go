@@ -0,0 +1,35 @@
+package spending
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/spending"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// DeletePolicyUseCase removes a spending policy; recorded spends are kept
+type DeletePolicyUseCase struct {
+	policies spending.Repository
+	logger   logger.Logger
+}
+
+// NewDeletePolicyUseCase creates a new delete policy use case
+func NewDeletePolicyUseCase(policies spending.Repository, logger logger.Logger) *DeletePolicyUseCase {
+	return &DeletePolicyUseCase{
+		policies: policies,
+		logger:   logger,
+	}
+}
+
+// Execute deletes a policy by ID
+func (uc *DeletePolicyUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	if err := uc.policies.DeletePolicy(ctx, id); err != nil {
+		return errors.NewNotFoundError("Spending policy not found")
+	}
+
+	uc.logger.Info("spending policy deleted", logger.String("policy_id", id.String()))
+	return nil
+}
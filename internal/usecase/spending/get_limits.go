@@ -0,0 +1,141 @@
+package spending
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/spending"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LimitsOutput lists the policies that apply to payments from a wallet by the caller,
+// with what remains of each
+type LimitsOutput struct {
+	WalletID string            `json:"wallet_id"`
+	UserID   string            `json:"user_id,omitempty"`
+	Limits   []AllowanceOutput `json:"limits"`
+}
+
+// AllowanceOutput is what remains of a policy's limits. Sections of unset limits are omitted.
+type AllowanceOutput struct {
+	Policy     PolicyOutput     `json:"policy"`
+	MaxPayment string           `json:"max_payment,omitempty"` // Largest payment the amount limits allow now
+	Daily      *AmountAllowance `json:"daily,omitempty"`
+	Monthly    *AmountAllowance `json:"monthly,omitempty"`
+	Count      *CountAllowance  `json:"count,omitempty"`
+}
+
+// AmountAllowance is the use of a daily or monthly total
+type AmountAllowance struct {
+	Limit     string `json:"limit"`
+	Used      string `json:"used"`
+	Remaining string `json:"remaining"`
+	ResetsAt  string `json:"resets_at"`
+}
+
+// CountAllowance is the use of a payment count over its rolling window
+type CountAllowance struct {
+	Limit     int    `json:"limit"`
+	Used      int    `json:"used"`
+	Remaining int    `json:"remaining"`
+	Window    string `json:"window"`
+}
+
+// GetLimitsUseCase reports the remaining allowance of a wallet and the caller
+type GetLimitsUseCase struct {
+	policies spending.Repository
+	wallets  wallet.Repository
+}
+
+// NewGetLimitsUseCase creates a new get limits use case
+func NewGetLimitsUseCase(policies spending.Repository, wallets wallet.Repository) *GetLimitsUseCase {
+	return &GetLimitsUseCase{
+		policies: policies,
+		wallets:  wallets,
+	}
+}
+
+// Execute returns the wallet's policies and the user's, each with its current usage
+func (uc *GetLimitsUseCase) Execute(ctx context.Context, walletID uuid.UUID, userID string) (*LimitsOutput, error) {
+	if _, err := uc.wallets.FindByID(ctx, walletID); err != nil {
+		return nil, errors.NewNotFoundError("Wallet not found")
+	}
+
+	policies, err := uc.policies.ListApplicable(ctx, walletID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list spending policies: %w", err)
+	}
+
+	now := time.Now().UTC()
+	limits := make([]AllowanceOutput, 0, len(policies))
+	for _, p := range policies {
+		usage, err := uc.policies.Usage(ctx, p, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get spending usage: %w", err)
+		}
+		limits = append(limits, toAllowanceOutput(p, usage, now))
+	}
+
+	return &LimitsOutput{
+		WalletID: walletID.String(),
+		UserID:   userID,
+		Limits:   limits,
+	}, nil
+}
+
+func toAllowanceOutput(p *spending.Policy, usage spending.Usage, now time.Time) AllowanceOutput {
+	output := AllowanceOutput{Policy: toPolicyOutput(p)}
+	day, month, _ := p.Periods(now)
+
+	var max *decimal.Decimal
+	lower := func(amount decimal.Decimal) {
+		amount = decimal.Max(amount, decimal.Zero)
+		if max == nil || amount.LessThan(*max) {
+			max = &amount
+		}
+	}
+	amountAllowance := func(limit, used decimal.Decimal, resetsAt time.Time) *AmountAllowance {
+		remaining := decimal.Max(limit.Sub(used), decimal.Zero)
+		lower(remaining)
+		return &AmountAllowance{
+			Limit:     formatLimit(limit),
+			Used:      used.StringFixed(domainStellar.AmountDecimals),
+			Remaining: remaining.StringFixed(domainStellar.AmountDecimals),
+			ResetsAt:  resetsAt.Format(time.RFC3339),
+		}
+	}
+
+	if !p.MaxPerTransaction.IsZero() {
+		lower(p.MaxPerTransaction)
+	}
+	if !p.DailyLimit.IsZero() {
+		output.Daily = amountAllowance(p.DailyLimit, usage.Daily, day.AddDate(0, 0, 1))
+	}
+	if !p.MonthlyLimit.IsZero() {
+		output.Monthly = amountAllowance(p.MonthlyLimit, usage.Monthly, month.AddDate(0, 1, 0))
+	}
+	if max != nil {
+		output.MaxPayment = max.StringFixed(domainStellar.AmountDecimals)
+	}
+
+	if p.MaxCount > 0 {
+		remaining := p.MaxCount - usage.Count
+		if remaining < 0 {
+			remaining = 0
+		}
+		output.Count = &CountAllowance{
+			Limit:     p.MaxCount,
+			Used:      usage.Count,
+			Remaining: remaining,
+			Window:    p.CountWindow.String(),
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,38 @@
+package spending
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/spending"
+	"quasarflow-api/pkg/errors"
+)
+
+// ListPoliciesUseCase lists the spending policies of a scope
+type ListPoliciesUseCase struct {
+	policies spending.Repository
+}
+
+// NewListPoliciesUseCase creates a new list policies use case
+func NewListPoliciesUseCase(policies spending.Repository) *ListPoliciesUseCase {
+	return &ListPoliciesUseCase{policies: policies}
+}
+
+// Execute lists the policies of a scope, optionally of one wallet or user
+func (uc *ListPoliciesUseCase) Execute(ctx context.Context, scope, subjectID string) ([]PolicyOutput, error) {
+	if scope != spending.ScopeWallet && scope != spending.ScopeUser {
+		return nil, errors.NewValidationError("Invalid scope", "scope must be wallet or user")
+	}
+
+	policies, err := uc.policies.ListPolicies(ctx, scope, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list spending policies: %w", err)
+	}
+
+	items := make([]PolicyOutput, 0, len(policies))
+	for _, p := range policies {
+		items = append(items, toPolicyOutput(p))
+	}
+
+	return items, nil
+}
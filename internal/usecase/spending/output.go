@@ -0,0 +1,53 @@
+package spending
+
+import (
+	"time"
+
+	"quasarflow-api/internal/domain/spending"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+
+	"github.com/shopspring/decimal"
+)
+
+// PolicyOutput represents a spending policy. Unset limits are omitted.
+type PolicyOutput struct {
+	ID                string `json:"id"`
+	Scope             string `json:"scope"`
+	SubjectID         string `json:"subject_id"`
+	Asset             string `json:"asset,omitempty"`
+	MaxPerTransaction string `json:"max_per_transaction,omitempty"`
+	DailyLimit        string `json:"daily_limit,omitempty"`
+	MonthlyLimit      string `json:"monthly_limit,omitempty"`
+	MaxCount          int    `json:"max_count,omitempty"`
+	CountWindow       string `json:"count_window,omitempty"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+}
+
+func toPolicyOutput(p *spending.Policy) PolicyOutput {
+	output := PolicyOutput{
+		ID:                p.ID.String(),
+		Scope:             p.Scope,
+		SubjectID:         p.SubjectID,
+		Asset:             p.Asset,
+		MaxPerTransaction: formatLimit(p.MaxPerTransaction),
+		DailyLimit:        formatLimit(p.DailyLimit),
+		MonthlyLimit:      formatLimit(p.MonthlyLimit),
+		MaxCount:          p.MaxCount,
+		CreatedAt:         p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         p.UpdatedAt.Format(time.RFC3339),
+	}
+	if p.MaxCount > 0 {
+		output.CountWindow = p.CountWindow.String()
+	}
+
+	return output
+}
+
+// formatLimit formats a limit amount, empty when the limit is unset
+func formatLimit(amount decimal.Decimal) string {
+	if amount.IsZero() {
+		return ""
+	}
+	return amount.StringFixed(domainStellar.AmountDecimals)
+}
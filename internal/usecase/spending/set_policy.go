@@ -0,0 +1,123 @@
+package spending
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"quasarflow-api/internal/domain/spending"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SetPolicyInput represents the limits of a wallet or user for one asset. Omitted
+// limits are unset.
+type SetPolicyInput struct {
+	Scope             string `json:"scope"`                         // wallet or user
+	SubjectID         string `json:"subject_id"`                    // Wallet ID or user ID
+	Asset             string `json:"asset,omitempty"`               // native or CODE:ISSUER; omitted for count limits across assets
+	MaxPerTransaction string `json:"max_per_transaction,omitempty"` // Largest single payment
+	DailyLimit        string `json:"daily_limit,omitempty"`         // Total per UTC day
+	MonthlyLimit      string `json:"monthly_limit,omitempty"`       // Total per UTC calendar month
+	MaxCount          int    `json:"max_count,omitempty"`           // Payments per count_window
+	CountWindow       string `json:"count_window,omitempty"`        // Go duration, e.g. "1h"
+}
+
+// SetPolicyUseCase creates or replaces the spending policy of a wallet or user for an asset
+type SetPolicyUseCase struct {
+	policies spending.Repository
+	wallets  wallet.Repository
+	logger   logger.Logger
+}
+
+// NewSetPolicyUseCase creates a new set policy use case
+func NewSetPolicyUseCase(policies spending.Repository, wallets wallet.Repository, logger logger.Logger) *SetPolicyUseCase {
+	return &SetPolicyUseCase{
+		policies: policies,
+		wallets:  wallets,
+		logger:   logger,
+	}
+}
+
+// Execute validates and saves the policy; it replaces any policy with the same scope,
+// subject and asset
+func (uc *SetPolicyUseCase) Execute(ctx context.Context, input SetPolicyInput) (*PolicyOutput, error) {
+	asset, err := parseAsset(input.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	var amounts [3]decimal.Decimal
+	for i, value := range []string{input.MaxPerTransaction, input.DailyLimit, input.MonthlyLimit} {
+		if value == "" {
+			continue
+		}
+		amounts[i], err = decimal.NewFromString(value)
+		if err != nil {
+			return nil, errors.ErrInvalidAmount
+		}
+	}
+
+	var window time.Duration
+	if input.CountWindow != "" {
+		window, err = time.ParseDuration(input.CountWindow)
+		if err != nil {
+			return nil, errors.NewValidationError("Invalid count window", "count_window must be a duration such as 1h or 24h")
+		}
+	}
+
+	if input.Scope == spending.ScopeWallet {
+		id, err := uuid.Parse(input.SubjectID)
+		if err != nil {
+			return nil, errors.NewValidationError("Invalid subject", "subject_id must be a wallet ID for wallet policies")
+		}
+		w, err := uc.wallets.FindByID(ctx, id)
+		if err != nil {
+			return nil, errors.NewNotFoundError("Wallet not found")
+		}
+		if w.IsClosed() {
+			return nil, errors.ErrWalletClosed
+		}
+	}
+
+	policy, err := spending.NewPolicy(input.Scope, input.SubjectID, asset, amounts[0], amounts[1], amounts[2], input.MaxCount, window)
+	if err != nil {
+		return nil, errors.NewValidationError("Invalid spending policy", err.Error())
+	}
+
+	if err := uc.policies.SavePolicy(ctx, policy); err != nil {
+		uc.logger.Error("failed to save spending policy", logger.Error(err))
+		return nil, fmt.Errorf("failed to save spending policy: %w", err)
+	}
+
+	uc.logger.Info("spending policy saved",
+		logger.String("policy_id", policy.ID.String()),
+		logger.String("scope", policy.Scope),
+		logger.String("subject_id", policy.SubjectID),
+		logger.String("asset", policy.Asset))
+
+	output := toPolicyOutput(policy)
+	return &output, nil
+}
+
+// parseAsset returns the canonical name of a policy asset, empty for every asset
+func parseAsset(asset string) (string, error) {
+	asset = strings.TrimSpace(asset)
+	switch {
+	case asset == "":
+		return "", nil
+	case strings.EqualFold(asset, "native"), strings.EqualFold(asset, "XLM"):
+		return "native", nil
+	}
+
+	parts := strings.Split(asset, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", errors.NewValidationError("Invalid asset", "asset must be native or CODE:ISSUER")
+	}
+	return asset, nil
+}
@@ -0,0 +1,33 @@
+package sponsorship
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/sponsorship"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetSponsorshipUseCase handles retrieving a tracked sponsorship by ID
+type GetSponsorshipUseCase struct {
+	sponsorships sponsorship.Repository
+}
+
+// NewGetSponsorshipUseCase creates a new get sponsorship use case
+func NewGetSponsorshipUseCase(sponsorships sponsorship.Repository) *GetSponsorshipUseCase {
+	return &GetSponsorshipUseCase{
+		sponsorships: sponsorships,
+	}
+}
+
+// Execute retrieves a sponsorship
+func (uc *GetSponsorshipUseCase) Execute(ctx context.Context, id uuid.UUID) (*SponsorshipOutput, error) {
+	s, err := uc.sponsorships.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.ErrSponsorshipNotFound
+	}
+
+	output := toSponsorshipOutput(s)
+	return &output, nil
+}
@@ -0,0 +1,91 @@
+package sponsorship
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/sponsorship"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ListSponsorshipsInput represents a request for a wallet's sponsorships
+type ListSponsorshipsInput struct {
+	WalletID uuid.UUID
+	Role     string // sponsor (default) or sponsored
+	Status   string // Optional filter
+	Limit    int
+	Offset   int
+}
+
+// ListSponsorshipsOutput represents a page of sponsorships
+type ListSponsorshipsOutput struct {
+	Sponsorships []SponsorshipOutput `json:"sponsorships"`
+	Total        int64               `json:"total"`
+	Limit        int                 `json:"limit"`
+	Offset       int                 `json:"offset"`
+}
+
+// ListSponsorshipsUseCase lists sponsorships a wallet pays for or benefits from
+type ListSponsorshipsUseCase struct {
+	sponsorships sponsorship.Repository
+}
+
+// NewListSponsorshipsUseCase creates a new list sponsorships use case
+func NewListSponsorshipsUseCase(sponsorships sponsorship.Repository) *ListSponsorshipsUseCase {
+	return &ListSponsorshipsUseCase{
+		sponsorships: sponsorships,
+	}
+}
+
+// Execute retrieves a paginated list of the wallet's sponsorships, newest first
+func (uc *ListSponsorshipsUseCase) Execute(ctx context.Context, input ListSponsorshipsInput) (*ListSponsorshipsOutput, error) {
+	role := input.Role
+	if role == "" {
+		role = sponsorship.RoleSponsor
+	}
+	if role != sponsorship.RoleSponsor && role != sponsorship.RoleSponsored {
+		return nil, errors.NewValidationError("Invalid role", "role must be sponsor or sponsored")
+	}
+
+	status := sponsorship.Status(input.Status)
+	switch status {
+	case "", sponsorship.StatusActive, sponsorship.StatusRevoked, sponsorship.StatusTransferred:
+	default:
+		return nil, errors.NewValidationError("Invalid status", "status must be active, revoked or transferred")
+	}
+
+	limit, offset := input.Limit, input.Offset
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	sponsorships, err := uc.sponsorships.ListByWallet(ctx, input.WalletID, role, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sponsorships: %w", err)
+	}
+
+	total, err := uc.sponsorships.CountByWallet(ctx, input.WalletID, role, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count sponsorships: %w", err)
+	}
+
+	items := make([]SponsorshipOutput, 0, len(sponsorships))
+	for _, s := range sponsorships {
+		items = append(items, toSponsorshipOutput(s))
+	}
+
+	return &ListSponsorshipsOutput{
+		Sponsorships: items,
+		Total:        total,
+		Limit:        limit,
+		Offset:       offset,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package sponsorship
+
+import (
+	"context"
+	"time"
+
+	"quasarflow-api/internal/domain/sponsorship"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+
+	"github.com/stellar/go/txnbuild"
+)
+
+// SponsorshipOutput represents a tracked sponsorship
+type SponsorshipOutput struct {
+	ID                string `json:"id"`
+	Type              string `json:"type"`
+	SponsorWalletID   string `json:"sponsor_wallet_id"`
+	Sponsor           string `json:"sponsor"`
+	SponsoredWalletID string `json:"sponsored_wallet_id"`
+	Sponsored         string `json:"sponsored"`
+	AssetCode         string `json:"asset_code,omitempty"`
+	AssetIssuer       string `json:"asset_issuer,omitempty"`
+	Status            string `json:"status"`
+	ReplacedByID      string `json:"replaced_by_id,omitempty"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+	EndedAt           string `json:"ended_at,omitempty"`
+}
+
+// SponsorshipOperationOutput is returned by every use case that changes a sponsorship on the ledger
+type SponsorshipOperationOutput struct {
+	Sponsorship     SponsorshipOutput `json:"sponsorship"`
+	Kind            string            `json:"kind"`
+	SubmissionID    string            `json:"submission_id"`
+	TransactionHash string            `json:"transaction_hash"`
+	Ledger          int32             `json:"ledger"`
+	FeeCharged      int64             `json:"fee_charged"`
+}
+
+func toSponsorshipOutput(s *sponsorship.Sponsorship) SponsorshipOutput {
+	output := SponsorshipOutput{
+		ID:                s.ID.String(),
+		Type:              s.Type,
+		SponsorWalletID:   s.SponsorWalletID.String(),
+		Sponsor:           s.SponsorPublicKey,
+		SponsoredWalletID: s.SponsoredWalletID.String(),
+		Sponsored:         s.SponsoredPublicKey,
+		AssetCode:         s.AssetCode,
+		AssetIssuer:       s.AssetIssuer,
+		Status:            string(s.Status),
+		CreatedAt:         s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         s.UpdatedAt.Format(time.RFC3339),
+	}
+	if s.ReplacedByID != nil {
+		output.ReplacedByID = s.ReplacedByID.String()
+	}
+	if s.EndedAt != nil {
+		output.EndedAt = s.EndedAt.Format(time.RFC3339)
+	}
+	return output
+}
+
+// submitSponsorship builds a transaction from source, signed also by coSigners, and submits it
+func submitSponsorship(
+	ctx context.Context,
+	txBuilder *walletUC.TransactionBuilder,
+	source *wallet.Wallet,
+	coSigners []*wallet.Wallet,
+	kind string,
+	ops []txnbuild.Operation,
+	maxFee int64,
+) (*walletUC.SubmitResult, error) {
+	signed, err := txBuilder.Build(ctx, walletUC.BuildTransactionParams{
+		Wallet:     source,
+		Kind:       kind,
+		Operations: ops,
+		MaxFee:     maxFee,
+		CoSigners:  coSigners,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txBuilder.Submit(ctx, signed)
+}
+
+func toOperationOutput(s *sponsorship.Sponsorship, kind string, resp *walletUC.SubmitResult) *SponsorshipOperationOutput {
+	return &SponsorshipOperationOutput{
+		Sponsorship:     toSponsorshipOutput(s),
+		Kind:            kind,
+		SubmissionID:    resp.SubmissionID.String(),
+		TransactionHash: resp.Hash,
+		Ledger:          resp.Ledger,
+		FeeCharged:      resp.FeeCharged,
+	}
+}
+
+// sponsoredEntry returns the RevokeSponsorship target for a tracked sponsorship
+func sponsoredEntry(s *sponsorship.Sponsorship) (*txnbuild.RevokeSponsorship, error) {
+	if s.Type == sponsorship.TypeAccount {
+		account := s.SponsoredPublicKey
+		return &txnbuild.RevokeSponsorship{
+			SponsorshipType: txnbuild.RevokeSponsorshipTypeAccount,
+			Account:         &account,
+		}, nil
+	}
+
+	line, err := txnbuild.CreditAsset{Code: s.AssetCode, Issuer: s.AssetIssuer}.ToTrustLineAsset()
+	if err != nil {
+		return nil, err
+	}
+	return &txnbuild.RevokeSponsorship{
+		SponsorshipType: txnbuild.RevokeSponsorshipTypeTrustLine,
+		TrustLine: &txnbuild.TrustLineID{
+			Account: s.SponsoredPublicKey,
+			Asset:   line,
+		},
+	}, nil
+}
@@ -0,0 +1,86 @@
+package sponsorship
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/sponsorship"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/txnbuild"
+)
+
+// RevokeInput represents a request to end a sponsorship
+type RevokeInput struct {
+	SponsorshipID uuid.UUID `json:"sponsorship_id"`
+	MaxFee        int64     `json:"max_fee,omitempty"`
+}
+
+// RevokeUseCase ends sponsorships; the sponsored account then pays the reserve itself
+type RevokeUseCase struct {
+	wallets      wallet.Repository
+	sponsorships sponsorship.Repository
+	txBuilder    *walletUC.TransactionBuilder
+	logger       logger.Logger
+}
+
+// NewRevokeUseCase creates a new revoke sponsorship use case
+func NewRevokeUseCase(
+	wallets wallet.Repository,
+	sponsorships sponsorship.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *RevokeUseCase {
+	return &RevokeUseCase{
+		wallets:      wallets,
+		sponsorships: sponsorships,
+		txBuilder:    txBuilder,
+		logger:       logger,
+	}
+}
+
+// Execute submits RevokeSponsorship from the sponsor. The ledger rejects it with
+// op_low_reserve when the sponsored account cannot cover the reserve on its own.
+func (uc *RevokeUseCase) Execute(ctx context.Context, input RevokeInput) (*SponsorshipOperationOutput, error) {
+	record, err := uc.sponsorships.FindByID(ctx, input.SponsorshipID)
+	if err != nil {
+		return nil, errors.ErrSponsorshipNotFound
+	}
+	if !record.IsActive() {
+		return nil, errors.ErrSponsorshipNotActive
+	}
+
+	sponsor, err := uc.wallets.FindByID(ctx, record.SponsorWalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Sponsor wallet not found")
+	}
+
+	op, err := sponsoredEntry(record)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := submitSponsorship(ctx, uc.txBuilder, sponsor, nil, submission.KindRevokeSponsorship, []txnbuild.Operation{op}, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	record.Revoke()
+	if err := uc.sponsorships.Update(ctx, record); err != nil {
+		uc.logger.Error("sponsorship revoked but not recorded",
+			logger.Error(err),
+			logger.String("sponsorship_id", record.ID.String()),
+			logger.String("hash", resp.Hash))
+	}
+
+	uc.logger.Info("sponsorship revoked",
+		logger.String("sponsorship_id", record.ID.String()),
+		logger.String("sponsor_wallet_id", sponsor.ID.String()),
+		logger.String("hash", resp.Hash))
+
+	return toOperationOutput(record, submission.KindRevokeSponsorship, resp), nil
+}
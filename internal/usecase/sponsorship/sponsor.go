@@ -0,0 +1,225 @@
+package sponsorship
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/spending"
+	"quasarflow-api/internal/domain/sponsorship"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+)
+
+// SponsorInput represents a request for a sponsor wallet to pay the reserve of a new ledger
+// entry of another managed wallet: its account when it is created, or a new trustline.
+type SponsorInput struct {
+	SponsorWalletID   uuid.UUID `json:"sponsor_wallet_id"`
+	Type              string    `json:"type" validate:"required,oneof=account trustline"`
+	SponsoredWalletID uuid.UUID `json:"sponsored_wallet_id" validate:"required"`
+	AssetCode         string    `json:"asset_code,omitempty"`       // Trustline only
+	AssetIssuer       string    `json:"asset_issuer,omitempty"`     // Trustline only
+	Limit             string    `json:"limit,omitempty"`            // Trustline only, defaults to the maximum limit
+	StartingBalance   string    `json:"starting_balance,omitempty"` // Account only, XLM sent by the sponsor, defaults to 0
+	MaxFee            int64     `json:"max_fee,omitempty"`
+	UserID            string    `json:"-"` // Authenticated caller, checked against the address book and spending limits
+}
+
+// SponsorUseCase creates sponsored accounts and trustlines
+type SponsorUseCase struct {
+	wallets       wallet.Repository
+	sponsorships  sponsorship.Repository
+	horizonClient *horizonclient.Client
+	txBuilder     *walletUC.TransactionBuilder
+	guard         *walletUC.OutflowGuard
+	logger        logger.Logger
+}
+
+// NewSponsorUseCase creates a new sponsor use case
+func NewSponsorUseCase(
+	wallets wallet.Repository,
+	sponsorships sponsorship.Repository,
+	horizonClient *horizonclient.Client,
+	txBuilder *walletUC.TransactionBuilder,
+	guard *walletUC.OutflowGuard,
+	logger logger.Logger,
+) *SponsorUseCase {
+	return &SponsorUseCase{
+		wallets:       wallets,
+		sponsorships:  sponsorships,
+		horizonClient: horizonClient,
+		txBuilder:     txBuilder,
+		guard:         guard,
+		logger:        logger,
+	}
+}
+
+// Execute wraps the account creation or ChangeTrust in BeginSponsoringFutureReserves and
+// EndSponsoringFutureReserves. The sponsor is the transaction source and pays the fee;
+// the sponsored wallet co-signs to accept the sponsorship.
+func (uc *SponsorUseCase) Execute(ctx context.Context, input SponsorInput) (*SponsorshipOperationOutput, error) {
+	// 1. Validate the sponsored entry
+	var line txnbuild.ChangeTrustAsset
+	limit := txnbuild.MaxTrustlineLimit
+	startingBalance := decimal.Zero
+	switch input.Type {
+	case sponsorship.TypeAccount:
+		if input.StartingBalance != "" {
+			amount, err := domainStellar.ParseAmount(input.StartingBalance)
+			if err != nil {
+				return nil, errors.ErrInvalidAmount
+			}
+			startingBalance = amount
+		}
+	case sponsorship.TypeTrustline:
+		if !domainStellar.IsValidAssetCode(input.AssetCode) {
+			return nil, errors.ErrInvalidAssetCode
+		}
+		if !strkey.IsValidEd25519PublicKey(input.AssetIssuer) {
+			return nil, errors.ErrInvalidIssuer
+		}
+		var err error
+		line, err = txnbuild.CreditAsset{Code: input.AssetCode, Issuer: input.AssetIssuer}.ToChangeTrustAsset()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build trustline asset: %w", err)
+		}
+		if input.Limit != "" {
+			if _, err := domainStellar.ParseAmount(input.Limit); err != nil {
+				return nil, errors.ErrInvalidTrustlineLimit
+			}
+			limit = input.Limit
+		}
+	default:
+		return nil, errors.ErrInvalidSponsorshipType
+	}
+
+	// 2. Load wallets
+	sponsor, err := uc.wallets.FindByID(ctx, input.SponsorWalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Sponsor wallet not found")
+	}
+
+	sponsored, err := uc.wallets.FindByID(ctx, input.SponsoredWalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Sponsored wallet not found")
+	}
+
+	if sponsor.Network != sponsored.Network {
+		return nil, errors.ErrSponsorshipWalletMismatch
+	}
+
+	record, err := sponsorship.NewSponsorship(input.Type, sponsor.ID, sponsor.PublicKey, sponsored.ID, sponsored.PublicKey, input.AssetCode, input.AssetIssuer)
+	if err != nil {
+		return nil, errors.ErrSponsorshipWalletMismatch
+	}
+
+	if _, err := uc.sponsorships.FindActive(ctx, record.Type, record.SponsoredPublicKey, record.AssetCode, record.AssetIssuer); err == nil {
+		return nil, errors.ErrSponsorshipExists
+	}
+
+	// 3. Check the entry does not exist yet; sponsorship applies to new entries only
+	account, err := uc.horizonClient.AccountDetail(horizonclient.AccountRequest{AccountID: sponsored.PublicKey})
+	accountExists := err == nil
+	if err != nil && !horizonclient.IsNotFoundError(err) {
+		uc.logger.Error("failed to load sponsored account", logger.Error(err))
+		return nil, fmt.Errorf("failed to load sponsored account: %w", err)
+	}
+
+	kind := submission.KindSponsorAccount
+	var sponsoredOp txnbuild.Operation
+	if record.Type == sponsorship.TypeAccount {
+		if accountExists {
+			return nil, errors.ErrAccountAlreadyExists
+		}
+		sponsoredOp = &txnbuild.CreateAccount{
+			Destination: sponsored.PublicKey,
+			Amount:      startingBalance.StringFixed(domainStellar.AmountDecimals),
+		}
+	} else {
+		if !accountExists {
+			return nil, errors.ErrAccountNotFound
+		}
+		for _, b := range account.Balances {
+			if b.Asset.Code == record.AssetCode && b.Asset.Issuer == record.AssetIssuer {
+				return nil, errors.ErrTrustlineAlreadyExists
+			}
+		}
+		kind = submission.KindSponsorTrustline
+		sponsoredOp = &txnbuild.ChangeTrust{
+			Line:          line,
+			Limit:         limit,
+			SourceAccount: sponsored.PublicKey,
+		}
+	}
+
+	// 4. Build, sign with both wallets and submit
+	ops := []txnbuild.Operation{
+		&txnbuild.BeginSponsoringFutureReserves{SponsoredID: sponsored.PublicKey},
+		sponsoredOp,
+		&txnbuild.EndSponsoringFutureReserves{SourceAccount: sponsored.PublicKey},
+	}
+
+	// A starting balance moves the sponsor's XLM out like a payment, so the new account
+	// must pass the allow-list and the amount counts against the spending limits
+	var spend *spending.Spend
+	if startingBalance.IsPositive() {
+		if err := uc.guard.CheckDestination(ctx, sponsor.ID, input.UserID, sponsored.PublicKey, "", ""); err != nil {
+			return nil, err
+		}
+		if spend, err = uc.guard.Reserve(ctx, sponsor, input.UserID, walletUC.AssetKey(txnbuild.NativeAsset{}), startingBalance); err != nil {
+			return nil, err
+		}
+	}
+
+	signed, err := uc.txBuilder.Build(ctx, walletUC.BuildTransactionParams{
+		Wallet:     sponsor,
+		Kind:       kind,
+		Operations: ops,
+		MaxFee:     input.MaxFee,
+		CoSigners:  []*wallet.Wallet{sponsored},
+	})
+	if err != nil {
+		if spend != nil {
+			uc.guard.Release(ctx, spend)
+		}
+		return nil, err
+	}
+	if spend != nil {
+		if err := uc.guard.Attach(ctx, spend, signed.Hash); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := uc.txBuilder.Submit(ctx, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. Record the sponsorship
+	if err := uc.sponsorships.Create(ctx, record); err != nil {
+		// The sponsorship is on the ledger; it can be reconciled from the sponsor's operations
+		uc.logger.Error("sponsorship created but not recorded",
+			logger.Error(err),
+			logger.String("sponsor_wallet_id", sponsor.ID.String()),
+			logger.String("hash", resp.Hash))
+		return nil, fmt.Errorf("failed to save sponsorship: %w", err)
+	}
+
+	uc.logger.Info("reserve sponsored",
+		logger.String("sponsorship_id", record.ID.String()),
+		logger.String("type", record.Type),
+		logger.String("sponsor_wallet_id", sponsor.ID.String()),
+		logger.String("sponsored_wallet_id", sponsored.ID.String()),
+		logger.String("hash", resp.Hash))
+
+	return toOperationOutput(record, kind, resp), nil
+}
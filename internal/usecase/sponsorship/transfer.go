@@ -0,0 +1,112 @@
+package sponsorship
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/sponsorship"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	walletUC "quasarflow-api/internal/usecase/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/txnbuild"
+)
+
+// TransferInput represents a request to move a sponsorship to another sponsor wallet
+type TransferInput struct {
+	SponsorshipID      uuid.UUID `json:"sponsorship_id"`
+	NewSponsorWalletID uuid.UUID `json:"new_sponsor_wallet_id" validate:"required"`
+	MaxFee             int64     `json:"max_fee,omitempty"`
+}
+
+// TransferUseCase hands the reserve of a sponsored entry over to a new sponsor
+type TransferUseCase struct {
+	wallets      wallet.Repository
+	sponsorships sponsorship.Repository
+	txBuilder    *walletUC.TransactionBuilder
+	logger       logger.Logger
+}
+
+// NewTransferUseCase creates a new transfer sponsorship use case
+func NewTransferUseCase(
+	wallets wallet.Repository,
+	sponsorships sponsorship.Repository,
+	txBuilder *walletUC.TransactionBuilder,
+	logger logger.Logger,
+) *TransferUseCase {
+	return &TransferUseCase{
+		wallets:      wallets,
+		sponsorships: sponsorships,
+		txBuilder:    txBuilder,
+		logger:       logger,
+	}
+}
+
+// Execute submits the current sponsor's RevokeSponsorship inside a sandwich in which the new
+// sponsor sponsors the current one, which moves the reserve to the new sponsor. The new
+// sponsor is the transaction source; the current sponsor co-signs.
+func (uc *TransferUseCase) Execute(ctx context.Context, input TransferInput) (*SponsorshipOperationOutput, error) {
+	// 1. Load the sponsorship and both sponsors
+	record, err := uc.sponsorships.FindByID(ctx, input.SponsorshipID)
+	if err != nil {
+		return nil, errors.ErrSponsorshipNotFound
+	}
+	if !record.IsActive() {
+		return nil, errors.ErrSponsorshipNotActive
+	}
+
+	current, err := uc.wallets.FindByID(ctx, record.SponsorWalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("Sponsor wallet not found")
+	}
+
+	next, err := uc.wallets.FindByID(ctx, input.NewSponsorWalletID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("New sponsor wallet not found")
+	}
+
+	if next.Network != current.Network || next.ID == current.ID {
+		return nil, errors.ErrSponsorshipWalletMismatch
+	}
+
+	replacement, err := record.TransferTo(next.ID, next.PublicKey)
+	if err != nil {
+		return nil, errors.ErrSponsorshipWalletMismatch
+	}
+
+	// 2. Build the sandwich around the current sponsor's revoke
+	revoke, err := sponsoredEntry(record)
+	if err != nil {
+		return nil, err
+	}
+	revoke.SourceAccount = current.PublicKey
+
+	ops := []txnbuild.Operation{
+		&txnbuild.BeginSponsoringFutureReserves{SponsoredID: current.PublicKey},
+		revoke,
+		&txnbuild.EndSponsoringFutureReserves{SourceAccount: current.PublicKey},
+	}
+
+	resp, err := submitSponsorship(ctx, uc.txBuilder, next, []*wallet.Wallet{current}, submission.KindTransferSponsorship, ops, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Record the hand-over
+	if err := uc.sponsorships.Transfer(ctx, record, replacement); err != nil {
+		uc.logger.Error("sponsorship transferred but not recorded",
+			logger.Error(err),
+			logger.String("sponsorship_id", record.ID.String()),
+			logger.String("hash", resp.Hash))
+	}
+
+	uc.logger.Info("sponsorship transferred",
+		logger.String("sponsorship_id", record.ID.String()),
+		logger.String("replacement_id", replacement.ID.String()),
+		logger.String("new_sponsor_wallet_id", next.ID.String()),
+		logger.String("hash", resp.Hash))
+
+	return toOperationOutput(replacement, submission.KindTransferSponsorship, resp), nil
+}
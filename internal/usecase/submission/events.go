@@ -0,0 +1,22 @@
+package submission
+
+import (
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/submission"
+)
+
+// FailureEvent builds the transaction.failed event of a failed or expired submission
+func FailureEvent(s *submission.Submission, publicKey string) *event.Event {
+	e := event.New(event.TypeTransactionFailed, s.WalletID, s.ID.String())
+	e.PublicKey = publicKey
+	e.OccurredAt = s.UpdatedAt
+	e.Transaction = &event.Transaction{
+		SubmissionID: s.ID,
+		Kind:         s.Kind,
+		Hash:         s.Hash,
+		Status:       string(s.Status),
+		ResultCode:   s.ResultCode,
+		ErrorMessage: s.ErrorMessage,
+	}
+	return e
+}
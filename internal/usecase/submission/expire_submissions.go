@@ -0,0 +1,126 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+const (
+	// expiryGracePeriod allows for ledger close and Horizon ingestion lag before
+	// a pending submission past its time bound is declared expired
+	expiryGracePeriod = 10 * time.Second
+
+	// expiryBatchSize limits how many submissions are settled per run
+	expiryBatchSize = 100
+)
+
+// ExpireSubmissionsOutput summarizes one run of the expiry job
+type ExpireSubmissionsOutput struct {
+	Checked   int
+	Succeeded int
+	Failed    int
+	Expired   int
+}
+
+// ExpireSubmissionsUseCase settles pending submissions whose time or ledger bounds have passed.
+// Transactions Horizon knows about take their on-chain outcome; the rest can no
+// longer be included and are marked expired so callers can rebuild and retry.
+// Failed and expired submissions publish transaction.failed.
+type ExpireSubmissionsUseCase struct {
+	repo          submission.Repository
+	horizonClient *horizonclient.Client
+	events        event.Publisher
+	logger        logger.Logger
+}
+
+// NewExpireSubmissionsUseCase creates a new expire submissions use case
+func NewExpireSubmissionsUseCase(
+	repo submission.Repository,
+	horizonClient *horizonclient.Client,
+	events event.Publisher,
+	logger logger.Logger,
+) *ExpireSubmissionsUseCase {
+	return &ExpireSubmissionsUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		events:        events,
+		logger:        logger,
+	}
+}
+
+// Execute settles one batch of stale pending submissions
+func (uc *ExpireSubmissionsUseCase) Execute(ctx context.Context) (*ExpireSubmissionsOutput, error) {
+	// Transactions are only valid in ledgers below their max ledger bound, so once Horizon
+	// has ingested that ledger no later one can include them. Without the latest ledger,
+	// only time bounds are checked.
+	var ledger uint32
+	if root, err := uc.horizonClient.Root(); err == nil && root.HorizonSequence > 0 {
+		ledger = uint32(root.HorizonSequence)
+	} else if err != nil {
+		uc.logger.Warn("failed to get latest ledger, checking time bounds only", logger.Error(err))
+	}
+
+	pending, err := uc.repo.ListPendingPast(ctx, time.Now().Add(-expiryGracePeriod), ledger, expiryBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending submissions: %w", err)
+	}
+
+	output := &ExpireSubmissionsOutput{}
+	for _, s := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+		output.Checked++
+
+		tx, err := uc.horizonClient.TransactionDetail(s.Hash)
+		switch {
+		case err == nil && tx.Successful:
+			s.MarkSucceeded(tx.Ledger)
+			output.Succeeded++
+		case err == nil:
+			s.MarkFailed("tx_failed", "transaction failed in ledger")
+			s.Ledger = tx.Ledger
+			output.Failed++
+		case horizonclient.IsNotFoundError(err):
+			s.MarkExpired()
+			output.Expired++
+		default:
+			uc.logger.Warn("failed to look up pending submission",
+				logger.Error(err),
+				logger.String("hash", s.Hash))
+			continue
+		}
+
+		if err := uc.repo.Update(ctx, s); err != nil {
+			uc.logger.Error("failed to update submission",
+				logger.Error(err),
+				logger.String("submission_id", s.ID.String()))
+			continue
+		}
+
+		if s.Status != submission.StatusSuccess {
+			if err := uc.events.Publish(ctx, FailureEvent(s, "")); err != nil {
+				uc.logger.Warn("failed to publish transaction failure",
+					logger.Error(err),
+					logger.String("submission_id", s.ID.String()))
+			}
+		}
+	}
+
+	if output.Checked > 0 {
+		uc.logger.Info("settled pending submissions",
+			logger.Int("checked", output.Checked),
+			logger.Int("succeeded", output.Succeeded),
+			logger.Int("failed", output.Failed),
+			logger.Int("expired", output.Expired))
+	}
+
+	return output, nil
+}
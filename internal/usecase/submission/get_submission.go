@@ -0,0 +1,72 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// SubmissionOutput represents a tracked transaction submission
+type SubmissionOutput struct {
+	ID           string `json:"id"`
+	WalletID     string `json:"wallet_id"`
+	Kind         string `json:"kind"`
+	Hash         string `json:"hash"`
+	Status       string `json:"status"`
+	ValidUntil   string `json:"valid_until"`
+	MinLedger    uint32 `json:"min_ledger,omitempty"`
+	MaxLedger    uint32 `json:"max_ledger,omitempty"`
+	Ledger       int32  `json:"ledger,omitempty"`
+	ResultCode   string `json:"result_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+	OfferID      int64  `json:"offer_id,omitempty"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+// GetSubmissionUseCase handles retrieving a submission by ID
+type GetSubmissionUseCase struct {
+	repo submission.Repository
+}
+
+// NewGetSubmissionUseCase creates a new get submission use case
+func NewGetSubmissionUseCase(repo submission.Repository) *GetSubmissionUseCase {
+	return &GetSubmissionUseCase{
+		repo: repo,
+	}
+}
+
+// Execute retrieves a submission by its ID
+func (uc *GetSubmissionUseCase) Execute(ctx context.Context, id uuid.UUID) (*SubmissionOutput, error) {
+	s, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.NewNotFoundError("Submission not found"), err)
+	}
+
+	output := toSubmissionOutput(s)
+	return &output, nil
+}
+
+func toSubmissionOutput(s *submission.Submission) SubmissionOutput {
+	return SubmissionOutput{
+		ID:           s.ID.String(),
+		WalletID:     s.WalletID.String(),
+		Kind:         s.Kind,
+		Hash:         s.Hash,
+		Status:       string(s.Status),
+		ValidUntil:   s.ValidUntil.Format(time.RFC3339),
+		MinLedger:    s.MinLedger,
+		MaxLedger:    s.MaxLedger,
+		Ledger:       s.Ledger,
+		ResultCode:   s.ResultCode,
+		ErrorMessage: s.ErrorMessage,
+		OfferID:      s.OfferID,
+		CreatedAt:    s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    s.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,65 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/submission"
+
+	"github.com/google/uuid"
+)
+
+// ListSubmissionsOutput represents a page of submissions for a wallet
+type ListSubmissionsOutput struct {
+	Submissions []SubmissionOutput `json:"submissions"`
+	Total       int64              `json:"total"`
+	Limit       int                `json:"limit"`
+	Offset      int                `json:"offset"`
+}
+
+// ListSubmissionsUseCase handles listing a wallet's submissions with pagination
+type ListSubmissionsUseCase struct {
+	repo submission.Repository
+}
+
+// NewListSubmissionsUseCase creates a new list submissions use case
+func NewListSubmissionsUseCase(repo submission.Repository) *ListSubmissionsUseCase {
+	return &ListSubmissionsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute retrieves a paginated list of submissions for a wallet, newest first
+func (uc *ListSubmissionsUseCase) Execute(ctx context.Context, walletID uuid.UUID, limit, offset int) (*ListSubmissionsOutput, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	submissions, err := uc.repo.ListByWallet(ctx, walletID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list submissions: %w", err)
+	}
+
+	total, err := uc.repo.CountByWallet(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count submissions: %w", err)
+	}
+
+	items := make([]SubmissionOutput, 0, len(submissions))
+	for _, s := range submissions {
+		items = append(items, toSubmissionOutput(s))
+	}
+
+	return &ListSubmissionsOutput{
+		Submissions: items,
+		Total:       total,
+		Limit:       limit,
+		Offset:      offset,
+	}, nil
+}
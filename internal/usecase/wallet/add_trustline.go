@@ -0,0 +1,160 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/txnbuild"
+)
+
+// AddTrustlineInput represents a request to create or update a trustline
+type AddTrustlineInput struct {
+	WalletID    uuid.UUID `json:"wallet_id"`
+	AssetCode   string    `json:"asset_code" validate:"required"`
+	AssetIssuer string    `json:"asset_issuer" validate:"required"`
+	Limit       string    `json:"limit,omitempty"`   // Optional, defaults to the maximum limit
+	MaxFee      int64     `json:"max_fee,omitempty"` // Optional per-operation fee cap in stroops
+}
+
+// ChangeTrustOutput represents the result of a ChangeTrust transaction
+type ChangeTrustOutput struct {
+	SubmissionID    string `json:"submission_id"`
+	TransactionHash string `json:"transaction_hash"`
+	WalletID        string `json:"wallet_id"`
+	AssetCode       string `json:"asset_code"`
+	AssetIssuer     string `json:"asset_issuer"`
+	Limit           string `json:"limit"`
+	Ledger          int32  `json:"ledger"`
+	FeeCharged      int64  `json:"fee_charged"`
+	Success         bool   `json:"success"`
+}
+
+// AddTrustlineUseCase creates a trustline, or changes its limit when it already exists
+type AddTrustlineUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	txBuilder     *TransactionBuilder
+	logger        logger.Logger
+}
+
+// NewAddTrustlineUseCase creates a new add trustline use case
+func NewAddTrustlineUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	txBuilder *TransactionBuilder,
+	logger logger.Logger,
+) *AddTrustlineUseCase {
+	return &AddTrustlineUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		txBuilder:     txBuilder,
+		logger:        logger,
+	}
+}
+
+// Execute builds and submits a ChangeTrust operation for the wallet
+func (uc *AddTrustlineUseCase) Execute(ctx context.Context, input AddTrustlineInput) (*ChangeTrustOutput, error) {
+	// 1. Validate asset and limit
+	asset, err := parseCreditAsset(input.AssetCode, input.AssetIssuer)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := txnbuild.MaxTrustlineLimit
+	if input.Limit != "" {
+		if _, err := domainStellar.ParseAmount(input.Limit); err != nil {
+			return nil, errors.ErrInvalidTrustlineLimit
+		}
+		limit = input.Limit
+	}
+
+	// 2. Find wallet
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	// 3. A lowered limit must still cover the current balance and pending buys
+	account, err := uc.horizonClient.AccountDetail(horizonclient.AccountRequest{AccountID: w.PublicKey})
+	if err != nil {
+		uc.logger.Error("failed to load wallet account", logger.Error(err))
+		return nil, fmt.Errorf("failed to load wallet account: %w", err)
+	}
+
+	if existing := findBalance(&account, asset.Code, asset.Issuer); existing != nil && input.Limit != "" {
+		required := decimal.RequireFromString(existing.Balance).Add(decimal.RequireFromString(existing.BuyingLiabilities))
+		if decimal.RequireFromString(limit).LessThan(required) {
+			return nil, errors.ErrTrustlineLimitBelowBalance
+		}
+	}
+
+	// 4. Build, sign and submit
+	output, err := submitChangeTrust(ctx, uc.txBuilder, w, asset, limit, input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("trustline changed",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("asset", assetParam(asset)),
+		logger.String("limit", limit),
+		logger.String("hash", output.TransactionHash))
+
+	return output, nil
+}
+
+// submitChangeTrust submits a single ChangeTrust operation; a limit of "0" removes the trustline
+func submitChangeTrust(
+	ctx context.Context,
+	txBuilder *TransactionBuilder,
+	w *wallet.Wallet,
+	asset txnbuild.CreditAsset,
+	limit string,
+	maxFee int64,
+) (*ChangeTrustOutput, error) {
+	line, err := asset.ToChangeTrustAsset()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trustline asset: %w", err)
+	}
+
+	signed, err := txBuilder.Build(ctx, BuildTransactionParams{
+		Wallet: w,
+		Kind:   submission.KindChangeTrust,
+		Operations: []txnbuild.Operation{
+			&txnbuild.ChangeTrust{
+				Line:  line,
+				Limit: limit,
+			},
+		},
+		MaxFee: maxFee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := txBuilder.Submit(ctx, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangeTrustOutput{
+		SubmissionID:    resp.SubmissionID.String(),
+		TransactionHash: resp.Hash,
+		WalletID:        w.ID.String(),
+		AssetCode:       asset.Code,
+		AssetIssuer:     asset.Issuer,
+		Limit:           limit,
+		Ledger:          resp.Ledger,
+		FeeCharged:      resp.FeeCharged,
+		Success:         true,
+	}, nil
+}
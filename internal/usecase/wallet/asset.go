@@ -0,0 +1,38 @@
+package wallet
+
+import (
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+)
+
+// parseCreditAsset validates an asset code and issuer and returns the credit asset
+func parseCreditAsset(code, issuer string) (txnbuild.CreditAsset, error) {
+	if !domainStellar.IsValidAssetCode(code) {
+		return txnbuild.CreditAsset{}, errors.ErrInvalidAssetCode
+	}
+	if !strkey.IsValidEd25519PublicKey(issuer) {
+		return txnbuild.CreditAsset{}, errors.ErrInvalidIssuer
+	}
+
+	return txnbuild.CreditAsset{Code: code, Issuer: issuer}, nil
+}
+
+// assetParam formats an asset the way Horizon expects it in query filters
+func assetParam(asset txnbuild.CreditAsset) string {
+	return asset.Code + ":" + asset.Issuer
+}
+
+// findBalance returns the account's balance line for a credit asset, or nil if it holds no trustline
+func findBalance(account *horizon.Account, code, issuer string) *horizon.Balance {
+	for i := range account.Balances {
+		b := &account.Balances[i]
+		if b.Asset.Code == code && b.Asset.Issuer == issuer {
+			return b
+		}
+	}
+	return nil
+}
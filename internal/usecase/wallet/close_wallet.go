@@ -0,0 +1,451 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	// maxOperationsPerTransaction is the protocol limit on operations in one transaction
+	maxOperationsPerTransaction = 100
+
+	// defaultMaxSlippageBP bounds how far below the quoted amount a conversion may fill
+	defaultMaxSlippageBP = 100
+
+	// maxOffersPerPage is the largest page of offers Horizon serves
+	maxOffersPerPage = 200
+)
+
+// Actions taken on a credit balance when closing a wallet
+const (
+	CloseActionSent      = "sent"      // Paid to the merge destination
+	CloseActionConverted = "converted" // Sold for XLM, which is merged into the destination
+	CloseActionReturned  = "returned"  // Paid back to the issuer, which burns it
+)
+
+// CloseWalletInput represents a request to close a wallet. Credit balances are sent to the
+// destination (or returned to the issuer when the destination is the issuer) unless
+// convert_to_xlm is set, in which case they are sold for XLM on the DEX first.
+type CloseWalletInput struct {
+	WalletID      uuid.UUID `json:"wallet_id"`
+	Destination   string    `json:"destination"`               // Existing account receiving the remaining XLM; optional for never funded wallets
+	ConvertToXLM  bool      `json:"convert_to_xlm,omitempty"`  // Sell credit balances for XLM instead of sending them
+	MaxSlippageBP int       `json:"max_slippage_bp,omitempty"` // Conversion tolerance in basis points, defaults to 100 (1%)
+	MaxFee        int64     `json:"max_fee,omitempty"`
+	UserID        string    `json:"-"` // Authenticated caller, checked against the address book and spending limits
+}
+
+// ClosedBalanceOutput describes what happened to a credit balance
+type ClosedBalanceOutput struct {
+	Asset     string `json:"asset"` // "CODE:ISSUER"
+	Amount    string `json:"amount"`
+	Action    string `json:"action"`
+	MinXLMOut string `json:"min_xlm_out,omitempty"` // Converted balances only
+}
+
+// CloseTransactionOutput identifies a transaction submitted while closing a wallet
+type CloseTransactionOutput struct {
+	Kind            string `json:"kind"`
+	SubmissionID    string `json:"submission_id"`
+	TransactionHash string `json:"transaction_hash"`
+	Ledger          int32  `json:"ledger"`
+	Operations      int    `json:"operations"`
+}
+
+// CloseWalletOutput represents a closed wallet and the steps taken to close it
+type CloseWalletOutput struct {
+	WalletID          string                   `json:"wallet_id"`
+	PublicKey         string                   `json:"public_key"`
+	Status            string                   `json:"status"`
+	MergedInto        string                   `json:"merged_into,omitempty"`
+	ClosedAt          string                   `json:"closed_at"`
+	OffersCancelled   int                      `json:"offers_cancelled"`
+	TrustlinesRemoved int                      `json:"trustlines_removed"`
+	Balances          []ClosedBalanceOutput    `json:"balances"`
+	Transactions      []CloseTransactionOutput `json:"transactions"`
+}
+
+// CloseWalletUseCase empties a wallet's account, merges it and archives the wallet
+type CloseWalletUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	txBuilder     *TransactionBuilder
+	guard         *OutflowGuard
+	logger        logger.Logger
+}
+
+// NewCloseWalletUseCase creates a new close wallet use case
+func NewCloseWalletUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	txBuilder *TransactionBuilder,
+	guard *OutflowGuard,
+	logger logger.Logger,
+) *CloseWalletUseCase {
+	return &CloseWalletUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		txBuilder:     txBuilder,
+		guard:         guard,
+		logger:        logger,
+	}
+}
+
+// Execute cancels the wallet's offers, moves out and removes its trustlines, clears data
+// entries and extra signers, merges the account into the destination and marks the wallet
+// closed. Operations are split into transactions of at most 100; the last one holds the
+// merge. If a transaction fails the wallet stays active and closing can be retried.
+func (uc *CloseWalletUseCase) Execute(ctx context.Context, input CloseWalletInput) (*CloseWalletOutput, error) {
+	// 1. Find wallet
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+	if w.IsClosed() {
+		return nil, errors.ErrWalletClosed
+	}
+
+	slippage := input.MaxSlippageBP
+	if slippage == 0 {
+		slippage = defaultMaxSlippageBP
+	}
+	if slippage < 0 || slippage >= 10000 {
+		return nil, errors.NewValidationError("Invalid max_slippage_bp", "max_slippage_bp must be between 0 and 9999")
+	}
+
+	output := &CloseWalletOutput{
+		WalletID:     w.ID.String(),
+		PublicKey:    w.PublicKey,
+		Balances:     make([]ClosedBalanceOutput, 0),
+		Transactions: make([]CloseTransactionOutput, 0),
+	}
+
+	// 2. A wallet that was never funded has nothing on the ledger to clean up
+	account, err := uc.horizonClient.AccountDetail(horizonclient.AccountRequest{AccountID: w.PublicKey})
+	if err != nil {
+		if !horizonclient.IsNotFoundError(err) {
+			uc.logger.Error("failed to load wallet account", logger.Error(err))
+			return nil, fmt.Errorf("failed to load wallet account: %w", err)
+		}
+		return uc.archive(ctx, w, "", output)
+	}
+
+	// 3. Validate the merge destination and what the ledger would refuse to merge
+	if !strkey.IsValidEd25519PublicKey(input.Destination) || input.Destination == w.PublicKey {
+		return nil, errors.ErrInvalidMergeDestination
+	}
+	destination, err := loadDestination(uc.horizonClient, input.Destination)
+	if err != nil {
+		return nil, err
+	}
+	if destination == nil {
+		return nil, errors.ErrInvalidMergeDestination
+	}
+
+	if account.NumSponsoring > 0 {
+		return nil, errors.ErrWalletIsSponsor
+	}
+
+	// Everything goes to the destination, which must pass the allow-list; the amounts
+	// cannot be reserved, so wallets with spending limits or approval policies are refused
+	if err := uc.guard.CheckDestination(ctx, w.ID, input.UserID, input.Destination, "", ""); err != nil {
+		return nil, err
+	}
+	if err := uc.guard.CheckUnlimited(ctx, w.ID, input.UserID, ""); err != nil {
+		return nil, err
+	}
+
+	// 4. Cancel open offers so their liabilities are released
+	ops, err := uc.cancelOffers(w.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	output.OffersCancelled = len(ops)
+
+	// 5. Remove empty pool share trustlines; they must go before the asset trustlines they reference
+	for _, b := range account.Balances {
+		if b.Asset.Type != domainStellar.AssetTypePoolShares {
+			continue
+		}
+		op, err := uc.removePoolShareTrustline(b)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+		output.TrustlinesRemoved++
+	}
+
+	// 6. Move out credit balances, then remove their trustlines
+	for _, b := range account.Balances {
+		if b.Asset.Type == "native" || b.Asset.Type == domainStellar.AssetTypePoolShares {
+			continue
+		}
+
+		asset := txnbuild.CreditAsset{Code: b.Asset.Code, Issuer: b.Asset.Issuer}
+		balance, err := decimal.NewFromString(b.Balance)
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance %q for %s: %w", b.Balance, assetParam(asset), err)
+		}
+
+		if balance.IsPositive() {
+			if b.IsAuthorized != nil && !*b.IsAuthorized {
+				return nil, errors.ErrTrustlineNotAuthorized
+			}
+
+			op, closed, err := uc.moveBalance(w.PublicKey, destination, asset, b.Balance, input.ConvertToXLM, slippage)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, op)
+			output.Balances = append(output.Balances, *closed)
+		}
+
+		line, err := asset.ToChangeTrustAsset()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build trustline asset: %w", err)
+		}
+		ops = append(ops, &txnbuild.ChangeTrust{Line: line, Limit: "0"})
+		output.TrustlinesRemoved++
+	}
+
+	// 7. Clear data entries and additional signers, which also count as subentries
+	for name := range account.Data {
+		ops = append(ops, &txnbuild.ManageData{Name: name})
+	}
+	for _, signer := range account.Signers {
+		if signer.Key == w.PublicKey {
+			continue
+		}
+		ops = append(ops, &txnbuild.SetOptions{Signer: &txnbuild.Signer{Address: signer.Key, Weight: 0}})
+	}
+
+	// 8. Merge, in the last of as many transactions as the operations need
+	ops = append(ops, &txnbuild.AccountMerge{Destination: input.Destination})
+
+	for start := 0; start < len(ops); start += maxOperationsPerTransaction {
+		end := start + maxOperationsPerTransaction
+		if end > len(ops) {
+			end = len(ops)
+		}
+
+		kind := submission.KindCloseWallet
+		if end == len(ops) {
+			kind = submission.KindAccountMerge
+		}
+
+		signed, err := uc.txBuilder.Build(ctx, BuildTransactionParams{
+			Wallet:     w,
+			Kind:       kind,
+			Operations: ops[start:end],
+			MaxFee:     input.MaxFee,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := uc.txBuilder.Submit(ctx, signed)
+		if err != nil {
+			return nil, err
+		}
+
+		output.Transactions = append(output.Transactions, CloseTransactionOutput{
+			Kind:            kind,
+			SubmissionID:    resp.SubmissionID.String(),
+			TransactionHash: resp.Hash,
+			Ledger:          resp.Ledger,
+			Operations:      end - start,
+		})
+	}
+
+	return uc.archive(ctx, w, input.Destination, output)
+}
+
+// archive marks the wallet closed once nothing of it remains on the ledger
+func (uc *CloseWalletUseCase) archive(ctx context.Context, w *wallet.Wallet, mergedInto string, output *CloseWalletOutput) (*CloseWalletOutput, error) {
+	w.Close(mergedInto)
+	if err := uc.repo.Update(ctx, w); err != nil {
+		// The account may already be merged; the wallet can still be archived by retrying
+		uc.logger.Error("wallet closed on the ledger but not archived",
+			logger.Error(err),
+			logger.String("wallet_id", w.ID.String()))
+		return nil, fmt.Errorf("failed to archive wallet: %w", err)
+	}
+
+	uc.logger.Info("wallet closed",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("merged_into", mergedInto),
+		logger.Int("transactions", len(output.Transactions)))
+
+	output.Status = string(w.Status)
+	output.MergedInto = w.MergedInto
+	output.ClosedAt = w.ClosedAt.Format("2006-01-02T15:04:05Z07:00")
+	return output, nil
+}
+
+// cancelOffers returns a delete operation for every open offer of the account
+func (uc *CloseWalletUseCase) cancelOffers(publicKey string) ([]txnbuild.Operation, error) {
+	ops := make([]txnbuild.Operation, 0)
+	cursor := ""
+	for {
+		page, err := uc.horizonClient.Offers(horizonclient.OfferRequest{
+			ForAccount: publicKey,
+			Cursor:     cursor,
+			Limit:      maxOffersPerPage,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch offers: %w", err)
+		}
+
+		for _, o := range page.Embedded.Records {
+			ops = append(ops, &txnbuild.ManageSellOffer{
+				Selling: horizonAsset(o.Selling),
+				Buying:  horizonAsset(o.Buying),
+				Amount:  "0",
+				Price:   xdr.Price{N: xdr.Int32(o.PriceR.N), D: xdr.Int32(o.PriceR.D)},
+				OfferID: o.ID,
+			})
+		}
+
+		records := page.Embedded.Records
+		if len(records) < maxOffersPerPage {
+			return ops, nil
+		}
+		cursor = records[len(records)-1].PT
+	}
+}
+
+// removePoolShareTrustline removes an empty pool share trustline; shares must be withdrawn first
+func (uc *CloseWalletUseCase) removePoolShareTrustline(b horizon.Balance) (txnbuild.Operation, error) {
+	shares, err := decimal.NewFromString(b.Balance)
+	if err != nil || shares.IsPositive() {
+		return nil, errors.ErrWalletHasPoolShares
+	}
+
+	pool, err := uc.horizonClient.LiquidityPoolDetail(horizonclient.LiquidityPoolRequest{LiquidityPoolID: b.LiquidityPoolId})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load liquidity pool: %w", err)
+	}
+	if len(pool.Reserves) != 2 {
+		return nil, fmt.Errorf("liquidity pool %s has %d reserves", pool.ID, len(pool.Reserves))
+	}
+
+	return &txnbuild.ChangeTrust{
+		Line: txnbuild.LiquidityPoolShareChangeTrustAsset{
+			LiquidityPoolParameters: txnbuild.LiquidityPoolParameters{
+				AssetA: reserveAsset(pool.Reserves[0].Asset),
+				AssetB: reserveAsset(pool.Reserves[1].Asset),
+				Fee:    int32(pool.FeeBP),
+			},
+		},
+		Limit: "0",
+	}, nil
+}
+
+// moveBalance returns the operation that empties a credit balance before its trustline is removed
+func (uc *CloseWalletUseCase) moveBalance(
+	publicKey string,
+	destination *horizon.Account,
+	asset txnbuild.CreditAsset,
+	amount string,
+	convert bool,
+	slippageBP int,
+) (txnbuild.Operation, *ClosedBalanceOutput, error) {
+	closed := &ClosedBalanceOutput{Asset: assetParam(asset), Amount: amount}
+
+	// Paying an issuer burns the units, whatever the conversion preference
+	if destination.AccountID == asset.Issuer {
+		closed.Action = CloseActionReturned
+		return &txnbuild.Payment{Destination: destination.AccountID, Amount: amount, Asset: asset}, closed, nil
+	}
+
+	if !convert {
+		if err := checkTrustline(destination, asset.Code, asset.Issuer); err != nil {
+			return nil, nil, err
+		}
+		closed.Action = CloseActionSent
+		return &txnbuild.Payment{Destination: destination.AccountID, Amount: amount, Asset: asset}, closed, nil
+	}
+
+	assetType := horizonclient.AssetType4
+	if len(asset.Code) > 4 {
+		assetType = horizonclient.AssetType12
+	}
+	paths, err := uc.horizonClient.StrictSendPaths(horizonclient.StrictSendPathsRequest{
+		SourceAssetType:   assetType,
+		SourceAssetCode:   asset.Code,
+		SourceAssetIssuer: asset.Issuer,
+		SourceAmount:      amount,
+		DestinationAssets: "native",
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find conversion paths: %w", err)
+	}
+
+	// Take the path that yields the most XLM
+	var best *horizon.Path
+	bestAmount := decimal.Zero
+	for i := range paths.Embedded.Records {
+		p := &paths.Embedded.Records[i]
+		received, err := decimal.NewFromString(p.DestinationAmount)
+		if err == nil && received.GreaterThan(bestAmount) {
+			best, bestAmount = p, received
+		}
+	}
+	if best == nil {
+		return nil, nil, errors.ErrNoConversionPath
+	}
+
+	minOut := bestAmount.Mul(decimal.NewFromInt(int64(10000 - slippageBP))).Div(decimal.NewFromInt(10000)).
+		RoundDown(domainStellar.AmountDecimals)
+	if !minOut.IsPositive() {
+		return nil, nil, errors.ErrNoConversionPath
+	}
+
+	path := make([]txnbuild.Asset, 0, len(best.Path))
+	for _, a := range best.Path {
+		path = append(path, horizonAsset(a))
+	}
+
+	closed.Action = CloseActionConverted
+	closed.MinXLMOut = minOut.StringFixed(domainStellar.AmountDecimals)
+	return &txnbuild.PathPaymentStrictSend{
+		SendAsset:   asset,
+		SendAmount:  amount,
+		Destination: publicKey,
+		DestAsset:   txnbuild.NativeAsset{},
+		DestMin:     closed.MinXLMOut,
+		Path:        path,
+	}, closed, nil
+}
+
+// horizonAsset converts an asset as reported by Horizon
+func horizonAsset(a horizon.Asset) txnbuild.Asset {
+	if a.Type == "native" {
+		return txnbuild.NativeAsset{}
+	}
+	return txnbuild.CreditAsset{Code: a.Code, Issuer: a.Issuer}
+}
+
+// reserveAsset parses a pool reserve asset given as "native" or "CODE:ISSUER"
+func reserveAsset(value string) txnbuild.Asset {
+	code, issuer, ok := strings.Cut(value, ":")
+	if !ok {
+		return txnbuild.NativeAsset{}
+	}
+	return txnbuild.CreditAsset{Code: code, Issuer: issuer}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"quasarflow-api/internal/domain/event"
 	"quasarflow-api/internal/domain/wallet"
 	"quasarflow-api/internal/infrastructure/crypto"
 	"quasarflow-api/pkg/logger"
@@ -25,17 +26,20 @@ type CreateWalletOutput struct {
 type CreateWalletUseCase struct {
 	repo   wallet.Repository
 	crypto crypto.Encryptor
+	events event.Publisher
 	logger logger.Logger
 }
 
 func NewCreateWalletUseCase(
 	repo wallet.Repository,
 	crypto crypto.Encryptor,
+	events event.Publisher,
 	logger logger.Logger,
 ) *CreateWalletUseCase {
 	return &CreateWalletUseCase{
 		repo:   repo,
 		crypto: crypto,
+		events: events,
 		logger: logger,
 	}
 }
@@ -70,6 +74,15 @@ func (uc *CreateWalletUseCase) Execute(ctx context.Context, input CreateWalletIn
 
 	uc.logger.Info("wallet created successfully", logger.String("id", w.ID.String()), logger.String("public_key", w.PublicKey))
 
+	// 5. Announce the wallet; it exists even if a subscriber fails
+	created := event.New(event.TypeWalletCreated, w.ID, w.ID.String())
+	created.PublicKey = w.PublicKey
+	created.OccurredAt = w.CreatedAt
+	created.Wallet = &event.Wallet{Network: w.Network}
+	if err := uc.events.Publish(ctx, created); err != nil {
+		uc.logger.Warn("failed to publish wallet created event", logger.Error(err), logger.String("id", w.ID.String()))
+	}
+
 	return &CreateWalletOutput{
 		ID:        w.ID.String(),
 		PublicKey: w.PublicKey,
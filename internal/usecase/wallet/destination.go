@@ -0,0 +1,48 @@
+package wallet
+
+import (
+	"fmt"
+
+	"quasarflow-api/pkg/errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/protocols/horizon"
+)
+
+// minimumAccountBalance is the base reserve (2 x 0.5 XLM) a new account must be created with
+var minimumAccountBalance = decimal.NewFromInt(1)
+
+// loadDestination fetches a destination account from Horizon.
+// It returns nil without error when the account does not exist yet.
+func loadDestination(horizonClient *horizonclient.Client, address string) (*horizon.Account, error) {
+	account, err := horizonClient.AccountDetail(horizonclient.AccountRequest{
+		AccountID: address,
+	})
+	if err != nil {
+		if horizonclient.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load destination account: %w", err)
+	}
+
+	return &account, nil
+}
+
+// checkTrustline verifies that an existing account can receive a credit asset
+func checkTrustline(account *horizon.Account, assetCode, assetIssuer string) error {
+	// Issuers hold their own assets without a trustline
+	if account.AccountID == assetIssuer {
+		return nil
+	}
+
+	b := findBalance(account, assetCode, assetIssuer)
+	if b == nil {
+		return errors.ErrDestinationNoTrustline
+	}
+	if b.IsAuthorized != nil && !*b.IsAuthorized {
+		return errors.ErrDestinationNotAuthorized
+	}
+
+	return nil
+}
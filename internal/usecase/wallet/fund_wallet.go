@@ -7,6 +7,7 @@ import (
 	"net/url"
 
 	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
 	"quasarflow-api/pkg/logger"
 
 	"github.com/google/uuid"
@@ -52,6 +53,11 @@ func (uc *FundWalletUseCase) Execute(ctx context.Context, input FundWalletInput)
 		return nil, fmt.Errorf("wallet not found: %w", err)
 	}
 
+	// Funding would recreate the merged account under a wallet that can no longer sign
+	if w.IsClosed() {
+		return nil, errors.ErrWalletClosed
+	}
+
 	// 2. Check if wallet is on a network that supports Friendbot
 	if w.Network == "mainnet" {
 		return &FundWalletOutput{
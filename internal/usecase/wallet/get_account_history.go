@@ -0,0 +1,111 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"quasarflow-api/internal/domain/ledger"
+	"quasarflow-api/internal/infrastructure/stellar"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+type GetAccountHistoryInput struct {
+	PublicKey string `json:"public_key" validate:"required"`
+	Limit     uint   `json:"limit,omitempty"`  // Default: 10, Max: 50
+	Order     string `json:"order,omitempty"`  // "asc" or "desc", default: "desc"
+	Cursor    string `json:"cursor,omitempty"` // ID of the last operation of the previous page
+}
+
+type GetAccountHistoryOutput struct {
+	PublicKey    string              `json:"public_key"`
+	Transactions []TransactionRecord `json:"transactions"`
+	Operations   []OperationRecord   `json:"operations"`
+	HasNext      bool                `json:"has_next"`
+	NextCursor   string              `json:"next_cursor,omitempty"`
+}
+
+// GetAccountHistoryUseCase lists the operations of any Stellar account straight from
+// Horizon, in the same form as the indexed wallet history
+type GetAccountHistoryUseCase struct {
+	reader *stellar.HistoryReader
+	logger logger.Logger
+}
+
+func NewGetAccountHistoryUseCase(horizonClient *horizonclient.Client, logger logger.Logger) *GetAccountHistoryUseCase {
+	return &GetAccountHistoryUseCase{
+		reader: stellar.NewHistoryReader(horizonClient),
+		logger: logger,
+	}
+}
+
+func (uc *GetAccountHistoryUseCase) Execute(ctx context.Context, input GetAccountHistoryInput) (*GetAccountHistoryOutput, error) {
+	// 1. Validate input. Effects are fetched per operation, so pages stay small.
+	if !isAccountAddress(input.PublicKey) {
+		return nil, errors.NewValidationError("Invalid public key", "public_key must be a Stellar account address")
+	}
+
+	limit := int(input.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	order := horizonclient.OrderDesc
+	if input.Order == ledger.OrderAsc {
+		order = horizonclient.OrderAsc
+	}
+
+	if input.Cursor != "" {
+		if position, err := strconv.ParseInt(input.Cursor, 10, 64); err != nil || position <= 0 {
+			return nil, errors.ErrInvalidHistoryCursor
+		}
+	}
+
+	// 2. Read one extra operation to know whether another page follows
+	ops, txs, err := uc.reader.Page(input.PublicKey, input.Cursor, limit+1, order)
+	if err != nil && !horizonclient.IsNotFoundError(err) {
+		uc.logger.Error("failed to read account history",
+			logger.String("public_key", input.PublicKey),
+			logger.Error(err))
+		return nil, fmt.Errorf("failed to read account history: %w", err)
+	}
+
+	hasNext := len(ops) > limit
+	if hasNext {
+		ops = ops[:limit]
+	}
+
+	// 3. Keep the transactions of the returned operations only
+	included := make(map[string]bool, len(ops))
+	operations := make([]OperationRecord, 0, len(ops))
+	for _, op := range ops {
+		included[op.TransactionHash] = true
+		operations = append(operations, toOperationRecord(op))
+	}
+
+	transactions := make([]TransactionRecord, 0, len(txs))
+	for _, t := range txs {
+		if included[t.Hash] {
+			transactions = append(transactions, toTransactionRecord(t))
+		}
+	}
+
+	nextCursor := ""
+	if hasNext {
+		nextCursor = operations[len(operations)-1].ID
+	}
+
+	return &GetAccountHistoryOutput{
+		PublicKey:    input.PublicKey,
+		Transactions: transactions,
+		Operations:   operations,
+		HasNext:      hasNext,
+		NextCursor:   nextCursor,
+	}, nil
+}
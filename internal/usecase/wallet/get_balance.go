@@ -3,38 +3,100 @@ package wallet
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	domainPricing "quasarflow-api/internal/domain/pricing"
 	domainStellar "quasarflow-api/internal/domain/stellar"
 	"quasarflow-api/internal/domain/wallet"
 	"quasarflow-api/internal/infrastructure/stellar"
+	"quasarflow-api/internal/usecase/pricing"
+	"quasarflow-api/internal/usecase/registry"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
 
 	"github.com/google/uuid"
 )
 
+// BalanceOutput represents a single balance enriched with registry information
+type BalanceOutput struct {
+	AssetType     string                    `json:"asset_type"`
+	AssetCode     string                    `json:"asset_code"`
+	AssetIssuer   string                    `json:"asset_issuer"`
+	Amount        string                    `json:"amount"`
+	Limit         *string                   `json:"limit"`
+	Asset         *registry.AssetInfoOutput `json:"asset,omitempty"`          // Name, image, anchor and verification status
+	LiquidityPool *PoolShareOutput          `json:"liquidity_pool,omitempty"` // Set for pool shares
+}
+
+// PoolReserveOutput is an amount of one pool asset
+type PoolReserveOutput struct {
+	Asset  string `json:"asset"` // "native" or "CODE:ISSUER"
+	Amount string `json:"amount"`
+}
+
+// PoolShareOutput describes the pool behind a pool share balance and what the shares redeem for
+type PoolShareOutput struct {
+	ID           string              `json:"id"`
+	FeeBP        uint32              `json:"fee_bp"`
+	TotalShares  string              `json:"total_shares"`
+	PoolReserves []PoolReserveOutput `json:"pool_reserves"`
+	Underlying   []PoolReserveOutput `json:"underlying"` // The wallet's share of the reserves
+}
+
+// CurrencyNone disables the valuation block of a balance response
+const CurrencyNone = "NONE"
+
+// GetBalanceInput represents a balance request
+type GetBalanceInput struct {
+	WalletID uuid.UUID
+	Currency string // USD, XLM or NONE; empty uses the configured default
+}
+
 type GetBalanceOutput struct {
-	PublicKey string                  `json:"public_key"`
-	Network   string                  `json:"network"`
-	Balances  []domainStellar.Balance `json:"balances"`
+	PublicKey string                   `json:"public_key"`
+	Network   string                   `json:"network"`
+	Balances  []BalanceOutput          `json:"balances"`
+	Valuation *pricing.ValuationOutput `json:"valuation,omitempty"` // Per-asset and total value in the reference currency
 }
 
 type GetBalanceUseCase struct {
-	repo          wallet.Repository
-	stellarClient *stellar.Client
+	repo            wallet.Repository
+	stellarClient   *stellar.Client
+	assetInfo       *registry.GetAssetInfoUseCase
+	valuation       *pricing.ValuePortfolioUseCase
+	defaultCurrency string
+	logger          logger.Logger
 }
 
 func NewGetBalanceUseCase(
 	repo wallet.Repository,
 	stellarClient *stellar.Client,
+	assetInfo *registry.GetAssetInfoUseCase,
+	valuation *pricing.ValuePortfolioUseCase,
+	defaultCurrency string,
+	logger logger.Logger,
 ) *GetBalanceUseCase {
 	return &GetBalanceUseCase{
-		repo:          repo,
-		stellarClient: stellarClient,
+		repo:            repo,
+		stellarClient:   stellarClient,
+		assetInfo:       assetInfo,
+		valuation:       valuation,
+		defaultCurrency: strings.ToUpper(defaultCurrency),
+		logger:          logger,
 	}
 }
 
-func (uc *GetBalanceUseCase) Execute(ctx context.Context, walletID uuid.UUID) (*GetBalanceOutput, error) {
+func (uc *GetBalanceUseCase) Execute(ctx context.Context, input GetBalanceInput) (*GetBalanceOutput, error) {
+	currency := strings.ToUpper(input.Currency)
+	if currency == "" {
+		currency = uc.defaultCurrency
+	}
+	if currency != CurrencyNone && currency != "" && !domainPricing.IsSupportedCurrency(currency) {
+		return nil, errors.ErrUnsupportedCurrency
+	}
+
 	// 1. Find wallet in database
-	w, err := uc.repo.FindByID(ctx, walletID)
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
 	if err != nil {
 		return nil, fmt.Errorf("wallet not found: %w", err)
 	}
@@ -45,9 +107,114 @@ func (uc *GetBalanceUseCase) Execute(ctx context.Context, walletID uuid.UUID) (*
 		return nil, fmt.Errorf("failed to fetch balance: %w", err)
 	}
 
-	return &GetBalanceOutput{
+	// 3. Enrich with registry metadata; a registry failure never fails the balance
+	outputs := make([]BalanceOutput, 0, len(balances))
+	holdings := make([]pricing.Holding, 0, len(balances))
+	for _, b := range balances {
+		output, holding := uc.toBalanceOutput(ctx, b)
+		outputs = append(outputs, output)
+		holdings = append(holdings, holding)
+	}
+
+	result := &GetBalanceOutput{
 		PublicKey: w.PublicKey,
 		Network:   w.Network,
-		Balances:  balances,
-	}, nil
+		Balances:  outputs,
+	}
+
+	// 4. Value the balances; a pricing failure never fails the balance
+	if currency != CurrencyNone && currency != "" {
+		valuation, err := uc.valuation.Execute(ctx, currency, holdings)
+		if err != nil {
+			uc.logger.Warn("failed to value balances", logger.Error(err), logger.String("currency", currency))
+		} else {
+			result.Valuation = valuation
+		}
+	}
+
+	return result, nil
+}
+
+func (uc *GetBalanceUseCase) toBalanceOutput(ctx context.Context, b domainStellar.Balance) (BalanceOutput, pricing.Holding) {
+	if b.AssetType == domainStellar.AssetTypePoolShares {
+		return uc.toPoolShareOutput(ctx, b)
+	}
+
+	holding := pricing.Holding{Asset: domainPricing.NativeAsset, Amount: b.Amount}
+	if b.AssetType != "native" {
+		holding.Asset = b.AssetCode + ":" + b.AssetIssuer
+	}
+
+	output := BalanceOutput{
+		AssetType:   b.AssetType,
+		AssetCode:   b.AssetCode,
+		AssetIssuer: b.AssetIssuer,
+		Amount:      b.Amount.StringFixed(domainStellar.AmountDecimals),
+	}
+	if b.AssetType == "native" {
+		output.AssetCode = "XLM"
+	}
+	if b.Limit != nil {
+		limit := b.Limit.StringFixed(domainStellar.AmountDecimals)
+		output.Limit = &limit
+	}
+
+	info, err := uc.assetInfo.Execute(ctx, output.AssetCode, b.AssetIssuer)
+	if err != nil {
+		uc.logger.Warn("failed to enrich balance with asset info",
+			logger.Error(err),
+			logger.String("asset_code", output.AssetCode),
+			logger.String("asset_issuer", b.AssetIssuer))
+		return output, holding
+	}
+	output.Asset = info
+
+	return output, holding
+}
+
+// toPoolShareOutput reports pool shares with the reserves they redeem for; a pool
+// lookup failure leaves the balance without the pool block
+func (uc *GetBalanceUseCase) toPoolShareOutput(ctx context.Context, b domainStellar.Balance) (BalanceOutput, pricing.Holding) {
+	holding := pricing.Holding{Asset: "liquidity_pool:" + b.LiquidityPoolID, Amount: b.Amount}
+
+	output := BalanceOutput{
+		AssetType: b.AssetType,
+		Amount:    b.Amount.StringFixed(domainStellar.AmountDecimals),
+	}
+	if b.Limit != nil {
+		limit := b.Limit.StringFixed(domainStellar.AmountDecimals)
+		output.Limit = &limit
+	}
+
+	pool, err := uc.stellarClient.GetLiquidityPool(ctx, b.LiquidityPoolID)
+	if err != nil {
+		uc.logger.Warn("failed to load liquidity pool for balance",
+			logger.Error(err),
+			logger.String("liquidity_pool_id", b.LiquidityPoolID))
+		output.LiquidityPool = &PoolShareOutput{ID: b.LiquidityPoolID}
+		return output, holding
+	}
+
+	underlying := pool.ShareOf(b.Amount)
+	for _, r := range underlying {
+		holding.Components = append(holding.Components, pricing.Holding{Asset: r.Asset, Amount: r.Amount})
+	}
+
+	output.LiquidityPool = &PoolShareOutput{
+		ID:           pool.ID,
+		FeeBP:        pool.FeeBP,
+		TotalShares:  pool.TotalShares.StringFixed(domainStellar.AmountDecimals),
+		PoolReserves: toPoolReserveOutputs(pool.Reserves),
+		Underlying:   toPoolReserveOutputs(underlying),
+	}
+
+	return output, holding
+}
+
+func toPoolReserveOutputs(reserves []domainStellar.PoolReserve) []PoolReserveOutput {
+	out := make([]PoolReserveOutput, 0, len(reserves))
+	for _, r := range reserves {
+		out = append(out, PoolReserveOutput{Asset: r.Asset, Amount: r.Amount.StringFixed(domainStellar.AmountDecimals)})
+	}
+	return out
 }
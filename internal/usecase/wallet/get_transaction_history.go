@@ -2,15 +2,20 @@ package wallet
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"quasarflow-api/internal/domain/ledger"
+	domainStellar "quasarflow-api/internal/domain/stellar"
 	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
 	"quasarflow-api/pkg/logger"
 
 	"github.com/google/uuid"
-	"github.com/stellar/go/clients/horizonclient"
-	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/keypair"
 )
 
 type TransactionRecord struct {
@@ -19,8 +24,6 @@ type TransactionRecord struct {
 	Ledger         int32     `json:"ledger"`
 	CreatedAt      time.Time `json:"created_at"`
 	SourceAccount  string    `json:"source_account"`
-	Type           string    `json:"type"`
-	TypeI          int32     `json:"type_i"`
 	OperationCount int32     `json:"operation_count"`
 	Successful     bool      `json:"successful"`
 	MaxFee         int64     `json:"max_fee"`
@@ -29,51 +32,76 @@ type TransactionRecord struct {
 	Memo           string    `json:"memo,omitempty"`
 }
 
-type PaymentOperation struct {
-	ID            string    `json:"id"`
-	Type          string    `json:"type"`
-	CreatedAt     time.Time `json:"created_at"`
-	TransactionID string    `json:"transaction_id"`
-	From          string    `json:"from"`
-	To            string    `json:"to"`
-	Amount        string    `json:"amount"`
-	AssetType     string    `json:"asset_type"`
-	AssetCode     string    `json:"asset_code,omitempty"`
-	AssetIssuer   string    `json:"asset_issuer,omitempty"`
+// OperationRecord is an operation with its effects on the account
+type OperationRecord struct {
+	ID              string         `json:"id"`
+	Type            string         `json:"type"`
+	CreatedAt       time.Time      `json:"created_at"`
+	TransactionHash string         `json:"transaction_hash"`
+	Successful      bool           `json:"successful"`
+	SourceAccount   string         `json:"source_account"`
+	Counterparties  []string       `json:"counterparties"`
+	Assets          []string       `json:"assets"`
+	Details         interface{}    `json:"details"` // Typed by operation type, see ledger.DecodeDetails
+	Flows           []FlowRecord   `json:"flows"`
+	Effects         []EffectRecord `json:"effects"`
+}
+
+// FlowRecord is the amount of an asset an operation moved into and out of the account
+type FlowRecord struct {
+	Asset     string `json:"asset"`
+	AmountIn  string `json:"amount_in"`
+	AmountOut string `json:"amount_out"`
+	Net       string `json:"net"`
+}
+
+// EffectRecord is a change an operation made to the wallet's account
+type EffectRecord struct {
+	ID      string          `json:"id"`
+	Type    string          `json:"type"`
+	Details json.RawMessage `json:"details"` // Horizon's effect record
 }
 
 type GetTransactionHistoryInput struct {
-	WalletID uuid.UUID `json:"wallet_id" validate:"required"`
-	Limit    uint      `json:"limit,omitempty"`  // Default: 10, Max: 200
-	Order    string    `json:"order,omitempty"`  // "asc" or "desc", default: "desc"
-	Cursor   string    `json:"cursor,omitempty"` // For pagination
+	WalletID     uuid.UUID  `json:"wallet_id" validate:"required"`
+	Limit        uint       `json:"limit,omitempty"`  // Default: 10, Max: 200
+	Order        string     `json:"order,omitempty"`  // "asc" or "desc", default: "desc"
+	Cursor       string     `json:"cursor,omitempty"` // ID of the last operation of the previous page
+	From         *time.Time `json:"from,omitempty"`   // Operations closed at or after
+	To           *time.Time `json:"to,omitempty"`     // Operations closed before
+	Asset        string     `json:"asset,omitempty"`  // "native", "XLM" or "CODE:ISSUER"
+	Counterparty string     `json:"counterparty,omitempty"`
+	Types        []string   `json:"types,omitempty"` // Horizon operation types
 }
 
 type GetTransactionHistoryOutput struct {
-	WalletID     string              `json:"wallet_id"`
-	PublicKey    string              `json:"public_key"`
-	Network      string              `json:"network"`
-	Transactions []TransactionRecord `json:"transactions"`
-	Operations   []PaymentOperation  `json:"operations"`
-	HasNext      bool                `json:"has_next"`
-	NextCursor   string              `json:"next_cursor,omitempty"`
+	WalletID         string              `json:"wallet_id"`
+	PublicKey        string              `json:"public_key"`
+	Network          string              `json:"network"`
+	Transactions     []TransactionRecord `json:"transactions"`
+	Operations       []OperationRecord   `json:"operations"`
+	HasNext          bool                `json:"has_next"`
+	NextCursor       string              `json:"next_cursor,omitempty"`
+	BackfillComplete bool                `json:"backfill_complete"` // False while older history is still being indexed
 }
 
+// GetTransactionHistoryUseCase lists a wallet's operations, and the transactions they
+// belong to, from the local ledger index
 type GetTransactionHistoryUseCase struct {
-	repo          wallet.Repository
-	horizonClient *horizonclient.Client
-	logger        logger.Logger
+	repo   wallet.Repository
+	index  ledger.Repository
+	logger logger.Logger
 }
 
 func NewGetTransactionHistoryUseCase(
 	repo wallet.Repository,
-	horizonClient *horizonclient.Client,
+	index ledger.Repository,
 	logger logger.Logger,
 ) *GetTransactionHistoryUseCase {
 	return &GetTransactionHistoryUseCase{
-		repo:          repo,
-		horizonClient: horizonClient,
-		logger:        logger,
+		repo:   repo,
+		index:  index,
+		logger: logger,
 	}
 }
 
@@ -85,147 +113,185 @@ func (uc *GetTransactionHistoryUseCase) Execute(ctx context.Context, input GetTr
 		return nil, fmt.Errorf("wallet not found: %w", err)
 	}
 
-	// 2. Set default values
-	limit := input.Limit
-	if limit == 0 {
-		limit = 10
-	}
-	if limit > 200 {
-		limit = 200
+	// 2. Build the index filter
+	filter, err := historyFilter(w.ID, input)
+	if err != nil {
+		return nil, err
 	}
 
-	order := input.Order
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	// 3. Query one extra operation to know whether another page follows
+	limit := filter.Limit
+	filter.Limit++
+	records, err := uc.index.ListOperations(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transaction history: %w", err)
 	}
 
-	// 3. Build transaction request
-	txRequest := horizonclient.TransactionRequest{
-		ForAccount: w.PublicKey,
-		Limit:      limit,
-		Order:      horizonclient.Order(order),
+	hasNext := len(records) > limit
+	if hasNext {
+		records = records[:limit]
 	}
 
-	if input.Cursor != "" {
-		txRequest.Cursor = input.Cursor
+	// 4. Load the transactions of the page's operations, in page order
+	hashes := make([]string, 0, len(records))
+	seen := make(map[string]bool)
+	for _, op := range records {
+		if !seen[op.TransactionHash] {
+			seen[op.TransactionHash] = true
+			hashes = append(hashes, op.TransactionHash)
+		}
 	}
 
-	uc.logger.Info("fetching transaction history",
-		logger.String("wallet_id", w.ID.String()),
-		logger.String("public_key", w.PublicKey),
-		logger.Int("limit", int(limit)),
-		logger.String("order", order),
-	)
-
-	// 4. Get transactions from Horizon
-	transactionsPage, err := uc.horizonClient.Transactions(txRequest)
-	if err != nil {
-		uc.logger.Error("failed to fetch transactions", logger.Error(err))
-		return nil, fmt.Errorf("failed to fetch transaction history: %w", err)
-	}
-
-	// 5. Convert transactions to our format
-	transactions := make([]TransactionRecord, 0, len(transactionsPage.Embedded.Records))
-	for _, tx := range transactionsPage.Embedded.Records {
-		record := TransactionRecord{
-			ID:             tx.ID,
-			Hash:           tx.Hash,
-			Ledger:         tx.Ledger,
-			CreatedAt:      tx.LedgerCloseTime,
-			SourceAccount:  "",
-			OperationCount: tx.OperationCount,
-			Successful:     tx.Successful,
-			MaxFee:         tx.MaxFee,
-			FeeCharged:     tx.FeeCharged,
-			MemoType:       tx.MemoType,
+	transactions := make([]TransactionRecord, 0, len(hashes))
+	if len(hashes) > 0 {
+		found, err := uc.index.FindTransactions(ctx, hashes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query transaction history: %w", err)
 		}
 
-		// Handle memo based on type
-		switch tx.MemoType {
-		case "text":
-			if tx.Memo != "" {
-				record.Memo = tx.Memo
-			}
-		case "id":
-			if tx.Memo != "" {
-				record.Memo = tx.Memo
-			}
-		case "hash", "return":
-			if tx.Memo != "" {
-				record.Memo = tx.Memo
+		byHash := make(map[string]*ledger.Transaction, len(found))
+		for _, t := range found {
+			byHash[t.Hash] = t
+		}
+		for _, hash := range hashes {
+			if t, ok := byHash[hash]; ok {
+				transactions = append(transactions, toTransactionRecord(t))
 			}
 		}
+	}
+
+	operations := make([]OperationRecord, 0, len(records))
+	for _, op := range records {
+		operations = append(operations, toOperationRecord(op))
+	}
+
+	nextCursor := ""
+	if hasNext {
+		nextCursor = operations[len(operations)-1].ID
+	}
+
+	// 5. Report whether older history is still being indexed
+	state, err := uc.index.GetState(ctx, w.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transaction history: %w", err)
+	}
+
+	return &GetTransactionHistoryOutput{
+		WalletID:         w.ID.String(),
+		PublicKey:        w.PublicKey,
+		Network:          w.Network,
+		Transactions:     transactions,
+		Operations:       operations,
+		HasNext:          hasNext,
+		NextCursor:       nextCursor,
+		BackfillComplete: state != nil && state.BackfilledAt != nil,
+	}, nil
+}
 
-		transactions = append(transactions, record)
+// historyFilter validates the input and converts it to an index filter
+func historyFilter(walletID uuid.UUID, input GetTransactionHistoryInput) (ledger.Filter, error) {
+	filter := ledger.Filter{
+		WalletID: walletID,
+		From:     input.From,
+		To:       input.To,
+		Types:    input.Types,
+		Order:    ledger.OrderDesc,
+		Limit:    int(input.Limit),
 	}
 
-	// 6. Get payment operations for more detailed view
-	opRequest := horizonclient.OperationRequest{
-		ForAccount: w.PublicKey,
-		Limit:      limit,
-		Order:      horizonclient.Order(order),
+	if filter.Limit <= 0 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 200 {
+		filter.Limit = 200
+	}
+
+	if input.Order == ledger.OrderAsc {
+		filter.Order = ledger.OrderAsc
 	}
 
 	if input.Cursor != "" {
-		opRequest.Cursor = input.Cursor
+		after, err := strconv.ParseInt(input.Cursor, 10, 64)
+		if err != nil || after <= 0 {
+			return filter, errors.ErrInvalidHistoryCursor
+		}
+		filter.After = after
 	}
 
-	operationsPage, err := uc.horizonClient.Operations(opRequest)
-	if err != nil {
-		uc.logger.Warn("failed to fetch operations", logger.Error(err))
-		// Continue without operations if this fails
-	}
-
-	// 7. Convert payment operations to our format
-	paymentOps := make([]PaymentOperation, 0)
-	if err == nil {
-		for _, op := range operationsPage.Embedded.Records {
-			// Only include payment operations
-			if payment, ok := op.(operations.Payment); ok {
-				paymentOp := PaymentOperation{
-					ID:            payment.GetID(),
-					Type:          payment.GetType(),
-					CreatedAt:     payment.LedgerCloseTime,
-					TransactionID: payment.GetTransactionHash(),
-					From:          payment.From,
-					To:            payment.To,
-					Amount:        payment.Amount,
-					AssetType:     payment.Asset.Type,
-				}
-
-				if payment.Asset.Code != "" {
-					paymentOp.AssetCode = payment.Asset.Code
-				}
-				if payment.Asset.Issuer != "" {
-					paymentOp.AssetIssuer = payment.Asset.Issuer
-				}
-
-				paymentOps = append(paymentOps, paymentOp)
-			}
+	if input.From != nil && input.To != nil && !input.From.Before(*input.To) {
+		return filter, errors.NewValidationError("Invalid history filter", "from must be before to")
+	}
+
+	switch asset := strings.TrimSpace(input.Asset); {
+	case asset == "":
+	case strings.EqualFold(asset, "native"), strings.EqualFold(asset, "XLM"):
+		filter.Asset = "native"
+	default:
+		parts := strings.Split(asset, ":")
+		if len(parts) != 2 || parts[0] == "" || !isAccountAddress(parts[1]) {
+			return filter, errors.NewValidationError("Invalid history filter", "asset must be native or CODE:ISSUER")
 		}
+		filter.Asset = asset
 	}
 
-	// 8. Determine pagination info
-	hasNext := len(transactionsPage.Embedded.Records) == int(limit)
-	nextCursor := ""
-	if hasNext && len(transactions) > 0 {
-		nextCursor = transactions[len(transactions)-1].ID
+	if input.Counterparty != "" {
+		if !isAccountAddress(input.Counterparty) {
+			return filter, errors.NewValidationError("Invalid history filter", "counterparty must be a Stellar account address")
+		}
+		filter.Counterparty = input.Counterparty
 	}
 
-	uc.logger.Info("transaction history fetched successfully",
-		logger.String("wallet_id", w.ID.String()),
-		logger.Int("transaction_count", len(transactions)),
-		logger.Int("payment_count", len(paymentOps)),
-		logger.Bool("has_next", hasNext),
-	)
+	return filter, nil
+}
 
-	return &GetTransactionHistoryOutput{
-		WalletID:     w.ID.String(),
-		PublicKey:    w.PublicKey,
-		Network:      w.Network,
-		Transactions: transactions,
-		Operations:   paymentOps,
-		HasNext:      hasNext,
-		NextCursor:   nextCursor,
-	}, nil
+func isAccountAddress(address string) bool {
+	_, err := keypair.ParseAddress(address)
+	return err == nil
+}
+
+func toTransactionRecord(t *ledger.Transaction) TransactionRecord {
+	return TransactionRecord{
+		ID:             t.ID,
+		Hash:           t.Hash,
+		Ledger:         t.Ledger,
+		CreatedAt:      t.ClosedAt,
+		SourceAccount:  t.SourceAccount,
+		OperationCount: t.OperationCount,
+		Successful:     t.Successful,
+		MaxFee:         t.MaxFee,
+		FeeCharged:     t.FeeCharged,
+		MemoType:       t.MemoType,
+		Memo:           t.Memo,
+	}
+}
+
+func toOperationRecord(op *ledger.Operation) OperationRecord {
+	effects := make([]EffectRecord, 0, len(op.Effects))
+	for _, e := range op.Effects {
+		effects = append(effects, EffectRecord{ID: e.ID, Type: e.Type, Details: e.Details})
+	}
+
+	flows := make([]FlowRecord, 0)
+	for _, f := range ledger.Flows(op.Effects) {
+		flows = append(flows, FlowRecord{
+			Asset:     f.Asset,
+			AmountIn:  f.In.StringFixed(domainStellar.AmountDecimals),
+			AmountOut: f.Out.StringFixed(domainStellar.AmountDecimals),
+			Net:       f.Net().StringFixed(domainStellar.AmountDecimals),
+		})
+	}
+
+	return OperationRecord{
+		ID:              op.ID,
+		Type:            op.Type,
+		CreatedAt:       op.ClosedAt,
+		TransactionHash: op.TransactionHash,
+		Successful:      op.Successful,
+		SourceAccount:   op.SourceAccount,
+		Counterparties:  op.Counterparties,
+		Assets:          op.Assets,
+		Details:         ledger.DecodeDetails(op.Type, op.Details),
+		Flows:           flows,
+		Effects:         effects,
+	}
 }
@@ -11,11 +11,14 @@ import (
 
 // GetWalletOutput represents the output of the get wallet use case
 type GetWalletOutput struct {
-	ID        string `json:"id"`
-	PublicKey string `json:"public_key"`
-	Network   string `json:"network"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	ID         string `json:"id"`
+	PublicKey  string `json:"public_key"`
+	Network    string `json:"network"`
+	Status     string `json:"status"`
+	ClosedAt   string `json:"closed_at,omitempty"`
+	MergedInto string `json:"merged_into,omitempty"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
 }
 
 // GetWalletUseCase handles retrieving a wallet by ID
@@ -38,11 +41,18 @@ func (uc *GetWalletUseCase) Execute(ctx context.Context, walletID uuid.UUID) (*G
 		return nil, fmt.Errorf("wallet not found: %w", err)
 	}
 
-	return &GetWalletOutput{
-		ID:        w.ID.String(),
-		PublicKey: w.PublicKey,
-		Network:   w.Network,
-		CreatedAt: w.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: w.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}, nil
+	output := &GetWalletOutput{
+		ID:         w.ID.String(),
+		PublicKey:  w.PublicKey,
+		Network:    w.Network,
+		Status:     string(w.Status),
+		MergedInto: w.MergedInto,
+		CreatedAt:  w.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:  w.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if w.ClosedAt != nil {
+		output.ClosedAt = w.ClosedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return output, nil
 }
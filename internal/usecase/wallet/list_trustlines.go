@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/internal/infrastructure/stellar"
+
+	"github.com/google/uuid"
+)
+
+// TrustlineOutput represents a single trustline held by a wallet
+type TrustlineOutput struct {
+	AssetType   string `json:"asset_type"`
+	AssetCode   string `json:"asset_code"`
+	AssetIssuer string `json:"asset_issuer"`
+	Balance     string `json:"balance"`
+	Limit       string `json:"limit"`
+}
+
+// ListTrustlinesOutput represents the trustlines held by a wallet
+type ListTrustlinesOutput struct {
+	WalletID   string            `json:"wallet_id"`
+	PublicKey  string            `json:"public_key"`
+	Trustlines []TrustlineOutput `json:"trustlines"`
+}
+
+// ListTrustlinesUseCase lists a wallet's trustlines from its account balances
+type ListTrustlinesUseCase struct {
+	repo          wallet.Repository
+	stellarClient *stellar.Client
+}
+
+// NewListTrustlinesUseCase creates a new list trustlines use case
+func NewListTrustlinesUseCase(repo wallet.Repository, stellarClient *stellar.Client) *ListTrustlinesUseCase {
+	return &ListTrustlinesUseCase{
+		repo:          repo,
+		stellarClient: stellarClient,
+	}
+}
+
+// Execute returns every balance line that carries a trustline limit
+func (uc *ListTrustlinesUseCase) Execute(ctx context.Context, walletID uuid.UUID) (*ListTrustlinesOutput, error) {
+	w, err := uc.repo.FindByID(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	balances, err := uc.stellarClient.GetAccountBalances(ctx, w.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch balance: %w", err)
+	}
+
+	trustlines := make([]TrustlineOutput, 0, len(balances))
+	for _, b := range balances {
+		if b.Limit == nil {
+			continue
+		}
+		trustlines = append(trustlines, TrustlineOutput{
+			AssetType:   b.AssetType,
+			AssetCode:   b.AssetCode,
+			AssetIssuer: b.AssetIssuer,
+			Balance:     b.Amount.String(),
+			Limit:       b.Limit.String(),
+		})
+	}
+
+	return &ListTrustlinesOutput{
+		WalletID:   w.ID.String(),
+		PublicKey:  w.PublicKey,
+		Trustlines: trustlines,
+	}, nil
+}
@@ -12,6 +12,7 @@ type WalletListItem struct {
 	ID        string `json:"id"`
 	PublicKey string `json:"public_key"`
 	Network   string `json:"network"`
+	Status    string `json:"status"`
 	CreatedAt string `json:"created_at"`
 }
 
@@ -67,6 +68,7 @@ func (uc *ListWalletsUseCase) Execute(ctx context.Context, limit, offset int) (*
 			ID:        w.ID.String(),
 			PublicKey: w.PublicKey,
 			Network:   w.Network,
+			Status:    string(w.Status),
 			CreatedAt: w.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		})
 	}
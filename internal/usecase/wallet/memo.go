@@ -0,0 +1,111 @@
+package wallet
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"quasarflow-api/pkg/errors"
+
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/txnbuild"
+)
+
+// Memo types accepted by the API
+const (
+	MemoTypeNone   = "none"
+	MemoTypeText   = "text"
+	MemoTypeID     = "id"
+	MemoTypeHash   = "hash"
+	MemoTypeReturn = "return"
+)
+
+const (
+	// maxMemoTextBytes is the protocol limit for MEMO_TEXT
+	maxMemoTextBytes = 28
+
+	// memoRequiredDataKey is the SEP-29 account data entry signalling that incoming payments need a memo
+	memoRequiredDataKey = "config.memo_required"
+)
+
+// buildMemo validates a memo value against its type and converts it to a txnbuild memo.
+// An empty type defaults to text when a value is present. Hash and return memos accept
+// 32 bytes encoded as hex (64 characters) or base64.
+func buildMemo(memoType, value string) (txnbuild.Memo, string, error) {
+	if memoType == "" {
+		if value == "" {
+			return nil, MemoTypeNone, nil
+		}
+		memoType = MemoTypeText
+	}
+
+	switch memoType {
+	case MemoTypeNone:
+		if value != "" {
+			return nil, "", errors.NewValidationError("Invalid memo", "memo must be empty when memo_type is none")
+		}
+		return nil, MemoTypeNone, nil
+
+	case MemoTypeText:
+		if len(value) > maxMemoTextBytes {
+			return nil, "", errors.NewValidationError("Invalid memo",
+				fmt.Sprintf("text memo must be at most %d bytes", maxMemoTextBytes))
+		}
+		return txnbuild.MemoText(value), MemoTypeText, nil
+
+	case MemoTypeID:
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, "", errors.NewValidationError("Invalid memo", "id memo must be an unsigned 64-bit integer")
+		}
+		return txnbuild.MemoID(id), MemoTypeID, nil
+
+	case MemoTypeHash, MemoTypeReturn:
+		hash, err := decodeMemoHash(value)
+		if err != nil {
+			return nil, "", errors.NewValidationError("Invalid memo",
+				fmt.Sprintf("%s memo must be 32 bytes encoded as hex or base64", memoType))
+		}
+		if memoType == MemoTypeHash {
+			return txnbuild.MemoHash(hash), MemoTypeHash, nil
+		}
+		return txnbuild.MemoReturn(hash), MemoTypeReturn, nil
+
+	default:
+		return nil, "", errors.NewValidationError("Invalid memo type",
+			"memo_type must be one of: none, text, id, hash, return")
+	}
+}
+
+// ValidateMemo checks a memo value for its type and returns the type, which defaults to
+// text when a value is given
+func ValidateMemo(memoType, value string) (string, error) {
+	_, memoType, err := buildMemo(memoType, value)
+	return memoType, err
+}
+
+// decodeMemoHash decodes a 32-byte hash from hex or base64
+func decodeMemoHash(value string) ([32]byte, error) {
+	var hash [32]byte
+
+	decoded, err := hex.DecodeString(value)
+	if err != nil || len(decoded) != len(hash) {
+		decoded, err = base64.StdEncoding.DecodeString(value)
+		if err != nil || len(decoded) != len(hash) {
+			return hash, fmt.Errorf("invalid memo hash")
+		}
+	}
+
+	copy(hash[:], decoded)
+	return hash, nil
+}
+
+// requiresMemo reports whether an account opted into SEP-29 memo-required checks
+func requiresMemo(account *horizon.Account) bool {
+	value, err := account.GetData(memoRequiredDataKey)
+	if err != nil {
+		return false
+	}
+	return string(value) == "1"
+}
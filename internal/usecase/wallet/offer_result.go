@@ -0,0 +1,52 @@
+package wallet
+
+import (
+	"github.com/stellar/go/xdr"
+)
+
+// offerIDFromResult returns the ID of the first DEX offer left on the book by a
+// successful transaction. It is zero when no manage offer operation created or
+// updated an offer, including offers that were fully filled on placement.
+func offerIDFromResult(resultXDR string) int64 {
+	if resultXDR == "" {
+		return 0
+	}
+
+	var result xdr.TransactionResult
+	if err := xdr.SafeUnmarshalBase64(resultXDR, &result); err != nil {
+		return 0
+	}
+
+	opResults, ok := result.OperationResults()
+	if !ok {
+		return 0
+	}
+
+	for _, opResult := range opResults {
+		tr, ok := opResult.GetTr()
+		if !ok {
+			continue
+		}
+
+		var success xdr.ManageOfferSuccessResult
+		switch tr.Type {
+		case xdr.OperationTypeManageSellOffer:
+			success, ok = tr.MustManageSellOfferResult().GetSuccess()
+		case xdr.OperationTypeManageBuyOffer:
+			success, ok = tr.MustManageBuyOfferResult().GetSuccess()
+		case xdr.OperationTypeCreatePassiveSellOffer:
+			success, ok = tr.MustCreatePassiveSellOfferResult().GetSuccess()
+		default:
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		if offer, ok := success.Offer.GetOffer(); ok {
+			return int64(offer.OfferId)
+		}
+	}
+
+	return 0
+}
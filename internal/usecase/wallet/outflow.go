@@ -0,0 +1,245 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"quasarflow-api/internal/domain/addressbook"
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/internal/domain/spending"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/txnbuild"
+)
+
+// OutflowGuard applies the controls on payments - address book allow-lists, spending
+// limits and approval policies - to the other operations that move funds out of a wallet
+type OutflowGuard struct {
+	addressBook addressbook.Repository
+	limits      spending.Repository
+	approvals   approval.Repository
+	logger      logger.Logger
+}
+
+// NewOutflowGuard creates a new outflow guard
+func NewOutflowGuard(
+	addressBook addressbook.Repository,
+	limits spending.Repository,
+	approvals approval.Repository,
+	logger logger.Logger,
+) *OutflowGuard {
+	return &OutflowGuard{
+		addressBook: addressBook,
+		limits:      limits,
+		approvals:   approvals,
+		logger:      logger,
+	}
+}
+
+// CheckDestination refuses a destination outside the address books in allow-list only
+// mode, as for payments
+func (g *OutflowGuard) CheckDestination(ctx context.Context, walletID uuid.UUID, userID, address, memo, memoType string) error {
+	return checkAllowList(ctx, g.addressBook, g.logger, walletID, userID, address, memo, memoType)
+}
+
+// CheckNoAllowList refuses operations without a destination to check, such as DEX offers,
+// while the wallet's or the caller's address book is in allow-list only mode
+func (g *OutflowGuard) CheckNoAllowList(ctx context.Context, walletID uuid.UUID, userID string) error {
+	enforced, err := allowListEnforced(ctx, g.addressBook, walletID, userID, time.Now())
+	if err != nil {
+		return err
+	}
+	if enforced {
+		g.logger.Warn("operation refused by address book",
+			logger.String("wallet_id", walletID.String()),
+			logger.String("user_id", userID))
+		return errors.ErrOutflowRestricted
+	}
+	return nil
+}
+
+// CheckUnlimited refuses operations whose amounts cannot be reserved, such as DEX offers
+// and closing the wallet, while a spending limit or an approval policy covers the asset.
+// An empty asset checks the policies of every asset.
+func (g *OutflowGuard) CheckUnlimited(ctx context.Context, walletID uuid.UUID, userID, asset string) error {
+	policies, err := g.limits.ListApplicable(ctx, walletID, userID)
+	if err != nil {
+		return errors.NewInternalError("Failed to check spending limits", err)
+	}
+	for _, p := range policies {
+		if asset == "" || p.Asset == "" || p.Asset == asset {
+			g.logger.Warn("operation refused by spending limit",
+				logger.String("wallet_id", walletID.String()),
+				logger.String("user_id", userID),
+				logger.String("policy_id", p.ID.String()))
+			return errors.ErrOutflowRestricted
+		}
+	}
+
+	var approvals []*approval.Policy
+	if asset == "" {
+		approvals, err = g.approvals.ListPolicies(ctx, walletID)
+	} else {
+		var p *approval.Policy
+		if p, err = g.approvals.FindPolicy(ctx, walletID, asset); p != nil {
+			approvals = append(approvals, p)
+		}
+	}
+	if err != nil {
+		return errors.NewInternalError("Failed to check approval policy", err)
+	}
+	if len(approvals) > 0 {
+		g.logger.Warn("operation refused by approval policy",
+			logger.String("wallet_id", walletID.String()),
+			logger.String("policy_id", approvals[0].ID.String()))
+		return errors.ErrOutflowRestricted
+	}
+
+	return nil
+}
+
+// Reserve reserves an amount sent to known destinations against the spending limits,
+// like a payment. Only payments can be held for approval, so an amount the wallet's
+// approval policy would hold is refused. The spend must be attached to the signed
+// transaction, or released if none is signed.
+func (g *OutflowGuard) Reserve(ctx context.Context, w *wallet.Wallet, userID, asset string, amount decimal.Decimal) (*spending.Spend, error) {
+	policy, err := g.approvals.FindPolicy(ctx, w.ID, asset)
+	if err != nil {
+		return nil, errors.NewInternalError("Failed to check approval policy", err)
+	}
+	if policy != nil && policy.Requires(amount) {
+		g.logger.Warn("operation refused by approval policy",
+			logger.String("wallet_id", w.ID.String()),
+			logger.String("policy_id", policy.ID.String()),
+			logger.String("amount", amount.String()))
+		return nil, errors.ErrApprovalRequired
+	}
+
+	spend := spending.NewSpend(w.ID, userID, asset, amount)
+	if err := reserveSpend(ctx, g.limits, g.logger, spend); err != nil {
+		return nil, err
+	}
+	return spend, nil
+}
+
+// Attach links a reserved spend to its signed transaction; the transaction must not be
+// submitted if it fails
+func (g *OutflowGuard) Attach(ctx context.Context, spend *spending.Spend, transactionHash string) error {
+	return attachSpend(ctx, g.limits, g.logger, spend, transactionHash)
+}
+
+// Release frees a reserved spend whose transaction was never signed
+func (g *OutflowGuard) Release(ctx context.Context, spend *spending.Spend) {
+	releaseSpend(ctx, g.limits, g.logger, spend)
+}
+
+// AssetKey returns the name spending limits and approval policies use for an asset:
+// "native" or "CODE:ISSUER"
+func AssetKey(asset txnbuild.Asset) string {
+	if asset.IsNative() {
+		return "native"
+	}
+	return asset.GetCode() + ":" + asset.GetIssuer()
+}
+
+// allowListEnforced reports whether the wallet's or the user's address book is in
+// allow-list only mode
+func allowListEnforced(ctx context.Context, book addressbook.Repository, walletID uuid.UUID, userID string, now time.Time) (bool, error) {
+	books := map[string]string{addressbook.ScopeWallet: walletID.String()}
+	if userID != "" {
+		books[addressbook.ScopeUser] = userID
+	}
+
+	enforced := false
+	for scope, subjectID := range books {
+		mode, err := book.GetMode(ctx, scope, subjectID)
+		if err != nil {
+			return false, errors.NewInternalError("Failed to check address book", err)
+		}
+		enforced = enforced || mode.Enforced(now)
+	}
+	return enforced, nil
+}
+
+// checkAllowList refuses a destination that matches no usable entry of the wallet's or
+// the caller's address book when either book is in allow-list only mode
+func checkAllowList(ctx context.Context, book addressbook.Repository, log logger.Logger, walletID uuid.UUID, userID, address, memo, memoType string) error {
+	now := time.Now()
+	enforced, err := allowListEnforced(ctx, book, walletID, userID, now)
+	if err != nil || !enforced {
+		return err
+	}
+
+	entries, err := book.FindForPayment(ctx, walletID, userID, address)
+	if err != nil {
+		return errors.NewInternalError("Failed to check address book", err)
+	}
+
+	coolingDown := false
+	for _, e := range entries {
+		if !e.Matches(address, memo, memoType) {
+			continue
+		}
+		if e.Usable(now) {
+			return nil
+		}
+		coolingDown = true
+	}
+
+	log.Warn("payment refused by address book",
+		logger.String("wallet_id", walletID.String()),
+		logger.String("user_id", userID),
+		logger.String("destination", address),
+		logger.Bool("cooling_down", coolingDown))
+
+	if coolingDown {
+		return errors.ErrAddressBookEntryCoolingDown
+	}
+	return errors.ErrDestinationNotAllowed
+}
+
+// reserveSpend reserves a spend against the wallet's and caller's spending limits
+func reserveSpend(ctx context.Context, limits spending.Repository, log logger.Logger, spend *spending.Spend) error {
+	violation, err := limits.Reserve(ctx, spend)
+	if err != nil {
+		log.Error("failed to check spending limits", logger.Error(err))
+		return errors.NewInternalError("Failed to check spending limits", err)
+	}
+	if violation != nil {
+		log.Warn("payment refused by spending limit",
+			logger.String("wallet_id", spend.WalletID.String()),
+			logger.String("user_id", spend.UserID),
+			logger.String("policy_id", violation.Policy.ID.String()),
+			logger.String("limit", violation.Limit))
+		return errors.NewLimitExceededError(violation.Error())
+	}
+	return nil
+}
+
+// attachSpend links a spend to its signed transaction. Once attached, the spend stops
+// counting if the submission fails or expires. A spend without a submission stops counting
+// after a while, so the transaction must not be submitted when attaching fails; the spend is
+// released instead.
+func attachSpend(ctx context.Context, limits spending.Repository, log logger.Logger, spend *spending.Spend, transactionHash string) error {
+	if err := limits.Attach(ctx, spend.ID, transactionHash); err != nil {
+		log.Error("failed to attach transaction to spend",
+			logger.String("spend_id", spend.ID.String()),
+			logger.Error(err))
+		releaseSpend(ctx, limits, log, spend)
+		return errors.NewInternalError("Failed to record spending", err)
+	}
+	return nil
+}
+
+// releaseSpend frees the amount reserved for a transaction that was never signed
+func releaseSpend(ctx context.Context, limits spending.Repository, log logger.Logger, spend *spending.Spend) {
+	if err := limits.Release(ctx, spend.ID); err != nil {
+		log.Error("failed to release spend",
+			logger.String("spend_id", spend.ID.String()),
+			logger.Error(err))
+	}
+}
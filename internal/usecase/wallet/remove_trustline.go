@@ -0,0 +1,110 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+// RemoveTrustlineInput represents a request to remove a trustline
+type RemoveTrustlineInput struct {
+	WalletID    uuid.UUID `json:"wallet_id"`
+	AssetCode   string    `json:"asset_code" validate:"required"`
+	AssetIssuer string    `json:"asset_issuer" validate:"required"`
+	MaxFee      int64     `json:"max_fee,omitempty"`
+}
+
+// RemoveTrustlineUseCase removes an empty trustline, releasing its base reserve
+type RemoveTrustlineUseCase struct {
+	repo          wallet.Repository
+	horizonClient *horizonclient.Client
+	txBuilder     *TransactionBuilder
+	logger        logger.Logger
+}
+
+// NewRemoveTrustlineUseCase creates a new remove trustline use case
+func NewRemoveTrustlineUseCase(
+	repo wallet.Repository,
+	horizonClient *horizonclient.Client,
+	txBuilder *TransactionBuilder,
+	logger logger.Logger,
+) *RemoveTrustlineUseCase {
+	return &RemoveTrustlineUseCase{
+		repo:          repo,
+		horizonClient: horizonClient,
+		txBuilder:     txBuilder,
+		logger:        logger,
+	}
+}
+
+// Execute validates that the trustline is empty and submits ChangeTrust with a zero limit.
+// The network would reject the operation otherwise; checking first gives a precise error.
+func (uc *RemoveTrustlineUseCase) Execute(ctx context.Context, input RemoveTrustlineInput) (*ChangeTrustOutput, error) {
+	// 1. Validate asset
+	asset, err := parseCreditAsset(input.AssetCode, input.AssetIssuer)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Find wallet
+	w, err := uc.repo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+
+	// 3. Load the trustline
+	account, err := uc.horizonClient.AccountDetail(horizonclient.AccountRequest{AccountID: w.PublicKey})
+	if err != nil {
+		uc.logger.Error("failed to load wallet account", logger.Error(err))
+		return nil, fmt.Errorf("failed to load wallet account: %w", err)
+	}
+
+	line := findBalance(&account, asset.Code, asset.Issuer)
+	if line == nil {
+		return nil, errors.ErrTrustlineNotFound
+	}
+
+	// 4. Balance and liabilities must be zero
+	if !decimal.RequireFromString(line.Balance).IsZero() {
+		return nil, errors.ErrTrustlineNotEmpty
+	}
+	if !decimal.RequireFromString(line.BuyingLiabilities).IsZero() ||
+		!decimal.RequireFromString(line.SellingLiabilities).IsZero() {
+		return nil, errors.ErrTrustlineHasLiabilities
+	}
+
+	// 5. No open offers may buy or sell the asset
+	for _, req := range []horizonclient.OfferRequest{
+		{ForAccount: w.PublicKey, Selling: assetParam(asset), Limit: 1},
+		{ForAccount: w.PublicKey, Buying: assetParam(asset), Limit: 1},
+	} {
+		offers, err := uc.horizonClient.Offers(req)
+		if err != nil {
+			uc.logger.Error("failed to load offers", logger.Error(err))
+			return nil, fmt.Errorf("failed to load offers: %w", err)
+		}
+		if len(offers.Embedded.Records) > 0 {
+			return nil, errors.ErrTrustlineHasOffers
+		}
+	}
+
+	// 6. Build, sign and submit ChangeTrust with a zero limit
+	output, err := submitChangeTrust(ctx, uc.txBuilder, w, asset, "0", input.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("trustline removed",
+		logger.String("wallet_id", w.ID.String()),
+		logger.String("asset", assetParam(asset)),
+		logger.String("hash", output.TransactionHash))
+
+	return output, nil
+}
@@ -3,62 +3,115 @@ package wallet
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"quasarflow-api/internal/domain/addressbook"
+	"quasarflow-api/internal/domain/approval"
+	"quasarflow-api/internal/domain/federation"
+	"quasarflow-api/internal/domain/spending"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
 	"quasarflow-api/internal/domain/wallet"
-	"quasarflow-api/internal/infrastructure/crypto"
+	"quasarflow-api/pkg/errors"
 	"quasarflow-api/pkg/logger"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stellar/go/clients/horizonclient"
-	"github.com/stellar/go/keypair"
-	"github.com/stellar/go/network"
 	"github.com/stellar/go/txnbuild"
 )
 
 type SendPaymentInput struct {
-	FromWalletID uuid.UUID `json:"from_wallet_id" validate:"required"`
-	ToAddress    string    `json:"to_address" validate:"required"`
-	Amount       string    `json:"amount" validate:"required"`
-	AssetCode    string    `json:"asset_code,omitempty"` // Optional, defaults to XLM
-	AssetIssuer  string    `json:"asset_issuer,omitempty"`
-	Memo         string    `json:"memo,omitempty"`
+	FromWalletID uuid.UUID  `json:"from_wallet_id" validate:"required"`
+	ToAddress    string     `json:"to_address" validate:"required"` // Account ID or federation address (name*domain)
+	Amount       string     `json:"amount" validate:"required"`
+	AssetCode    string     `json:"asset_code,omitempty"` // Optional, defaults to XLM
+	AssetIssuer  string     `json:"asset_issuer,omitempty"`
+	Memo         string     `json:"memo,omitempty"`
+	MemoType     string     `json:"memo_type,omitempty"`   // none, text (default), id, hash or return
+	MaxFee       int64      `json:"max_fee,omitempty"`     // Optional per-operation fee cap in stroops
+	ValidUntil   *time.Time `json:"valid_until,omitempty"` // Optional, defaults to the configured timeout
+	MinLedger    uint32     `json:"min_ledger,omitempty"`  // Optional ledger bound precondition
+	MaxLedger    uint32     `json:"max_ledger,omitempty"`  // Optional ledger bound precondition
+	UserID       string     `json:"-"`                     // Authenticated caller, checked against user spending limits
 }
 
 type SendPaymentOutput struct {
-	TransactionHash string `json:"transaction_hash"`
-	FromAddress     string `json:"from_address"`
-	ToAddress       string `json:"to_address"`
-	Amount          string `json:"amount"`
-	AssetCode       string `json:"asset_code"`
-	AssetIssuer     string `json:"asset_issuer,omitempty"`
-	Memo            string `json:"memo,omitempty"`
-	Network         string `json:"network"`
-	Ledger          int32  `json:"ledger"`
-	Success         bool   `json:"success"`
+	SubmissionID      string `json:"submission_id"`
+	TransactionHash   string `json:"transaction_hash"`
+	FederationAddress string `json:"federation_address,omitempty"`
+	Operation         string `json:"operation"` // "payment", or "create_account" for unfunded destinations
+	FromAddress       string `json:"from_address"`
+	ToAddress         string `json:"to_address"`
+	Amount            string `json:"amount"`
+	AssetCode         string `json:"asset_code"`
+	AssetIssuer       string `json:"asset_issuer,omitempty"`
+	Memo              string `json:"memo,omitempty"`
+	MemoType          string `json:"memo_type"`
+	Network           string `json:"network"`
+	Ledger            int32  `json:"ledger"`
+	BaseFee           int64  `json:"base_fee"`
+	FeeCharged        int64  `json:"fee_charged"`
+	ValidUntil        string `json:"valid_until"`
+	Success           bool   `json:"success"`
+	PendingApproval   bool   `json:"pending_approval,omitempty"`    // Held for approval; nothing was signed
+	ApprovalRequestID string `json:"approval_request_id,omitempty"` // Set with pending_approval
+}
+
+// PaymentHolder holds payments for approval before they are signed
+type PaymentHolder interface {
+	// Hold creates an approval request when the wallet's policy for the asset requires
+	// approval of the amount, and returns nil otherwise
+	Hold(ctx context.Context, w *wallet.Wallet, input SendPaymentInput, asset string, amount decimal.Decimal) (*approval.Request, error)
 }
 
 type SendPaymentUseCase struct {
 	repo          wallet.Repository
 	horizonClient *horizonclient.Client
-	encryptor     crypto.Encryptor
+	txBuilder     *TransactionBuilder
+	federation    federation.Resolver
+	limits        spending.Repository
+	addressBook   addressbook.Repository
+	holder        PaymentHolder
 	logger        logger.Logger
 }
 
 func NewSendPaymentUseCase(
 	repo wallet.Repository,
 	horizonClient *horizonclient.Client,
-	encryptor crypto.Encryptor,
+	txBuilder *TransactionBuilder,
+	federationResolver federation.Resolver,
+	limits spending.Repository,
+	addressBook addressbook.Repository,
+	holder PaymentHolder,
 	logger logger.Logger,
 ) *SendPaymentUseCase {
 	return &SendPaymentUseCase{
 		repo:          repo,
 		horizonClient: horizonClient,
-		encryptor:     encryptor,
+		txBuilder:     txBuilder,
+		federation:    federationResolver,
+		limits:        limits,
+		addressBook:   addressBook,
+		holder:        holder,
 		logger:        logger,
 	}
 }
 
+// Execute sends a payment, or holds it for approval when the wallet's approval policy
+// requires it
 func (uc *SendPaymentUseCase) Execute(ctx context.Context, input SendPaymentInput) (*SendPaymentOutput, error) {
+	return uc.execute(ctx, input, true, nil)
+}
+
+// ExecuteApproved sends a payment whose approval request reached its quorum. Every other
+// check still applies. onSigned is called with the transaction hash before submitting;
+// an error from it cancels the payment.
+func (uc *SendPaymentUseCase) ExecuteApproved(ctx context.Context, input SendPaymentInput, onSigned func(hash string) error) (*SendPaymentOutput, error) {
+	return uc.execute(ctx, input, false, onSigned)
+}
+
+func (uc *SendPaymentUseCase) execute(ctx context.Context, input SendPaymentInput, hold bool, onSigned func(hash string) error) (*SendPaymentOutput, error) {
 	// 1. Find source wallet
 	sourceWallet, err := uc.repo.FindByID(ctx, input.FromWalletID)
 	if err != nil {
@@ -66,47 +119,16 @@ func (uc *SendPaymentUseCase) Execute(ctx context.Context, input SendPaymentInpu
 		return nil, fmt.Errorf("source wallet not found: %w", err)
 	}
 
-	// 2. Decrypt private key
-	decryptedSeed, err := uc.encryptor.Decrypt(sourceWallet.EncryptedKey)
-	if err != nil {
-		uc.logger.Error("failed to decrypt private key", logger.Error(err))
-		return nil, fmt.Errorf("failed to decrypt private key: %w", err)
-	}
-
-	// 3. Create keypair from seed
-	sourceKeypair, err := keypair.ParseFull(decryptedSeed)
-	if err != nil {
-		uc.logger.Error("failed to parse keypair", logger.Error(err))
-		return nil, fmt.Errorf("failed to parse keypair: %w", err)
-	}
-
-	// 4. Get network passphrase
-	var networkPassphrase string
-	switch sourceWallet.Network {
-	case "mainnet":
-		networkPassphrase = network.PublicNetworkPassphrase
-	case "testnet":
-		networkPassphrase = network.TestNetworkPassphrase
-	case "local":
-		networkPassphrase = "Standalone Network ; February 2017"
-	default:
-		return nil, fmt.Errorf("unsupported network: %s", sourceWallet.Network)
-	}
-
-	// 5. Load source account
-	accountRequest := horizonclient.AccountRequest{
-		AccountID: sourceKeypair.Address(),
-	}
-
-	sourceAccount, err := uc.horizonClient.AccountDetail(accountRequest)
+	amount, err := domainStellar.ParseAmount(input.Amount)
 	if err != nil {
-		uc.logger.Error("failed to load source account", logger.Error(err))
-		return nil, fmt.Errorf("failed to load source account: %w", err)
+		return nil, errors.ErrInvalidAmount
 	}
 
-	// 6. Create asset (default to native XLM)
+	// 2. Create asset (default to native XLM)
+	isNative := input.AssetCode == "" || input.AssetCode == "XLM"
 	var asset txnbuild.Asset
-	if input.AssetCode == "" || input.AssetCode == "XLM" {
+	assetKey, assetCode, assetIssuer := "native", "XLM", ""
+	if isNative {
 		asset = txnbuild.NativeAsset{}
 	} else {
 		if input.AssetIssuer == "" {
@@ -116,89 +138,210 @@ func (uc *SendPaymentUseCase) Execute(ctx context.Context, input SendPaymentInpu
 			Code:   input.AssetCode,
 			Issuer: input.AssetIssuer,
 		}
+		assetKey = input.AssetCode + ":" + input.AssetIssuer
+		assetCode, assetIssuer = input.AssetCode, input.AssetIssuer
 	}
 
-	// 7. Create payment operation
-	paymentOp := &txnbuild.Payment{
-		Destination: input.ToAddress,
-		Amount:      input.Amount,
-		Asset:       asset,
+	// 3. Resolve federation addresses (SEP-2), applying the memo the federation server requires
+	federationAddress := ""
+	if federation.IsAddress(input.ToAddress) {
+		record, err := uc.federation.Resolve(ctx, input.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		federationAddress = record.StellarAddress
+		input.ToAddress = record.AccountID
+		if err := applyFederationMemo(&input, record); err != nil {
+			return nil, err
+		}
 	}
 
-	// 8. Build transaction
-	txParams := txnbuild.TransactionParams{
-		SourceAccount:        &sourceAccount,
-		IncrementSequenceNum: true,
-		Operations:           []txnbuild.Operation{paymentOp},
-		BaseFee:              txnbuild.MinBaseFee,
-		Preconditions: txnbuild.Preconditions{
-			TimeBounds: txnbuild.NewInfiniteTimeout(),
-		},
+	// 4. Validate memo for its type
+	memo, memoType, err := buildMemo(input.MemoType, input.Memo)
+	if err != nil {
+		return nil, err
 	}
 
-	// Add memo if provided
-	if input.Memo != "" {
-		txParams.Memo = txnbuild.MemoText(input.Memo)
+	// 5. Refuse destinations outside the address books in allow-list only mode
+	if err := checkAllowList(ctx, uc.addressBook, uc.logger, sourceWallet.ID, input.UserID, input.ToAddress, input.Memo, memoType); err != nil {
+		return nil, err
 	}
 
-	tx, err := txnbuild.NewTransaction(txParams)
+	// 6. Check the destination before submitting so op_no_destination never reaches Horizon
+	destination, err := loadDestination(uc.horizonClient, input.ToAddress)
 	if err != nil {
-		uc.logger.Error("failed to build transaction", logger.Error(err))
-		return nil, fmt.Errorf("failed to build transaction: %w", err)
+		uc.logger.Error("failed to load destination account", logger.Error(err))
+		return nil, err
 	}
 
-	// 9. Sign transaction
-	tx, err = tx.Sign(networkPassphrase, sourceKeypair)
+	// 7. Create payment operation, or create the account for native payments to unfunded destinations
+	var op txnbuild.Operation
+	kind := submission.KindPayment
+	switch {
+	case destination == nil && isNative:
+		if amount.LessThan(minimumAccountBalance) {
+			return nil, errors.ErrCreateAccountAmountTooLow
+		}
+
+		uc.logger.Info("destination account does not exist, creating it",
+			logger.String("destination", input.ToAddress),
+			logger.String("amount", input.Amount))
+
+		op = &txnbuild.CreateAccount{
+			Destination: input.ToAddress,
+			Amount:      input.Amount,
+		}
+		kind = submission.KindCreateAccount
+	case destination == nil:
+		return nil, errors.ErrDestinationNotFound
+	default:
+		// SEP-29: refuse to pay accounts that require a memo without one
+		if memo == nil && requiresMemo(destination) {
+			return nil, errors.ErrMemoRequired
+		}
+
+		if !isNative {
+			if err := checkTrustline(destination, input.AssetCode, input.AssetIssuer); err != nil {
+				return nil, err
+			}
+		}
+
+		op = &txnbuild.Payment{
+			Destination: input.ToAddress,
+			Amount:      input.Amount,
+			Asset:       asset,
+		}
+	}
+
+	// 8. Hold payments above the wallet's approval threshold until approvers approve them
+	if hold {
+		request, err := uc.holder.Hold(ctx, sourceWallet, input, assetKey, amount)
+		if err != nil {
+			return nil, err
+		}
+		if request != nil {
+			return &SendPaymentOutput{
+				FederationAddress: federationAddress,
+				Operation:         kind,
+				FromAddress:       sourceWallet.PublicKey,
+				ToAddress:         input.ToAddress,
+				Amount:            input.Amount,
+				AssetCode:         assetCode,
+				AssetIssuer:       assetIssuer,
+				Memo:              input.Memo,
+				MemoType:          memoType,
+				Network:           sourceWallet.Network,
+				PendingApproval:   true,
+				ApprovalRequestID: request.ID.String(),
+			}, nil
+		}
+	}
+
+	// 9. Reserve the amount against the wallet's and caller's spending limits before signing
+	spend := spending.NewSpend(sourceWallet.ID, input.UserID, assetKey, amount)
+	if err := reserveSpend(ctx, uc.limits, uc.logger, spend); err != nil {
+		return nil, err
+	}
+
+	// 10. Build and sign transaction
+	params := BuildTransactionParams{
+		Wallet:     sourceWallet,
+		Kind:       kind,
+		Operations: []txnbuild.Operation{op},
+		Memo:       memo,
+		MaxFee:     input.MaxFee,
+		ValidUntil: input.ValidUntil,
+		MinLedger:  input.MinLedger,
+		MaxLedger:  input.MaxLedger,
+	}
+
+	signed, err := uc.txBuilder.Build(ctx, params)
 	if err != nil {
-		uc.logger.Error("failed to sign transaction", logger.Error(err))
-		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+		releaseSpend(ctx, uc.limits, uc.logger, spend)
+		return nil, err
+	}
+	if onSigned != nil {
+		if err := onSigned(signed.Hash); err != nil {
+			releaseSpend(ctx, uc.limits, uc.logger, spend)
+			return nil, err
+		}
 	}
 
-	// 10. Submit transaction
+	if err := attachSpend(ctx, uc.limits, uc.logger, spend, signed.Hash); err != nil {
+		return nil, err
+	}
+
+	// 11. Submit transaction
 	uc.logger.Info("submitting payment transaction",
-		logger.String("from", sourceKeypair.Address()),
+		logger.String("operation", kind),
+		logger.String("from", signed.SourceAddress),
 		logger.String("to", input.ToAddress),
 		logger.String("amount", input.Amount),
 		logger.String("asset", input.AssetCode),
+		logger.Int64("base_fee", signed.BaseFee),
+		logger.String("valid_until", signed.ValidUntil.Format(time.RFC3339)),
 	)
 
-	resp, err := uc.horizonClient.SubmitTransaction(tx)
+	resp, err := uc.txBuilder.Submit(ctx, signed)
 	if err != nil {
-		uc.logger.Error("failed to submit transaction", logger.Error(err))
-
-		// Try to get more details from Horizon error
-		if horizonErr, ok := err.(*horizonclient.Error); ok {
-			return nil, fmt.Errorf("transaction failed: %s (code: %d)", horizonErr.Problem.Detail, horizonErr.Problem.Status)
-		}
-
-		return nil, fmt.Errorf("failed to submit transaction: %w", err)
-	}
-
-	// 11. Parse response
-	assetCode := "XLM"
-	assetIssuer := ""
-	if input.AssetCode != "" && input.AssetCode != "XLM" {
-		assetCode = input.AssetCode
-		assetIssuer = input.AssetIssuer
+		return nil, err
 	}
 
+	// 12. Parse response
 	uc.logger.Info("payment transaction successful",
 		logger.String("hash", resp.Hash),
 		logger.Int32("ledger", resp.Ledger),
-		logger.String("from", sourceKeypair.Address()),
+		logger.String("from", signed.SourceAddress),
 		logger.String("to", input.ToAddress),
 	)
 
 	return &SendPaymentOutput{
-		TransactionHash: resp.Hash,
-		FromAddress:     sourceKeypair.Address(),
-		ToAddress:       input.ToAddress,
-		Amount:          input.Amount,
-		AssetCode:       assetCode,
-		AssetIssuer:     assetIssuer,
-		Memo:            input.Memo,
-		Network:         sourceWallet.Network,
-		Ledger:          resp.Ledger,
-		Success:         resp.Successful,
+		SubmissionID:      resp.SubmissionID.String(),
+		TransactionHash:   resp.Hash,
+		FederationAddress: federationAddress,
+		Operation:         kind,
+		FromAddress:       signed.SourceAddress,
+		ToAddress:         input.ToAddress,
+		Amount:            input.Amount,
+		AssetCode:         assetCode,
+		AssetIssuer:       assetIssuer,
+		Memo:              input.Memo,
+		MemoType:          memoType,
+		Network:           sourceWallet.Network,
+		Ledger:            resp.Ledger,
+		BaseFee:           signed.BaseFee,
+		FeeCharged:        resp.FeeCharged,
+		ValidUntil:        signed.ValidUntil.Format(time.RFC3339),
+		Success:           true,
 	}, nil
 }
+
+// applyFederationMemo copies the memo returned by a federation server into the
+// payment. A client supplied memo is only accepted when it matches.
+func applyFederationMemo(input *SendPaymentInput, record *federation.Record) error {
+	if record.Memo == "" {
+		return nil
+	}
+
+	recordType := record.MemoType
+	if recordType == "" {
+		recordType = MemoTypeText
+	}
+
+	if input.Memo == "" {
+		input.Memo = record.Memo
+		input.MemoType = recordType
+		return nil
+	}
+
+	inputType := input.MemoType
+	if inputType == "" {
+		inputType = MemoTypeText
+	}
+	if input.Memo != record.Memo || inputType != recordType {
+		return errors.ErrFederationMemoMismatch
+	}
+
+	return nil
+}
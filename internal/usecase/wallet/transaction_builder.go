@@ -0,0 +1,428 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+	domainStellar "quasarflow-api/internal/domain/stellar"
+	"quasarflow-api/internal/domain/submission"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/internal/infrastructure/crypto"
+	submissionUC "quasarflow-api/internal/usecase/submission"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/txnbuild"
+)
+
+const (
+	defaultTransactionTimeout = 30 * time.Second
+	maxTransactionTimeout     = 5 * time.Minute
+)
+
+// TimeoutConfig bounds how long a built transaction remains valid
+type TimeoutConfig struct {
+	Default time.Duration // Used when the caller does not supply valid_until
+	Max     time.Duration // Latest valid_until a caller may request, relative to now
+}
+
+// TransactionBuilder builds, signs and submits transactions for managed wallets.
+// Every use case that writes to the ledger goes through it so fee, time bound
+// and signing policy are applied consistently and every submission is tracked.
+// It publishes transaction.failed for rejected submissions and trustline.changed
+// for the ChangeTrust operations of successful ones.
+type TransactionBuilder struct {
+	horizonClient *horizonclient.Client
+	encryptor     crypto.Encryptor
+	feeEstimator  domainStellar.FeeEstimator
+	submissions   submission.Repository
+	events        event.Publisher
+	timeouts      TimeoutConfig
+	logger        logger.Logger
+}
+
+// BuildTransactionParams describes a transaction to be built for a managed wallet
+type BuildTransactionParams struct {
+	Wallet     *wallet.Wallet
+	Kind       string // Submission kind recorded for tracking
+	Operations []txnbuild.Operation
+	Memo       txnbuild.Memo
+	MaxFee     int64            // Per-operation fee cap in stroops, zero uses the configured default
+	ValidUntil *time.Time       // Optional upper time bound, defaults to now + default timeout
+	MinLedger  uint32           // Optional lower ledger bound
+	MaxLedger  uint32           // Optional upper ledger bound
+	OfferID    int64            // DEX offer being updated or cancelled; new offers are read from the result
+	CoSigners  []*wallet.Wallet // Other managed wallets that are the source of an operation, e.g. in sponsorships
+}
+
+// SignedTransaction is a transaction ready to be submitted to Horizon
+type SignedTransaction struct {
+	Tx            *txnbuild.Transaction
+	Hash          string
+	SourceAddress string
+	Network       string
+	BaseFee       int64
+	ValidUntil    time.Time
+
+	walletID  uuid.UUID
+	kind      string
+	minLedger uint32
+	maxLedger uint32
+	offerID   int64
+	signers   map[string]uuid.UUID // Managed wallet of each signing account
+}
+
+// SubmitResult is the outcome of a successful submission
+type SubmitResult struct {
+	SubmissionID uuid.UUID
+	Hash         string
+	Ledger       int32
+	FeeCharged   int64
+	OfferID      int64 // DEX offer left on the order book by the transaction, zero if none
+}
+
+// NewTransactionBuilder creates a new transaction builder
+func NewTransactionBuilder(
+	horizonClient *horizonclient.Client,
+	encryptor crypto.Encryptor,
+	feeEstimator domainStellar.FeeEstimator,
+	submissions submission.Repository,
+	events event.Publisher,
+	timeouts TimeoutConfig,
+	logger logger.Logger,
+) *TransactionBuilder {
+	if timeouts.Max <= 0 {
+		timeouts.Max = maxTransactionTimeout
+	}
+	if timeouts.Default <= 0 || timeouts.Default > timeouts.Max {
+		timeouts.Default = defaultTransactionTimeout
+	}
+
+	return &TransactionBuilder{
+		horizonClient: horizonClient,
+		encryptor:     encryptor,
+		feeEstimator:  feeEstimator,
+		submissions:   submissions,
+		events:        events,
+		timeouts:      timeouts,
+		logger:        logger,
+	}
+}
+
+// Build loads the wallet's account, estimates the base fee and returns a signed transaction
+func (b *TransactionBuilder) Build(ctx context.Context, params BuildTransactionParams) (*SignedTransaction, error) {
+	// 1. Resolve preconditions before touching keys or the network
+	validUntil, err := b.resolveValidUntil(params.ValidUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.MaxLedger != 0 && params.MinLedger > params.MaxLedger {
+		return nil, errors.ErrInvalidLedgerBounds
+	}
+
+	if params.Wallet.IsClosed() {
+		return nil, errors.ErrWalletClosed
+	}
+	for _, coSigner := range params.CoSigners {
+		if coSigner.IsClosed() {
+			return nil, errors.ErrWalletClosed
+		}
+	}
+
+	// 2. Decrypt private key and create keypair
+	sourceKeypair, err := b.walletKeypair(params.Wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Every co-signer must be on the same network as the source wallet
+	for _, coSigner := range params.CoSigners {
+		if coSigner.Network != params.Wallet.Network {
+			return nil, fmt.Errorf("co-signer wallet %s is on %s, not %s", coSigner.ID, coSigner.Network, params.Wallet.Network)
+		}
+	}
+
+	// 4. Get network passphrase
+	passphrase, err := networkPassphrase(params.Wallet.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. Load source account
+	sourceAccount, err := b.horizonClient.AccountDetail(horizonclient.AccountRequest{
+		AccountID: sourceKeypair.Address(),
+	})
+	if err != nil {
+		b.logger.Error("failed to load source account", logger.Error(err))
+		return nil, fmt.Errorf("failed to load source account: %w", err)
+	}
+
+	// 6. Estimate base fee from current network conditions
+	fee, err := b.feeEstimator.EstimateFee(ctx, params.MaxFee)
+	if err != nil {
+		b.logger.Error("failed to estimate fee", logger.Error(err))
+		return nil, fmt.Errorf("failed to estimate fee: %w", err)
+	}
+	if fee.Capped {
+		b.logger.Warn("base fee capped below network percentile",
+			logger.Int64("base_fee", fee.BaseFee),
+			logger.Int("percentile", fee.Percentile))
+	}
+
+	// 7. Build transaction
+	preconditions := txnbuild.Preconditions{
+		TimeBounds: txnbuild.NewTimebounds(0, validUntil.Unix()),
+	}
+	if params.MinLedger != 0 || params.MaxLedger != 0 {
+		preconditions.LedgerBounds = &txnbuild.LedgerBounds{
+			MinLedger: params.MinLedger,
+			MaxLedger: params.MaxLedger,
+		}
+	}
+
+	txParams := txnbuild.TransactionParams{
+		SourceAccount:        &sourceAccount,
+		IncrementSequenceNum: true,
+		Operations:           params.Operations,
+		BaseFee:              fee.BaseFee,
+		Memo:                 params.Memo,
+		Preconditions:        preconditions,
+	}
+
+	tx, err := txnbuild.NewTransaction(txParams)
+	if err != nil {
+		b.logger.Error("failed to build transaction", logger.Error(err))
+		return nil, fmt.Errorf("failed to build transaction: %w", err)
+	}
+
+	// 8. Sign transaction with the source wallet and any co-signers
+	signers := []*keypair.Full{sourceKeypair}
+	signerWallets := map[string]uuid.UUID{sourceKeypair.Address(): params.Wallet.ID}
+	for _, coSigner := range params.CoSigners {
+		kp, err := b.walletKeypair(coSigner)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, kp)
+		signerWallets[kp.Address()] = coSigner.ID
+	}
+
+	tx, err = tx.Sign(passphrase, signers...)
+	if err != nil {
+		b.logger.Error("failed to sign transaction", logger.Error(err))
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	hash, err := tx.HashHex(passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash transaction: %w", err)
+	}
+
+	return &SignedTransaction{
+		Tx:            tx,
+		Hash:          hash,
+		SourceAddress: sourceKeypair.Address(),
+		Network:       params.Wallet.Network,
+		BaseFee:       fee.BaseFee,
+		ValidUntil:    validUntil,
+		walletID:      params.Wallet.ID,
+		kind:          params.Kind,
+		minLedger:     params.MinLedger,
+		maxLedger:     params.MaxLedger,
+		offerID:       params.OfferID,
+		signers:       signerWallets,
+	}, nil
+}
+
+// Submit records the transaction as a pending submission, sends it to Horizon and
+// updates the submission with the outcome. Transactions rejected with tx_too_late
+// are marked expired; Horizon timeouts leave the submission pending so the expiry
+// job can settle it once its time bounds pass.
+func (b *TransactionBuilder) Submit(ctx context.Context, signed *SignedTransaction) (*SubmitResult, error) {
+	envelope, err := signed.Tx.Base64()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	record, err := submission.NewSubmission(signed.walletID, signed.kind, signed.Hash, envelope, signed.ValidUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create submission record: %w", err)
+	}
+	record.MinLedger = signed.minLedger
+	record.MaxLedger = signed.maxLedger
+	record.OfferID = signed.offerID
+
+	if err := b.submissions.Create(ctx, record); err != nil {
+		b.logger.Error("failed to save submission", logger.Error(err))
+		return nil, fmt.Errorf("failed to save submission: %w", err)
+	}
+
+	resp, err := b.horizonClient.SubmitTransaction(signed.Tx)
+	if err != nil {
+		b.logger.Error("failed to submit transaction",
+			logger.Error(err),
+			logger.String("hash", signed.Hash))
+		submitErr := b.recordSubmitError(ctx, record, err)
+		if record.IsFinal() {
+			b.publish(ctx, submissionUC.FailureEvent(record, signed.SourceAddress))
+		}
+		return nil, submitErr
+	}
+
+	record.MarkSucceeded(resp.Ledger)
+	openOfferID := offerIDFromResult(resp.ResultXdr)
+	if openOfferID != 0 {
+		record.OfferID = openOfferID
+	}
+	if err := b.submissions.Update(ctx, record); err != nil {
+		// The transaction is on the ledger; a stale record is settled by the expiry job
+		b.logger.Warn("failed to update submission", logger.Error(err), logger.String("hash", signed.Hash))
+	}
+
+	b.publishTrustlineChanges(ctx, signed, resp.Ledger)
+
+	return &SubmitResult{
+		SubmissionID: record.ID,
+		Hash:         resp.Hash,
+		Ledger:       resp.Ledger,
+		FeeCharged:   resp.FeeCharged,
+		OfferID:      openOfferID,
+	}, nil
+}
+
+// recordSubmitError classifies a Horizon submission error and persists the outcome
+func (b *TransactionBuilder) recordSubmitError(ctx context.Context, record *submission.Submission, submitErr error) error {
+	horizonErr, ok := submitErr.(*horizonclient.Error)
+	if !ok {
+		// Outcome unknown (network error); leave pending for the expiry job
+		return fmt.Errorf("failed to submit transaction: %w", submitErr)
+	}
+
+	if horizonErr.Problem.Status == http.StatusGatewayTimeout {
+		return errors.ErrSubmissionPending
+	}
+
+	resultCode := ""
+	if codes, err := horizonErr.ResultCodes(); err == nil && codes != nil {
+		resultCode = codes.TransactionCode
+	}
+
+	var result error
+	if resultCode == "tx_too_late" {
+		record.MarkExpired()
+		result = errors.ErrTransactionExpired
+	} else {
+		record.MarkFailed(resultCode, horizonErr.Problem.Detail)
+		result = fmt.Errorf("transaction failed: %s (code: %d)", horizonErr.Problem.Detail, horizonErr.Problem.Status)
+	}
+
+	if err := b.submissions.Update(ctx, record); err != nil {
+		b.logger.Warn("failed to update submission", logger.Error(err), logger.String("hash", record.Hash))
+	}
+
+	return result
+}
+
+// publishTrustlineChanges publishes trustline.changed for every ChangeTrust operation
+// on a credit asset whose source is one of the transaction's managed signers
+func (b *TransactionBuilder) publishTrustlineChanges(ctx context.Context, signed *SignedTransaction, ledger int32) {
+	for i, op := range signed.Tx.Operations() {
+		changeTrust, ok := op.(*txnbuild.ChangeTrust)
+		if !ok || changeTrust.Line.GetCode() == "" {
+			continue
+		}
+
+		source := changeTrust.SourceAccount
+		if source == "" {
+			source = signed.SourceAddress
+		}
+		walletID, managed := signed.signers[source]
+		if !managed {
+			continue
+		}
+
+		limit := changeTrust.Limit
+		if limit == "" {
+			limit = txnbuild.MaxTrustlineLimit
+		}
+		action := event.TrustlineLimitSet
+		if limit == "0" {
+			action = event.TrustlineRemoved
+		}
+
+		e := event.New(event.TypeTrustlineChanged, walletID, fmt.Sprintf("%s:%d", signed.Hash, i))
+		e.PublicKey = source
+		e.Trustline = &event.Trustline{
+			Action:          action,
+			AssetCode:       changeTrust.Line.GetCode(),
+			AssetIssuer:     changeTrust.Line.GetIssuer(),
+			Limit:           limit,
+			TransactionHash: signed.Hash,
+			Ledger:          ledger,
+		}
+		b.publish(ctx, e)
+	}
+}
+
+// publish delivers an event; the ledger outcome stands even if a subscriber fails
+func (b *TransactionBuilder) publish(ctx context.Context, e *event.Event) {
+	if err := b.events.Publish(ctx, e); err != nil {
+		b.logger.Warn("failed to publish event",
+			logger.Error(err),
+			logger.String("type", string(e.Type)),
+			logger.String("event_id", e.ID.String()))
+	}
+}
+
+// walletKeypair decrypts a managed wallet's seed
+func (b *TransactionBuilder) walletKeypair(w *wallet.Wallet) (*keypair.Full, error) {
+	decryptedSeed, err := b.encryptor.Decrypt(w.EncryptedKey)
+	if err != nil {
+		b.logger.Error("failed to decrypt private key", logger.Error(err))
+		return nil, fmt.Errorf("failed to decrypt private key: %w", err)
+	}
+
+	kp, err := keypair.ParseFull(decryptedSeed)
+	if err != nil {
+		b.logger.Error("failed to parse keypair", logger.Error(err))
+		return nil, fmt.Errorf("failed to parse keypair: %w", err)
+	}
+
+	return kp, nil
+}
+
+// resolveValidUntil validates a caller supplied expiry or applies the default timeout
+func (b *TransactionBuilder) resolveValidUntil(requested *time.Time) (time.Time, error) {
+	now := time.Now()
+	if requested == nil {
+		return now.Add(b.timeouts.Default), nil
+	}
+
+	if !requested.After(now) || requested.After(now.Add(b.timeouts.Max)) {
+		return time.Time{}, errors.ErrInvalidValidUntil
+	}
+
+	return *requested, nil
+}
+
+// networkPassphrase returns the Stellar network passphrase for a wallet network
+func networkPassphrase(walletNetwork string) (string, error) {
+	switch walletNetwork {
+	case "mainnet":
+		return network.PublicNetworkPassphrase, nil
+	case "testnet":
+		return network.TestNetworkPassphrase, nil
+	case "local":
+		return "Standalone Network ; February 2017", nil
+	default:
+		return "", fmt.Errorf("unsupported network: %s", walletNetwork)
+	}
+}
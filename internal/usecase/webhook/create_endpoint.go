@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/internal/domain/webhook"
+	"quasarflow-api/internal/infrastructure/crypto"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+	"quasarflow-api/pkg/safehttp"
+
+	"github.com/google/uuid"
+)
+
+// CreateEndpointInput represents a request to register a webhook endpoint
+type CreateEndpointInput struct {
+	OwnerID     string      `json:"-"`
+	URL         string      `json:"url"`
+	Description string      `json:"description,omitempty"`
+	EventTypes  []string    `json:"event_types"`
+	WalletIDs   []uuid.UUID `json:"wallet_ids,omitempty"` // Optional; empty delivers events of every wallet
+}
+
+// CreateEndpointUseCase registers a webhook endpoint and generates its signing secret
+type CreateEndpointUseCase struct {
+	endpoints webhook.EndpointRepository
+	wallets   wallet.Repository
+	encryptor crypto.Encryptor
+	logger    logger.Logger
+}
+
+// NewCreateEndpointUseCase creates a new create endpoint use case
+func NewCreateEndpointUseCase(
+	endpoints webhook.EndpointRepository,
+	wallets wallet.Repository,
+	encryptor crypto.Encryptor,
+	logger logger.Logger,
+) *CreateEndpointUseCase {
+	return &CreateEndpointUseCase{
+		endpoints: endpoints,
+		wallets:   wallets,
+		encryptor: encryptor,
+		logger:    logger,
+	}
+}
+
+// Execute registers the endpoint; the secret is only returned in this response
+func (uc *CreateEndpointUseCase) Execute(ctx context.Context, input CreateEndpointInput) (*EndpointOutput, error) {
+	// 1. Validate URL, event types and wallets
+	eventTypes, err := validateSubscription(ctx, uc.wallets, input.URL, input.EventTypes, input.WalletIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Generate and encrypt the signing secret
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedSecret, err := uc.encryptor.Encrypt(secret)
+	if err != nil {
+		uc.logger.Error("failed to encrypt webhook secret", logger.Error(err))
+		return nil, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	// 3. Save the endpoint
+	e, err := webhook.NewEndpoint(input.OwnerID, input.URL, input.Description, eventTypes, input.WalletIDs, encryptedSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+
+	if err := uc.endpoints.Create(ctx, e); err != nil {
+		uc.logger.Error("failed to save webhook endpoint", logger.Error(err))
+		return nil, fmt.Errorf("failed to save webhook endpoint: %w", err)
+	}
+
+	uc.logger.Info("webhook endpoint created",
+		logger.String("webhook_id", e.ID.String()),
+		logger.String("owner_id", e.OwnerID),
+		logger.String("url", e.URL))
+
+	output := toEndpointOutput(e)
+	output.Secret = secret
+	return &output, nil
+}
+
+// validateSubscription checks an endpoint's URL, event types and wallet filter
+func validateSubscription(ctx context.Context, wallets wallet.Repository, rawURL string, eventTypeNames []string, walletIDs []uuid.UUID) ([]event.Type, error) {
+	if !webhook.IsValidURL(rawURL) {
+		return nil, errors.ErrInvalidWebhookURL
+	}
+	if err := safehttp.CheckURL(rawURL); err != nil {
+		return nil, errors.NewValidationError("Invalid webhook URL", err.Error())
+	}
+
+	if len(eventTypeNames) == 0 {
+		return nil, errors.ErrInvalidWebhookEventTypes
+	}
+	eventTypes := parseEventTypes(eventTypeNames)
+	for _, t := range eventTypes {
+		if !event.IsValidType(t) {
+			return nil, errors.ErrInvalidWebhookEventTypes
+		}
+	}
+
+	for _, id := range walletIDs {
+		w, err := wallets.FindByID(ctx, id)
+		if err != nil {
+			return nil, errors.NewNotFoundError(fmt.Sprintf("Wallet %s not found", id))
+		}
+		if w.IsClosed() {
+			return nil, errors.ErrWalletClosed
+		}
+	}
+
+	return eventTypes, nil
+}
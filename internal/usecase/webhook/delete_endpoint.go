@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/webhook"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// DeleteEndpointUseCase removes a webhook endpoint together with its deliveries and their logs
+type DeleteEndpointUseCase struct {
+	endpoints webhook.EndpointRepository
+	logger    logger.Logger
+}
+
+// NewDeleteEndpointUseCase creates a new delete endpoint use case
+func NewDeleteEndpointUseCase(endpoints webhook.EndpointRepository, logger logger.Logger) *DeleteEndpointUseCase {
+	return &DeleteEndpointUseCase{
+		endpoints: endpoints,
+		logger:    logger,
+	}
+}
+
+// Execute deletes an endpoint owned by ownerID
+func (uc *DeleteEndpointUseCase) Execute(ctx context.Context, id uuid.UUID, ownerID string) error {
+	e, err := findOwnedEndpoint(ctx, uc.endpoints, id, ownerID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.endpoints.Delete(ctx, e.ID); err != nil {
+		uc.logger.Error("failed to delete webhook endpoint", logger.Error(err))
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+
+	uc.logger.Info("webhook endpoint deleted", logger.String("webhook_id", e.ID.String()))
+	return nil
+}
@@ -0,0 +1,256 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"quasarflow-api/internal/domain/webhook"
+	"quasarflow-api/internal/infrastructure/crypto"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// deliveryBatchSize limits how many deliveries are sent per run
+	deliveryBatchSize = 100
+
+	// deliveryConcurrency limits how many requests are in flight at once
+	deliveryConcurrency = 10
+
+	defaultMaxAttempts  = 8
+	defaultRetryBackoff = 30 * time.Second
+	defaultMaxBackoff   = 6 * time.Hour
+)
+
+// DeliveryConfig controls retries of failed deliveries
+type DeliveryConfig struct {
+	MaxAttempts  int           // Attempts before a delivery moves to the dead-letter queue
+	RetryBackoff time.Duration // Delay after the first failure, doubled after each further failure
+	MaxBackoff   time.Duration
+	Timeout      time.Duration // Request timeout; claimed deliveries are leased for a little longer
+}
+
+// DeliverOutput summarizes one run of the delivery worker
+type DeliverOutput struct {
+	Sent      int
+	Delivered int
+	Failed    int
+	Dead      int
+}
+
+// DeliverUseCase sends due deliveries from the outbox. Non-2xx answers and network
+// errors are retried with exponential backoff; after the maximum attempts the delivery
+// is dead and stays in the dead-letter queue until it is redelivered.
+type DeliverUseCase struct {
+	endpoints  webhook.EndpointRepository
+	deliveries webhook.DeliveryRepository
+	sender     webhook.Sender
+	encryptor  crypto.Encryptor
+	config     DeliveryConfig
+	logger     logger.Logger
+}
+
+// NewDeliverUseCase creates a new deliver use case
+func NewDeliverUseCase(
+	endpoints webhook.EndpointRepository,
+	deliveries webhook.DeliveryRepository,
+	sender webhook.Sender,
+	encryptor crypto.Encryptor,
+	config DeliveryConfig,
+	logger logger.Logger,
+) *DeliverUseCase {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = defaultRetryBackoff
+	}
+	if config.MaxBackoff < config.RetryBackoff {
+		config.MaxBackoff = defaultMaxBackoff
+	}
+
+	return &DeliverUseCase{
+		endpoints:  endpoints,
+		deliveries: deliveries,
+		sender:     sender,
+		encryptor:  encryptor,
+		config:     config,
+		logger:     logger,
+	}
+}
+
+// Execute sends one batch of due deliveries
+func (uc *DeliverUseCase) Execute(ctx context.Context) (*DeliverOutput, error) {
+	due, err := uc.deliveries.ClaimDue(ctx, deliveryBatchSize, uc.config.Timeout+30*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	output := &DeliverOutput{}
+	if len(due) == 0 {
+		return output, nil
+	}
+
+	// Endpoints are loaded once per batch
+	endpoints := make(map[uuid.UUID]*webhook.Endpoint)
+	loadErrors := make(map[uuid.UUID]error)
+	for _, d := range due {
+		if _, ok := endpoints[d.EndpointID]; ok {
+			continue
+		}
+		e, err := uc.endpoints.FindByID(ctx, d.EndpointID)
+		if err != nil {
+			uc.logger.Warn("failed to load webhook endpoint",
+				logger.String("webhook_id", d.EndpointID.String()),
+				logger.Error(err))
+			loadErrors[d.EndpointID] = err
+		}
+		endpoints[d.EndpointID] = e
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, deliveryConcurrency)
+	)
+	for _, d := range due {
+		endpoint := endpoints[d.EndpointID]
+		if endpoint == nil {
+			// Counted as a failed attempt so a delivery whose endpoint cannot be loaded
+			// ends in the dead-letter queue instead of being claimed again forever
+			message := "webhook endpoint could not be loaded"
+			if err := loadErrors[d.EndpointID]; err != nil {
+				message += ": " + err.Error()
+			}
+			uc.fail(ctx, d, message)
+			if d.Status == webhook.DeliveryDead {
+				output.Dead++
+			} else {
+				output.Failed++
+			}
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(d *webhook.Delivery) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			uc.deliver(ctx, endpoint, d)
+
+			mu.Lock()
+			defer mu.Unlock()
+			output.Sent++
+			switch d.Status {
+			case webhook.DeliveryDelivered:
+				output.Delivered++
+			case webhook.DeliveryDead:
+				output.Dead++
+			default:
+				output.Failed++
+			}
+		}(d)
+	}
+	wg.Wait()
+
+	uc.logger.Info("webhook deliveries sent",
+		logger.Int("sent", output.Sent),
+		logger.Int("delivered", output.Delivered),
+		logger.Int("failed", output.Failed),
+		logger.Int("dead", output.Dead))
+
+	return output, nil
+}
+
+// deliver signs and sends one delivery and records the attempt
+func (uc *DeliverUseCase) deliver(ctx context.Context, endpoint *webhook.Endpoint, d *webhook.Delivery) {
+	if !endpoint.Active {
+		d.Abandon("webhook endpoint is disabled")
+		if err := uc.deliveries.Update(ctx, d); err != nil {
+			uc.logger.Error("failed to update webhook delivery", logger.Error(err), logger.String("delivery_id", d.ID.String()))
+		}
+		return
+	}
+
+	// A secret that cannot be decrypted will not decrypt on a retry either; the delivery
+	// is dead-lettered and can be redelivered once the secret is rotated
+	secret, err := uc.encryptor.Decrypt(endpoint.EncryptedSecret)
+	if err != nil {
+		uc.logger.Error("failed to decrypt webhook secret", logger.Error(err), logger.String("webhook_id", endpoint.ID.String()))
+		d.Abandon("webhook secret could not be decrypted")
+		if err := uc.deliveries.Update(ctx, d); err != nil {
+			uc.logger.Error("failed to update webhook delivery", logger.Error(err), logger.String("delivery_id", d.ID.String()))
+		}
+		return
+	}
+
+	now := time.Now()
+	headers := map[string]string{
+		HeaderDeliveryID: d.ID.String(),
+		HeaderEventID:    d.EventID.String(),
+		HeaderEventType:  string(d.EventType),
+		HeaderTimestamp:  fmt.Sprintf("%d", now.Unix()),
+		HeaderSignature:  Sign(secret, now, d.Payload),
+	}
+
+	resp, sendErr := uc.sender.Send(ctx, endpoint.URL, headers, d.Payload)
+
+	attempt := &webhook.Attempt{
+		ID:         uuid.New(),
+		DeliveryID: d.ID,
+		Number:     d.Attempts + 1,
+		Duration:   time.Since(now),
+		CreatedAt:  now,
+	}
+
+	switch {
+	case sendErr != nil:
+		attempt.Error = sendErr.Error()
+		d.MarkFailed(0, attempt.Error, uc.config.MaxAttempts, uc.retryAt(d.Attempts+1))
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		attempt.StatusCode = resp.StatusCode
+		attempt.ResponseBody = resp.Body
+		d.MarkDelivered(resp.StatusCode)
+	default:
+		attempt.StatusCode = resp.StatusCode
+		attempt.ResponseBody = resp.Body
+		attempt.Error = fmt.Sprintf("endpoint responded with status %d", resp.StatusCode)
+		d.MarkFailed(resp.StatusCode, attempt.Error, uc.config.MaxAttempts, uc.retryAt(d.Attempts+1))
+	}
+
+	if d.Status == webhook.DeliveryDead {
+		uc.logger.Warn("webhook delivery moved to dead-letter queue",
+			logger.String("delivery_id", d.ID.String()),
+			logger.String("webhook_id", endpoint.ID.String()),
+			logger.Int("attempts", d.Attempts),
+			logger.String("last_error", d.LastError))
+	}
+
+	if err := uc.deliveries.RecordAttempt(ctx, d, attempt); err != nil {
+		uc.logger.Error("failed to record webhook attempt", logger.Error(err), logger.String("delivery_id", d.ID.String()))
+	}
+}
+
+// fail records a failed attempt that was not sent, retrying it later like a failed request
+func (uc *DeliverUseCase) fail(ctx context.Context, d *webhook.Delivery, message string) {
+	d.MarkFailed(0, message, uc.config.MaxAttempts, uc.retryAt(d.Attempts+1))
+	if err := uc.deliveries.Update(ctx, d); err != nil {
+		uc.logger.Error("failed to update webhook delivery", logger.Error(err), logger.String("delivery_id", d.ID.String()))
+	}
+}
+
+// retryAt returns when to retry after the given number of failed attempts
+func (uc *DeliverUseCase) retryAt(failures int) time.Time {
+	backoff := uc.config.RetryBackoff
+	for i := 1; i < failures && backoff < uc.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > uc.config.MaxBackoff {
+		backoff = uc.config.MaxBackoff
+	}
+	return time.Now().Add(backoff)
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/webhook"
+	eventUC "quasarflow-api/internal/usecase/event"
+	"quasarflow-api/pkg/logger"
+)
+
+// EnqueueUseCase writes a delivery to the outbox for every endpoint subscribed to an event.
+// It is subscribed to the event bus, so an event is only considered published once its
+// deliveries are stored; the delivery worker sends them afterwards.
+type EnqueueUseCase struct {
+	endpoints  webhook.EndpointRepository
+	deliveries webhook.DeliveryRepository
+	logger     logger.Logger
+}
+
+// NewEnqueueUseCase creates a new enqueue use case
+func NewEnqueueUseCase(
+	endpoints webhook.EndpointRepository,
+	deliveries webhook.DeliveryRepository,
+	logger logger.Logger,
+) *EnqueueUseCase {
+	return &EnqueueUseCase{
+		endpoints:  endpoints,
+		deliveries: deliveries,
+		logger:     logger,
+	}
+}
+
+// Execute queues the event. Events seen before are not queued twice for the same endpoint.
+func (uc *EnqueueUseCase) Execute(ctx context.Context, e *event.Event) error {
+	endpoints, err := uc.endpoints.ListSubscribed(ctx, e.Type)
+	if err != nil {
+		return fmt.Errorf("failed to list subscribed webhooks: %w", err)
+	}
+
+	var payload []byte
+	for _, endpoint := range endpoints {
+		if !endpoint.Subscribes(e) {
+			continue
+		}
+
+		if payload == nil {
+			if payload, err = json.Marshal(eventUC.ToEventOutput(e)); err != nil {
+				return fmt.Errorf("failed to encode event: %w", err)
+			}
+		}
+
+		queued, err := uc.deliveries.Enqueue(ctx, webhook.NewDelivery(endpoint.ID, e.ID, e.Type, payload))
+		if err != nil {
+			return err
+		}
+
+		if queued {
+			uc.logger.Debug("webhook delivery queued",
+				logger.String("webhook_id", endpoint.ID.String()),
+				logger.String("event_id", e.ID.String()),
+				logger.String("type", string(e.Type)))
+		}
+	}
+
+	return nil
+}
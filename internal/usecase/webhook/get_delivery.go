@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+// GetDeliveryUseCase retrieves a delivery with its payload and attempt log
+type GetDeliveryUseCase struct {
+	endpoints  webhook.EndpointRepository
+	deliveries webhook.DeliveryRepository
+}
+
+// NewGetDeliveryUseCase creates a new get delivery use case
+func NewGetDeliveryUseCase(endpoints webhook.EndpointRepository, deliveries webhook.DeliveryRepository) *GetDeliveryUseCase {
+	return &GetDeliveryUseCase{
+		endpoints:  endpoints,
+		deliveries: deliveries,
+	}
+}
+
+// Execute retrieves a delivery of one of ownerID's webhooks
+func (uc *GetDeliveryUseCase) Execute(ctx context.Context, webhookID, deliveryID uuid.UUID, ownerID string) (*DeliveryOutput, error) {
+	d, err := findOwnedDelivery(ctx, uc.endpoints, uc.deliveries, webhookID, deliveryID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := uc.deliveries.ListAttempts(ctx, d.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook attempts: %w", err)
+	}
+
+	output := toDeliveryOutput(d, true)
+	output.AttemptLog = make([]AttemptOutput, 0, len(attempts))
+	for _, a := range attempts {
+		output.AttemptLog = append(output.AttemptLog, toAttemptOutput(a))
+	}
+
+	return &output, nil
+}
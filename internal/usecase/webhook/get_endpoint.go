@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+
+	"quasarflow-api/internal/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+// GetEndpointUseCase handles retrieving one of the user's webhook endpoints
+type GetEndpointUseCase struct {
+	endpoints webhook.EndpointRepository
+}
+
+// NewGetEndpointUseCase creates a new get endpoint use case
+func NewGetEndpointUseCase(endpoints webhook.EndpointRepository) *GetEndpointUseCase {
+	return &GetEndpointUseCase{
+		endpoints: endpoints,
+	}
+}
+
+// Execute retrieves an endpoint owned by ownerID
+func (uc *GetEndpointUseCase) Execute(ctx context.Context, id uuid.UUID, ownerID string) (*EndpointOutput, error) {
+	e, err := findOwnedEndpoint(ctx, uc.endpoints, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := toEndpointOutput(e)
+	return &output, nil
+}
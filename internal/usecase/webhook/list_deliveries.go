@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/webhook"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ListDeliveriesInput represents a request for a webhook's delivery log
+type ListDeliveriesInput struct {
+	WebhookID uuid.UUID
+	OwnerID   string
+	Status    string // Optional filter; dead lists the dead-letter queue
+	Limit     int
+	Offset    int
+}
+
+// ListDeliveriesOutput represents a page of deliveries
+type ListDeliveriesOutput struct {
+	Deliveries []DeliveryOutput `json:"deliveries"`
+	Total      int64            `json:"total"`
+	Limit      int              `json:"limit"`
+	Offset     int              `json:"offset"`
+}
+
+// ListDeliveriesUseCase lists the deliveries queued for one of the user's webhooks
+type ListDeliveriesUseCase struct {
+	endpoints  webhook.EndpointRepository
+	deliveries webhook.DeliveryRepository
+}
+
+// NewListDeliveriesUseCase creates a new list deliveries use case
+func NewListDeliveriesUseCase(endpoints webhook.EndpointRepository, deliveries webhook.DeliveryRepository) *ListDeliveriesUseCase {
+	return &ListDeliveriesUseCase{
+		endpoints:  endpoints,
+		deliveries: deliveries,
+	}
+}
+
+// Execute retrieves a paginated list of the webhook's deliveries, newest first
+func (uc *ListDeliveriesUseCase) Execute(ctx context.Context, input ListDeliveriesInput) (*ListDeliveriesOutput, error) {
+	status := webhook.DeliveryStatus(input.Status)
+	switch status {
+	case "", webhook.DeliveryPending, webhook.DeliveryDelivered, webhook.DeliveryDead:
+	default:
+		return nil, errors.NewValidationError("Invalid status", "status must be pending, delivered or dead")
+	}
+
+	if _, err := findOwnedEndpoint(ctx, uc.endpoints, input.WebhookID, input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	limit, offset := normalizePage(input.Limit, input.Offset)
+
+	deliveries, err := uc.deliveries.ListByEndpoint(ctx, input.WebhookID, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	total, err := uc.deliveries.CountByEndpoint(ctx, input.WebhookID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	items := make([]DeliveryOutput, 0, len(deliveries))
+	for _, d := range deliveries {
+		items = append(items, toDeliveryOutput(d, false))
+	}
+
+	return &ListDeliveriesOutput{
+		Deliveries: items,
+		Total:      total,
+		Limit:      limit,
+		Offset:     offset,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/webhook"
+)
+
+// ListEndpointsInput represents a request for the user's webhook endpoints
+type ListEndpointsInput struct {
+	OwnerID string
+	Limit   int
+	Offset  int
+}
+
+// ListEndpointsOutput represents a page of webhook endpoints
+type ListEndpointsOutput struct {
+	Webhooks []EndpointOutput `json:"webhooks"`
+	Total    int64            `json:"total"`
+	Limit    int              `json:"limit"`
+	Offset   int              `json:"offset"`
+}
+
+// ListEndpointsUseCase lists the webhook endpoints a user registered
+type ListEndpointsUseCase struct {
+	endpoints webhook.EndpointRepository
+}
+
+// NewListEndpointsUseCase creates a new list endpoints use case
+func NewListEndpointsUseCase(endpoints webhook.EndpointRepository) *ListEndpointsUseCase {
+	return &ListEndpointsUseCase{
+		endpoints: endpoints,
+	}
+}
+
+// Execute retrieves a paginated list of the user's endpoints, newest first
+func (uc *ListEndpointsUseCase) Execute(ctx context.Context, input ListEndpointsInput) (*ListEndpointsOutput, error) {
+	limit, offset := normalizePage(input.Limit, input.Offset)
+
+	endpoints, err := uc.endpoints.ListByOwner(ctx, input.OwnerID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+
+	total, err := uc.endpoints.CountByOwner(ctx, input.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhook endpoints: %w", err)
+	}
+
+	items := make([]EndpointOutput, 0, len(endpoints))
+	for _, e := range endpoints {
+		items = append(items, toEndpointOutput(e))
+	}
+
+	return &ListEndpointsOutput{
+		Webhooks: items,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+// normalizePage applies the default and maximum page size
+func normalizePage(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
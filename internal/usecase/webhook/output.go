@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"quasarflow-api/internal/domain/event"
+	"quasarflow-api/internal/domain/webhook"
+	"quasarflow-api/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// EndpointOutput represents a registered webhook endpoint
+type EndpointOutput struct {
+	ID          string   `json:"id"`
+	URL         string   `json:"url"`
+	Description string   `json:"description,omitempty"`
+	EventTypes  []string `json:"event_types"`
+	WalletIDs   []string `json:"wallet_ids"`
+	Active      bool     `json:"active"`
+	Secret      string   `json:"secret,omitempty"` // Only returned when the secret is created or rotated
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// DeliveryOutput represents one event queued for an endpoint
+type DeliveryOutput struct {
+	ID             string          `json:"id"`
+	WebhookID      string          `json:"webhook_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  string          `json:"next_attempt_at,omitempty"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	Payload        json.RawMessage `json:"payload,omitempty"`
+	CreatedAt      string          `json:"created_at"`
+	UpdatedAt      string          `json:"updated_at"`
+	DeliveredAt    string          `json:"delivered_at,omitempty"`
+	AttemptLog     []AttemptOutput `json:"attempt_log,omitempty"`
+}
+
+// AttemptOutput represents one logged HTTP request of a delivery
+type AttemptOutput struct {
+	Number       int    `json:"number"`
+	StatusCode   int    `json:"status_code,omitempty"`
+	Error        string `json:"error,omitempty"`
+	ResponseBody string `json:"response_body,omitempty"`
+	DurationMs   int64  `json:"duration_ms"`
+	CreatedAt    string `json:"created_at"`
+}
+
+func toEndpointOutput(e *webhook.Endpoint) EndpointOutput {
+	eventTypes := make([]string, 0, len(e.EventTypes))
+	for _, t := range e.EventTypes {
+		eventTypes = append(eventTypes, string(t))
+	}
+
+	walletIDs := make([]string, 0, len(e.WalletIDs))
+	for _, id := range e.WalletIDs {
+		walletIDs = append(walletIDs, id.String())
+	}
+
+	return EndpointOutput{
+		ID:          e.ID.String(),
+		URL:         e.URL,
+		Description: e.Description,
+		EventTypes:  eventTypes,
+		WalletIDs:   walletIDs,
+		Active:      e.Active,
+		CreatedAt:   e.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   e.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// toDeliveryOutput converts a delivery; the payload is only included in single-delivery responses
+func toDeliveryOutput(d *webhook.Delivery, withPayload bool) DeliveryOutput {
+	output := DeliveryOutput{
+		ID:             d.ID.String(),
+		WebhookID:      d.EndpointID.String(),
+		EventID:        d.EventID.String(),
+		EventType:      string(d.EventType),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      d.UpdatedAt.Format(time.RFC3339),
+	}
+	if d.Status == webhook.DeliveryPending {
+		output.NextAttemptAt = d.NextAttemptAt.Format(time.RFC3339)
+	}
+	if d.DeliveredAt != nil {
+		output.DeliveredAt = d.DeliveredAt.Format(time.RFC3339)
+	}
+	if withPayload {
+		output.Payload = json.RawMessage(d.Payload)
+	}
+	return output
+}
+
+func toAttemptOutput(a *webhook.Attempt) AttemptOutput {
+	return AttemptOutput{
+		Number:       a.Number,
+		StatusCode:   a.StatusCode,
+		Error:        a.Error,
+		ResponseBody: a.ResponseBody,
+		DurationMs:   a.Duration.Milliseconds(),
+		CreatedAt:    a.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// findOwnedEndpoint loads an endpoint, hiding endpoints of other users as not found
+func findOwnedEndpoint(ctx context.Context, endpoints webhook.EndpointRepository, id uuid.UUID, ownerID string) (*webhook.Endpoint, error) {
+	e, err := endpoints.FindByID(ctx, id)
+	if err != nil || e.OwnerID != ownerID {
+		return nil, errors.ErrWebhookNotFound
+	}
+	return e, nil
+}
+
+// findOwnedDelivery loads a delivery of one of the owner's endpoints
+func findOwnedDelivery(ctx context.Context, endpoints webhook.EndpointRepository, deliveries webhook.DeliveryRepository, endpointID, deliveryID uuid.UUID, ownerID string) (*webhook.Delivery, error) {
+	if _, err := findOwnedEndpoint(ctx, endpoints, endpointID, ownerID); err != nil {
+		return nil, err
+	}
+
+	d, err := deliveries.FindByID(ctx, deliveryID)
+	if err != nil || d.EndpointID != endpointID {
+		return nil, errors.ErrWebhookDeliveryNotFound
+	}
+	return d, nil
+}
+
+// parseEventTypes converts requested event type names
+func parseEventTypes(names []string) []event.Type {
+	types := make([]event.Type, 0, len(names))
+	for _, name := range names {
+		types = append(types, event.Type(name))
+	}
+	return types
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/webhook"
+	"quasarflow-api/pkg/errors"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// RedeliverUseCase queues a delivered or dead delivery again, e.g. to drain the
+// dead-letter queue once an endpoint is fixed
+type RedeliverUseCase struct {
+	endpoints  webhook.EndpointRepository
+	deliveries webhook.DeliveryRepository
+	logger     logger.Logger
+}
+
+// NewRedeliverUseCase creates a new redeliver use case
+func NewRedeliverUseCase(
+	endpoints webhook.EndpointRepository,
+	deliveries webhook.DeliveryRepository,
+	logger logger.Logger,
+) *RedeliverUseCase {
+	return &RedeliverUseCase{
+		endpoints:  endpoints,
+		deliveries: deliveries,
+		logger:     logger,
+	}
+}
+
+// Execute resets the delivery's attempts and makes it due immediately. The original
+// payload and event ID are kept so receivers can deduplicate.
+func (uc *RedeliverUseCase) Execute(ctx context.Context, webhookID, deliveryID uuid.UUID, ownerID string) (*DeliveryOutput, error) {
+	d, err := findOwnedDelivery(ctx, uc.endpoints, uc.deliveries, webhookID, deliveryID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Redeliver(); err != nil {
+		return nil, errors.ErrWebhookDeliveryPending
+	}
+
+	if err := uc.deliveries.Update(ctx, d); err != nil {
+		uc.logger.Error("failed to update webhook delivery", logger.Error(err))
+		return nil, fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	uc.logger.Info("webhook delivery requeued",
+		logger.String("delivery_id", d.ID.String()),
+		logger.String("webhook_id", webhookID.String()))
+
+	output := toDeliveryOutput(d, false)
+	return &output, nil
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp time.Time
+		body      []byte
+		want      string
+	}{
+		{
+			name:      "payload",
+			secret:    "whsec_test",
+			timestamp: time.Unix(1700000000, 0),
+			body:      body,
+			want:      "t=1700000000,v1=11bf4466ea17c3df3fd743af0b435368e16b7a05eb8eced85e8c4670767bdec5",
+		},
+		{
+			name:      "empty body",
+			secret:    "whsec_test",
+			timestamp: time.Unix(1700000000, 0),
+			body:      nil,
+			want:      "t=1700000000,v1=5967f3c560522fa40cf2876ebc3c3a08551dd6959aaade3b413460591895bdcc",
+		},
+		{
+			name:      "other secret",
+			secret:    "whsec_other",
+			timestamp: time.Unix(1700000000, 0),
+			body:      body,
+			want:      "t=1700000000,v1=eab0e844c24ee1175c6a6f3af3669ed257ad73931101a09a27ecbbaa6f628b3b",
+		},
+		{
+			name:      "other timestamp",
+			secret:    "whsec_test",
+			timestamp: time.Unix(1700000001, 0),
+			body:      body,
+			want:      "t=1700000001,v1=b1feba12f212f2ce5192c1233a9427597af5f3d45ee6bb9cb6c30b434095284d",
+		},
+		{
+			name:      "sub-second precision is dropped",
+			secret:    "whsec_test",
+			timestamp: time.Unix(1700000000, 999999999),
+			body:      body,
+			want:      "t=1700000000,v1=11bf4466ea17c3df3fd743af0b435368e16b7a05eb8eced85e8c4670767bdec5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sign(tt.secret, tt.timestamp, tt.body); got != tt.want {
+				t.Errorf("Sign() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	first, err := generateSecret()
+	if err != nil {
+		t.Fatalf("generateSecret() error = %v", err)
+	}
+	second, err := generateSecret()
+	if err != nil {
+		t.Fatalf("generateSecret() error = %v", err)
+	}
+
+	if !strings.HasPrefix(first, secretPrefix) {
+		t.Errorf("generateSecret() = %q, want prefix %q", first, secretPrefix)
+	}
+	// 32 random bytes, unpadded base64url
+	if got := len(first) - len(secretPrefix); got != 43 {
+		t.Errorf("generateSecret() has %d secret characters, want 43", got)
+	}
+	if first == second {
+		t.Errorf("generateSecret() returned the same secret twice")
+	}
+}
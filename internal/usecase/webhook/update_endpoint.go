@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"quasarflow-api/internal/domain/wallet"
+	"quasarflow-api/internal/domain/webhook"
+	"quasarflow-api/internal/infrastructure/crypto"
+	"quasarflow-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// UpdateEndpointInput represents changes to a webhook endpoint; omitted fields are left unchanged
+type UpdateEndpointInput struct {
+	ID           uuid.UUID    `json:"-"`
+	OwnerID      string       `json:"-"`
+	URL          *string      `json:"url,omitempty"`
+	Description  *string      `json:"description,omitempty"`
+	EventTypes   []string     `json:"event_types,omitempty"`
+	WalletIDs    *[]uuid.UUID `json:"wallet_ids,omitempty"` // An empty list delivers events of every wallet
+	Active       *bool        `json:"active,omitempty"`
+	RotateSecret bool         `json:"rotate_secret,omitempty"` // Replace the signing secret; the new one is returned once
+}
+
+// UpdateEndpointUseCase changes a webhook endpoint's subscription, state or secret
+type UpdateEndpointUseCase struct {
+	endpoints webhook.EndpointRepository
+	wallets   wallet.Repository
+	encryptor crypto.Encryptor
+	logger    logger.Logger
+}
+
+// NewUpdateEndpointUseCase creates a new update endpoint use case
+func NewUpdateEndpointUseCase(
+	endpoints webhook.EndpointRepository,
+	wallets wallet.Repository,
+	encryptor crypto.Encryptor,
+	logger logger.Logger,
+) *UpdateEndpointUseCase {
+	return &UpdateEndpointUseCase{
+		endpoints: endpoints,
+		wallets:   wallets,
+		encryptor: encryptor,
+		logger:    logger,
+	}
+}
+
+// Execute applies the changes. Deliveries already queued keep their payload and are
+// signed with the current secret when sent.
+func (uc *UpdateEndpointUseCase) Execute(ctx context.Context, input UpdateEndpointInput) (*EndpointOutput, error) {
+	e, err := findOwnedEndpoint(ctx, uc.endpoints, input.ID, input.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Validate the resulting subscription
+	rawURL := e.URL
+	if input.URL != nil {
+		rawURL = *input.URL
+	}
+	eventTypeNames := make([]string, 0, len(e.EventTypes))
+	for _, t := range e.EventTypes {
+		eventTypeNames = append(eventTypeNames, string(t))
+	}
+	if input.EventTypes != nil {
+		eventTypeNames = input.EventTypes
+	}
+	walletIDs := e.WalletIDs
+	if input.WalletIDs != nil {
+		walletIDs = *input.WalletIDs
+	}
+
+	eventTypes, err := validateSubscription(ctx, uc.wallets, rawURL, eventTypeNames, walletIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Apply the changes
+	if err := e.SetURL(rawURL); err != nil {
+		return nil, fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+	if err := e.SetEventTypes(eventTypes); err != nil {
+		return nil, fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+	e.WalletIDs = walletIDs
+	if input.Description != nil {
+		e.Description = *input.Description
+	}
+	if input.Active != nil {
+		e.Active = *input.Active
+	}
+
+	secret := ""
+	if input.RotateSecret {
+		if secret, err = generateSecret(); err != nil {
+			return nil, err
+		}
+		if e.EncryptedSecret, err = uc.encryptor.Encrypt(secret); err != nil {
+			uc.logger.Error("failed to encrypt webhook secret", logger.Error(err))
+			return nil, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+		}
+	}
+
+	// 3. Save
+	if err := uc.endpoints.Update(ctx, e); err != nil {
+		uc.logger.Error("failed to update webhook endpoint", logger.Error(err))
+		return nil, fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+
+	uc.logger.Info("webhook endpoint updated",
+		logger.String("webhook_id", e.ID.String()),
+		logger.Bool("active", e.Active),
+		logger.Bool("secret_rotated", input.RotateSecret))
+
+	output := toEndpointOutput(e)
+	output.Secret = secret
+	return &output, nil
+}
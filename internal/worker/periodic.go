@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"quasarflow-api/pkg/logger"
+)
+
+// Job is a unit of background work run by a Periodic worker
+type Job func(ctx context.Context) error
+
+// Periodic runs a job on a fixed interval until its context is cancelled
+type Periodic struct {
+	name     string
+	interval time.Duration
+	job      Job
+	logger   logger.Logger
+}
+
+// NewPeriodic creates a new periodic worker
+func NewPeriodic(name string, interval time.Duration, job Job, logger logger.Logger) *Periodic {
+	return &Periodic{
+		name:     name,
+		interval: interval,
+		job:      job,
+		logger:   logger,
+	}
+}
+
+// Run blocks, executing the job once per interval until ctx is done.
+// Job errors are logged and do not stop the worker.
+func (p *Periodic) Run(ctx context.Context) {
+	p.logger.Info("starting background worker",
+		logger.String("worker", p.name),
+		logger.String("interval", p.interval.String()))
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.logger.Info("background worker stopped", logger.String("worker", p.name))
+			return
+		case <-ticker.C:
+			if err := p.job(ctx); err != nil {
+				p.logger.Error("background job failed",
+					logger.String("worker", p.name),
+					logger.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_transaction_submissions_pending;
+DROP INDEX IF EXISTS idx_transaction_submissions_wallet_id;
+
+-- Drop transaction_submissions table
+DROP TABLE IF EXISTS transaction_submissions;
@@ -0,0 +1,33 @@
+-- Create transaction_submissions table
+CREATE TABLE IF NOT EXISTS transaction_submissions (
+    id UUID PRIMARY KEY,
+    wallet_id UUID NOT NULL REFERENCES wallets(id),
+    kind VARCHAR(32) NOT NULL,
+    hash CHAR(64) NOT NULL UNIQUE,
+    envelope_xdr TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL CHECK (status IN ('pending', 'success', 'failed', 'expired')),
+    valid_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    min_ledger BIGINT NOT NULL DEFAULT 0,
+    max_ledger BIGINT NOT NULL DEFAULT 0,
+    ledger INTEGER NOT NULL DEFAULT 0,
+    result_code VARCHAR(64) NOT NULL DEFAULT '',
+    error_message TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index on wallet_id for per-wallet listings
+CREATE INDEX IF NOT EXISTS idx_transaction_submissions_wallet_id ON transaction_submissions(wallet_id, created_at DESC);
+
+-- Create partial index used by the expiry job
+CREATE INDEX IF NOT EXISTS idx_transaction_submissions_pending ON transaction_submissions(valid_until) WHERE status = 'pending';
+
+-- Add comment to table
+COMMENT ON TABLE transaction_submissions IS 'Tracks transactions signed and submitted for managed wallets';
+COMMENT ON COLUMN transaction_submissions.kind IS 'Operation kind (payment, create_account, ...)';
+COMMENT ON COLUMN transaction_submissions.hash IS 'Transaction hash (hex)';
+COMMENT ON COLUMN transaction_submissions.envelope_xdr IS 'Signed transaction envelope (base64 XDR)';
+COMMENT ON COLUMN transaction_submissions.status IS 'pending, success, failed or expired';
+COMMENT ON COLUMN transaction_submissions.valid_until IS 'Upper time bound; pending submissions past it are marked expired';
+COMMENT ON COLUMN transaction_submissions.min_ledger IS 'Optional lower ledger bound (0 = none)';
+COMMENT ON COLUMN transaction_submissions.max_ledger IS 'Optional upper ledger bound (0 = none)';
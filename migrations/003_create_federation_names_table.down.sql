@@ -0,0 +1,2 @@
+-- Drop federation_names table
+DROP TABLE IF EXISTS federation_names;
@@ -0,0 +1,11 @@
+-- Create federation_names table
+CREATE TABLE IF NOT EXISTS federation_names (
+    name VARCHAR(64) PRIMARY KEY,
+    wallet_id UUID NOT NULL UNIQUE REFERENCES wallets(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Add comment to table
+COMMENT ON TABLE federation_names IS 'SEP-2 federation names on our domain mapped to managed wallets';
+COMMENT ON COLUMN federation_names.name IS 'Name part of name*FEDERATION_DOMAIN (lowercase)';
+COMMENT ON COLUMN federation_names.wallet_id IS 'Managed wallet the name resolves to';
@@ -0,0 +1,2 @@
+-- Drop assets table
+DROP TABLE IF EXISTS assets;
@@ -0,0 +1,28 @@
+-- Create assets table
+CREATE TABLE IF NOT EXISTS assets (
+    id UUID PRIMARY KEY,
+    code VARCHAR(12) NOT NULL,
+    issuer_wallet_id UUID NOT NULL REFERENCES wallets(id),
+    issuer_public_key VARCHAR(56) NOT NULL,
+    distribution_wallet_id UUID NOT NULL REFERENCES wallets(id),
+    auth_required BOOLEAN NOT NULL DEFAULT FALSE,
+    auth_revocable BOOLEAN NOT NULL DEFAULT FALSE,
+    clawback_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    locked BOOLEAN NOT NULL DEFAULT FALSE,
+    minted NUMERIC(26, 7) NOT NULL DEFAULT 0,
+    clawed_back NUMERIC(26, 7) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (code, issuer_public_key)
+);
+
+-- Create index on issuer_wallet_id for per-issuer listings
+CREATE INDEX IF NOT EXISTS idx_assets_issuer_wallet_id ON assets(issuer_wallet_id);
+
+-- Add comment to table
+COMMENT ON TABLE assets IS 'Assets issued from managed issuer wallets';
+COMMENT ON COLUMN assets.issuer_public_key IS 'Issuer account; together with code identifies the asset on the network';
+COMMENT ON COLUMN assets.distribution_wallet_id IS 'Wallet that receives newly minted units';
+COMMENT ON COLUMN assets.locked IS 'Issuer master weight set to zero; supply is fixed';
+COMMENT ON COLUMN assets.minted IS 'Total units issued from the issuer';
+COMMENT ON COLUMN assets.clawed_back IS 'Total units clawed back; supply = minted - clawed_back';
@@ -0,0 +1,2 @@
+-- Drop verified_assets table
+DROP TABLE IF EXISTS verified_assets;
@@ -0,0 +1,13 @@
+-- Create verified_assets table
+CREATE TABLE IF NOT EXISTS verified_assets (
+    code VARCHAR(12) NOT NULL,
+    issuer VARCHAR(56) NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (code, issuer)
+);
+
+-- Add comment to table
+COMMENT ON TABLE verified_assets IS 'Admin allow-list of verified assets shown as verified in balance responses';
+COMMENT ON COLUMN verified_assets.issuer IS 'Issuer public key';
+COMMENT ON COLUMN verified_assets.note IS 'Why the asset was verified';
@@ -0,0 +1,3 @@
+-- Remove offer tracking from transaction_submissions
+DROP INDEX IF EXISTS idx_transaction_submissions_offer_id;
+ALTER TABLE transaction_submissions DROP COLUMN IF EXISTS offer_id;
@@ -0,0 +1,7 @@
+-- Track the DEX offer touched by offer submissions
+ALTER TABLE transaction_submissions ADD COLUMN IF NOT EXISTS offer_id BIGINT NOT NULL DEFAULT 0;
+
+-- Create index used to look up an offer's submission history
+CREATE INDEX IF NOT EXISTS idx_transaction_submissions_offer_id ON transaction_submissions(wallet_id, offer_id) WHERE offer_id <> 0;
+
+COMMENT ON COLUMN transaction_submissions.offer_id IS 'DEX offer created, updated or cancelled by the transaction (0 = none)';
@@ -0,0 +1,2 @@
+-- Drop sponsorships table
+DROP TABLE IF EXISTS sponsorships;
@@ -0,0 +1,32 @@
+-- Create sponsorships table
+CREATE TABLE IF NOT EXISTS sponsorships (
+    id UUID PRIMARY KEY,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('account', 'trustline')),
+    sponsor_wallet_id UUID NOT NULL REFERENCES wallets(id),
+    sponsor_public_key VARCHAR(56) NOT NULL,
+    sponsored_wallet_id UUID NOT NULL REFERENCES wallets(id),
+    sponsored_public_key VARCHAR(56) NOT NULL,
+    asset_code VARCHAR(12) NOT NULL DEFAULT '',
+    asset_issuer VARCHAR(56) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'revoked', 'transferred')),
+    replaced_by_id UUID REFERENCES sponsorships(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ended_at TIMESTAMP WITH TIME ZONE
+);
+
+-- A ledger entry has at most one active sponsor
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sponsorships_active_entry
+    ON sponsorships(type, sponsored_public_key, asset_code, asset_issuer)
+    WHERE status = 'active';
+
+-- Create indexes for per-wallet listings
+CREATE INDEX IF NOT EXISTS idx_sponsorships_sponsor_wallet_id ON sponsorships(sponsor_wallet_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_sponsorships_sponsored_wallet_id ON sponsorships(sponsored_wallet_id, created_at DESC);
+
+-- Add comment to table
+COMMENT ON TABLE sponsorships IS 'Reserves of managed wallet ledger entries paid by another managed wallet';
+COMMENT ON COLUMN sponsorships.type IS 'Sponsored ledger entry: account (base reserve) or trustline';
+COMMENT ON COLUMN sponsorships.asset_code IS 'Trustline asset code; empty for account sponsorships';
+COMMENT ON COLUMN sponsorships.status IS 'active, revoked (sponsored account pays its reserve) or transferred';
+COMMENT ON COLUMN sponsorships.replaced_by_id IS 'Sponsorship created when this one was transferred to a new sponsor';
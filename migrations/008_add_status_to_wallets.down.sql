@@ -0,0 +1,7 @@
+-- Remove soft-delete status from wallets
+DROP INDEX IF EXISTS idx_wallets_status;
+
+ALTER TABLE wallets
+    DROP COLUMN IF EXISTS merged_into,
+    DROP COLUMN IF EXISTS closed_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,13 @@
+-- Add soft-delete status to wallets
+ALTER TABLE wallets
+    ADD COLUMN IF NOT EXISTS status VARCHAR(10) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'closed')),
+    ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS merged_into VARCHAR(56) NOT NULL DEFAULT '';
+
+-- Create index on status for filtering
+CREATE INDEX IF NOT EXISTS idx_wallets_status ON wallets(status);
+
+-- Add comment to columns
+COMMENT ON COLUMN wallets.status IS 'active, or closed once the account was merged; closed wallets are kept for audit and never sign again';
+COMMENT ON COLUMN wallets.closed_at IS 'Timestamp when the wallet was closed';
+COMMENT ON COLUMN wallets.merged_into IS 'Account that received the remaining XLM; empty if the account was never funded';
@@ -0,0 +1,2 @@
+-- Drop stream_cursors table
+DROP TABLE IF EXISTS stream_cursors;
@@ -0,0 +1,10 @@
+-- Create stream_cursors table
+CREATE TABLE IF NOT EXISTS stream_cursors (
+    wallet_id UUID PRIMARY KEY REFERENCES wallets(id),
+    cursor VARCHAR(64) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Add comment to table
+COMMENT ON TABLE stream_cursors IS 'Resume points of the Horizon payment stream watched for each managed wallet';
+COMMENT ON COLUMN stream_cursors.cursor IS 'Paging token of the last operation whose events were published';
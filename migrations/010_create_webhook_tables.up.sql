@@ -0,0 +1,67 @@
+-- Create webhook_endpoints table
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id UUID PRIMARY KEY,
+    owner_id VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    event_types TEXT[] NOT NULL,
+    wallet_ids UUID[] NOT NULL DEFAULT '{}',
+    encrypted_secret TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_owner_id ON webhook_endpoints(owner_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_event_types ON webhook_endpoints USING GIN (event_types) WHERE active;
+
+COMMENT ON TABLE webhook_endpoints IS 'URLs registered by users to receive signed event deliveries';
+COMMENT ON COLUMN webhook_endpoints.owner_id IS 'User that registered the endpoint';
+COMMENT ON COLUMN webhook_endpoints.event_types IS 'Event types the endpoint is subscribed to';
+COMMENT ON COLUMN webhook_endpoints.wallet_ids IS 'Wallets whose events are delivered; empty for every wallet';
+COMMENT ON COLUMN webhook_endpoints.encrypted_secret IS 'HMAC-SHA256 signing secret (encrypted)';
+
+-- Create webhook_deliveries table (outbox and dead-letter queue)
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY,
+    endpoint_id UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_status_code INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (endpoint_id, event_id)
+);
+
+-- Index for the delivery worker
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint_id ON webhook_deliveries(endpoint_id, created_at DESC);
+
+COMMENT ON TABLE webhook_deliveries IS 'Outbox of events queued for webhook endpoints; dead deliveries form the dead-letter queue';
+COMMENT ON COLUMN webhook_deliveries.event_id IS 'Stable event ID; an endpoint gets each event at most once in the outbox';
+COMMENT ON COLUMN webhook_deliveries.status IS 'pending (retrying), delivered, or dead after the maximum attempts';
+COMMENT ON COLUMN webhook_deliveries.next_attempt_at IS 'When the pending delivery is next sent';
+
+-- Create webhook_delivery_attempts table
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+    id UUID PRIMARY KEY,
+    delivery_id UUID NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    response_body TEXT NOT NULL DEFAULT '',
+    duration_ms INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_attempts_delivery_id ON webhook_delivery_attempts(delivery_id, number);
+
+COMMENT ON TABLE webhook_delivery_attempts IS 'Log of every HTTP request made for a webhook delivery';
+COMMENT ON COLUMN webhook_delivery_attempts.status_code IS 'HTTP status answered by the endpoint; 0 if no response was received';
+COMMENT ON COLUMN webhook_delivery_attempts.response_body IS 'Start of the response body, truncated';
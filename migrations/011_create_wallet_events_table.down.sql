@@ -0,0 +1,4 @@
+-- Drop wallet_events table
+DROP INDEX IF EXISTS idx_wallet_events_created_at;
+DROP INDEX IF EXISTS idx_wallet_events_wallet_seq;
+DROP TABLE IF EXISTS wallet_events;
@@ -0,0 +1,20 @@
+-- Create wallet_events table
+CREATE TABLE IF NOT EXISTS wallet_events (
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE,
+    wallet_id UUID NOT NULL REFERENCES wallets(id),
+    type VARCHAR(50) NOT NULL,
+    public_key VARCHAR(56) NOT NULL DEFAULT '',
+    data JSONB NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for replay and retention
+CREATE INDEX IF NOT EXISTS idx_wallet_events_wallet_seq ON wallet_events(wallet_id, seq);
+CREATE INDEX IF NOT EXISTS idx_wallet_events_created_at ON wallet_events(created_at);
+
+-- Add comment to table
+COMMENT ON TABLE wallet_events IS 'Published domain events, replayed to stream clients that resume with Last-Event-ID';
+COMMENT ON COLUMN wallet_events.seq IS 'Insertion order; replay returns events with a higher seq than the last one the client saw';
+COMMENT ON COLUMN wallet_events.data IS 'Event details matching the event type';
@@ -0,0 +1,5 @@
+-- Drop ledger index tables
+DROP TABLE IF EXISTS ledger_index_state;
+DROP TABLE IF EXISTS ledger_effects;
+DROP TABLE IF EXISTS ledger_operations;
+DROP TABLE IF EXISTS ledger_transactions;
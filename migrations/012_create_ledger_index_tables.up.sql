@@ -0,0 +1,66 @@
+-- Create ledger_transactions table
+CREATE TABLE IF NOT EXISTS ledger_transactions (
+    hash VARCHAR(64) PRIMARY KEY,
+    id VARCHAR(32) NOT NULL,
+    ledger INTEGER NOT NULL,
+    source_account VARCHAR(69) NOT NULL,
+    successful BOOLEAN NOT NULL,
+    max_fee BIGINT NOT NULL,
+    fee_charged BIGINT NOT NULL,
+    operation_count INTEGER NOT NULL,
+    memo_type VARCHAR(10) NOT NULL DEFAULT '',
+    memo TEXT NOT NULL DEFAULT '',
+    closed_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Create ledger_operations table
+CREATE TABLE IF NOT EXISTS ledger_operations (
+    wallet_id UUID NOT NULL REFERENCES wallets(id),
+    position BIGINT NOT NULL,
+    id VARCHAR(32) NOT NULL,
+    transaction_hash VARCHAR(64) NOT NULL REFERENCES ledger_transactions(hash),
+    type VARCHAR(64) NOT NULL,
+    source_account VARCHAR(69) NOT NULL,
+    successful BOOLEAN NOT NULL,
+    counterparties TEXT[] NOT NULL DEFAULT '{}',
+    assets TEXT[] NOT NULL DEFAULT '{}',
+    details JSONB NOT NULL,
+    closed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (wallet_id, position)
+);
+
+-- Create ledger_effects table
+CREATE TABLE IF NOT EXISTS ledger_effects (
+    wallet_id UUID NOT NULL,
+    id VARCHAR(48) NOT NULL,
+    operation_position BIGINT NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    details JSONB NOT NULL,
+    PRIMARY KEY (wallet_id, id),
+    FOREIGN KEY (wallet_id, operation_position) REFERENCES ledger_operations(wallet_id, position)
+);
+
+-- Create ledger_index_state table
+CREATE TABLE IF NOT EXISTS ledger_index_state (
+    wallet_id UUID PRIMARY KEY REFERENCES wallets(id),
+    cursor VARCHAR(64) NOT NULL,
+    backfilled_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for history filters
+CREATE INDEX IF NOT EXISTS idx_ledger_operations_wallet_closed_at ON ledger_operations(wallet_id, closed_at);
+CREATE INDEX IF NOT EXISTS idx_ledger_operations_wallet_type ON ledger_operations(wallet_id, type);
+CREATE INDEX IF NOT EXISTS idx_ledger_operations_counterparties ON ledger_operations USING GIN (counterparties);
+CREATE INDEX IF NOT EXISTS idx_ledger_operations_assets ON ledger_operations USING GIN (assets);
+CREATE INDEX IF NOT EXISTS idx_ledger_effects_operation ON ledger_effects(wallet_id, operation_position);
+
+-- Add comment to tables
+COMMENT ON TABLE ledger_transactions IS 'Transactions of indexed operations, shared by every wallet they involve';
+COMMENT ON TABLE ledger_operations IS 'Local index of operations involving managed wallets, filled by backfill and streaming from Horizon';
+COMMENT ON COLUMN ledger_operations.position IS 'Numeric Horizon operation ID; orders operations and serves as the history cursor';
+COMMENT ON COLUMN ledger_operations.counterparties IS 'Accounts other than the wallet that appear in the operation or its effects';
+COMMENT ON COLUMN ledger_operations.assets IS 'Assets that appear in the operation or its effects, native or CODE:ISSUER';
+COMMENT ON TABLE ledger_effects IS 'Effects of indexed operations on the wallet account';
+COMMENT ON TABLE ledger_index_state IS 'How far each wallet''s operations have been indexed';
+COMMENT ON COLUMN ledger_index_state.backfilled_at IS 'When the history before the stream was fully indexed';
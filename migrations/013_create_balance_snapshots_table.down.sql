@@ -0,0 +1,2 @@
+-- Drop balance_snapshots table
+DROP TABLE IF EXISTS balance_snapshots;
@@ -0,0 +1,13 @@
+-- Create balance_snapshots table
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    wallet_id UUID NOT NULL REFERENCES wallets(id),
+    taken_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    asset VARCHAR(130) NOT NULL,
+    balance NUMERIC(26, 7) NOT NULL,
+    PRIMARY KEY (wallet_id, taken_at, asset)
+);
+
+-- Add comment to table
+COMMENT ON TABLE balance_snapshots IS 'Per-asset wallet balances read from Horizon on a schedule, the starting points for past balances';
+COMMENT ON COLUMN balance_snapshots.taken_at IS 'When the balances were read; rows sharing it form one snapshot';
+COMMENT ON COLUMN balance_snapshots.asset IS 'native, CODE:ISSUER or liquidity_pool:<pool id>';
@@ -0,0 +1,3 @@
+-- Drop reconciliation tables
+DROP TABLE IF EXISTS reconciliation_discrepancies;
+DROP TABLE IF EXISTS reconciliation_reports;
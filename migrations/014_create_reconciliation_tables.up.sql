@@ -0,0 +1,43 @@
+-- Create reconciliation_reports table
+CREATE TABLE IF NOT EXISTS reconciliation_reports (
+    id UUID PRIMARY KEY,
+    wallet_id UUID NOT NULL REFERENCES wallets(id),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('clean', 'discrepancies', 'failed')),
+    window_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    cursor VARCHAR(32) NOT NULL DEFAULT '',
+    operations_checked INTEGER NOT NULL DEFAULT 0,
+    submissions_checked INTEGER NOT NULL DEFAULT 0,
+    balances_checked BOOLEAN NOT NULL DEFAULT FALSE,
+    discrepancy_count INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Indexes for the admin listing
+CREATE INDEX IF NOT EXISTS idx_reconciliation_reports_started_at ON reconciliation_reports(started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_reconciliation_reports_wallet_id ON reconciliation_reports(wallet_id, started_at DESC);
+
+COMMENT ON TABLE reconciliation_reports IS 'Results of comparing a wallet''s stored transactions and derived balances against Horizon';
+COMMENT ON COLUMN reconciliation_reports.window_start IS 'Operations and submissions from here on were compared';
+COMMENT ON COLUMN reconciliation_reports.cursor IS 'Last indexed operation; operations after it were not compared';
+COMMENT ON COLUMN reconciliation_reports.balances_checked IS 'False when the index was behind the ledger or still backfilling';
+
+-- Create reconciliation_discrepancies table
+CREATE TABLE IF NOT EXISTS reconciliation_discrepancies (
+    report_id UUID NOT NULL REFERENCES reconciliation_reports(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('missing', 'unexpected', 'mismatched')),
+    record VARCHAR(20) NOT NULL,
+    reference VARCHAR(130) NOT NULL,
+    field VARCHAR(64) NOT NULL DEFAULT '',
+    expected TEXT NOT NULL DEFAULT '',
+    actual TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (report_id, number)
+);
+
+COMMENT ON TABLE reconciliation_discrepancies IS 'Differences between our records and the ledger found by a reconciliation run';
+COMMENT ON COLUMN reconciliation_discrepancies.kind IS 'missing (on the ledger only), unexpected (in our records only) or mismatched';
+COMMENT ON COLUMN reconciliation_discrepancies.record IS 'operation, transaction, submission or balance';
+COMMENT ON COLUMN reconciliation_discrepancies.expected IS 'Value on the ledger';
+COMMENT ON COLUMN reconciliation_discrepancies.actual IS 'Value in our records';
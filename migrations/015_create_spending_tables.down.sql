@@ -0,0 +1,3 @@
+-- Drop spending tables
+DROP TABLE IF EXISTS spending_records;
+DROP TABLE IF EXISTS spending_policies;
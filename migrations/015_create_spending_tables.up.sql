@@ -0,0 +1,40 @@
+-- Create spending_policies table
+CREATE TABLE IF NOT EXISTS spending_policies (
+    id UUID PRIMARY KEY,
+    scope VARCHAR(10) NOT NULL CHECK (scope IN ('wallet', 'user')),
+    subject_id VARCHAR(255) NOT NULL,
+    asset VARCHAR(70) NOT NULL DEFAULT '',
+    max_per_transaction NUMERIC(26, 7) NOT NULL DEFAULT 0,
+    daily_limit NUMERIC(26, 7) NOT NULL DEFAULT 0,
+    monthly_limit NUMERIC(26, 7) NOT NULL DEFAULT 0,
+    max_count INTEGER NOT NULL DEFAULT 0,
+    count_window_seconds BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (scope, subject_id, asset)
+);
+
+COMMENT ON TABLE spending_policies IS 'Payment limits of a wallet, or of a user across wallets, checked before signing';
+COMMENT ON COLUMN spending_policies.subject_id IS 'Wallet ID for wallet policies, user ID for user policies';
+COMMENT ON COLUMN spending_policies.asset IS 'native or CODE:ISSUER; empty counts payments of any asset and only limits their number';
+COMMENT ON COLUMN spending_policies.daily_limit IS 'Total per UTC day; 0 is no limit, as for every limit';
+COMMENT ON COLUMN spending_policies.monthly_limit IS 'Total per UTC calendar month';
+COMMENT ON COLUMN spending_policies.count_window_seconds IS 'Rolling window max_count applies to';
+
+-- Create spending_records table
+CREATE TABLE IF NOT EXISTS spending_records (
+    id UUID PRIMARY KEY,
+    wallet_id UUID NOT NULL REFERENCES wallets(id),
+    user_id VARCHAR(255) NOT NULL DEFAULT '',
+    asset VARCHAR(70) NOT NULL,
+    amount NUMERIC(26, 7) NOT NULL,
+    transaction_hash CHAR(64),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Indexes for summing usage
+CREATE INDEX IF NOT EXISTS idx_spending_records_wallet_id ON spending_records(wallet_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_spending_records_user_id ON spending_records(user_id, created_at);
+
+COMMENT ON TABLE spending_records IS 'Payments reserved against the spending limits before signing';
+COMMENT ON COLUMN spending_records.transaction_hash IS 'Signed transaction; records whose submission failed or expired stop counting';
@@ -0,0 +1,3 @@
+-- Drop address book tables
+DROP TABLE IF EXISTS address_book_modes;
+DROP TABLE IF EXISTS address_book_entries;
@@ -0,0 +1,36 @@
+-- Create address_book_entries table
+CREATE TABLE IF NOT EXISTS address_book_entries (
+    id UUID PRIMARY KEY,
+    scope VARCHAR(10) NOT NULL CHECK (scope IN ('wallet', 'user')),
+    subject_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    address VARCHAR(56) NOT NULL,
+    memo VARCHAR(64) NOT NULL DEFAULT '',
+    memo_type VARCHAR(10) NOT NULL DEFAULT '',
+    federation_address VARCHAR(255) NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    usable_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (scope, subject_id, name)
+);
+
+-- Index for checking payment destinations
+CREATE INDEX IF NOT EXISTS idx_address_book_entries_address ON address_book_entries(address);
+
+COMMENT ON TABLE address_book_entries IS 'Named counterparties of a wallet, or of a user across wallets';
+COMMENT ON COLUMN address_book_entries.subject_id IS 'Wallet ID for wallet address books, user ID for user address books';
+COMMENT ON COLUMN address_book_entries.memo IS 'When set, only payments carrying this memo match the entry';
+COMMENT ON COLUMN address_book_entries.usable_at IS 'End of the cool-down; before it the entry does not satisfy allow-list only mode';
+
+-- Create address_book_modes table
+CREATE TABLE IF NOT EXISTS address_book_modes (
+    scope VARCHAR(10) NOT NULL CHECK (scope IN ('wallet', 'user')),
+    subject_id VARCHAR(255) NOT NULL,
+    allowlist_only BOOLEAN NOT NULL DEFAULT FALSE,
+    relaxes_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (scope, subject_id)
+);
+
+COMMENT ON TABLE address_book_modes IS 'Whether payments covered by an address book may only go to its entries';
+COMMENT ON COLUMN address_book_modes.relaxes_at IS 'Set when allow-list only was turned off; the mode stays enforced until then';
@@ -0,0 +1,4 @@
+-- Drop approval tables
+DROP TABLE IF EXISTS approval_audit;
+DROP TABLE IF EXISTS approval_requests;
+DROP TABLE IF EXISTS approval_policies;
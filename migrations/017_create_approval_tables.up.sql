@@ -0,0 +1,63 @@
+-- Create approval_policies table
+CREATE TABLE IF NOT EXISTS approval_policies (
+    id UUID PRIMARY KEY,
+    wallet_id UUID NOT NULL REFERENCES wallets(id),
+    asset VARCHAR(70) NOT NULL,
+    threshold NUMERIC(26, 7) NOT NULL,
+    quorum INTEGER NOT NULL CHECK (quorum >= 1),
+    ttl_seconds BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (wallet_id, asset)
+);
+
+COMMENT ON TABLE approval_policies IS 'Payments of an asset from a wallet above a threshold, held until approvers approve them';
+COMMENT ON COLUMN approval_policies.asset IS 'native or CODE:ISSUER';
+COMMENT ON COLUMN approval_policies.quorum IS 'Approvals needed before the payment is signed';
+COMMENT ON COLUMN approval_policies.ttl_seconds IS 'How long requests stay open before they expire';
+
+-- Create approval_requests table
+CREATE TABLE IF NOT EXISTS approval_requests (
+    id UUID PRIMARY KEY,
+    wallet_id UUID NOT NULL REFERENCES wallets(id),
+    policy_id UUID NOT NULL,
+    asset VARCHAR(70) NOT NULL,
+    amount NUMERIC(26, 7) NOT NULL,
+    destination VARCHAR(56) NOT NULL,
+    memo VARCHAR(64) NOT NULL DEFAULT '',
+    payment JSONB NOT NULL,
+    requested_by VARCHAR(255) NOT NULL,
+    quorum INTEGER NOT NULL,
+    approvals INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('pending', 'approved', 'rejected', 'expired', 'executed', 'failed')),
+    transaction_hash VARCHAR(64) NOT NULL DEFAULT '',
+    submission_id VARCHAR(36) NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    version INTEGER NOT NULL DEFAULT 0
+);
+
+-- Indexes for listings and the expiry job
+CREATE INDEX IF NOT EXISTS idx_approval_requests_wallet_id ON approval_requests(wallet_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_approval_requests_pending ON approval_requests(expires_at) WHERE status = 'pending';
+
+COMMENT ON TABLE approval_requests IS 'Payments held for maker-checker approval';
+COMMENT ON COLUMN approval_requests.payment IS 'The payment as requested, executed as is once approved';
+COMMENT ON COLUMN approval_requests.requested_by IS 'User who made the payment; cannot approve it';
+COMMENT ON COLUMN approval_requests.version IS 'Incremented on every update so concurrent decisions cannot both apply';
+
+-- Create approval_audit table
+CREATE TABLE IF NOT EXISTS approval_audit (
+    request_id UUID NOT NULL REFERENCES approval_requests(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL,
+    action VARCHAR(10) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (request_id, number)
+);
+
+COMMENT ON TABLE approval_audit IS 'Every step of an approval request: requested, approved, rejected, expired, executed or failed';
+COMMENT ON COLUMN approval_audit.actor IS 'User who acted, or system';
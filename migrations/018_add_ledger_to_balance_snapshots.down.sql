@@ -0,0 +1,4 @@
+-- Remove the ledger from balance snapshots
+ALTER TABLE balance_snapshots DROP COLUMN IF EXISTS ledger;
+
+COMMENT ON COLUMN balance_snapshots.taken_at IS 'When the balances were read; rows sharing it form one snapshot';
@@ -0,0 +1,5 @@
+-- Record the ledger each balance snapshot is as of
+ALTER TABLE balance_snapshots ADD COLUMN IF NOT EXISTS ledger BIGINT NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN balance_snapshots.ledger IS 'Ledger the balances are as of, replayed from after its last operation (0 = snapshot stamped with the read time)';
+COMMENT ON COLUMN balance_snapshots.taken_at IS 'Close time of the ledger the balances are as of, or when they were read for older snapshots; rows sharing it form one snapshot';
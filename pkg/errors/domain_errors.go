@@ -28,11 +28,432 @@ var (
 		"Amount cannot be negative",
 	)
 
+	// ErrInvalidAmount is returned when an amount is not a valid positive decimal
+	ErrInvalidAmount = NewValidationError(
+		"Invalid amount",
+		"Amount must be a positive decimal with at most 7 decimal places",
+	)
+
 	// ErrInvalidIssuer is returned when an invalid asset issuer is specified
 	ErrInvalidIssuer = NewValidationError(
 		"Invalid asset issuer",
 		"Asset issuer must be a valid Stellar public key",
 	)
+
+	// ErrMaxFeeTooLow is returned when a requested fee cap is below the network minimum
+	ErrMaxFeeTooLow = NewValidationError(
+		"Invalid max fee",
+		"max_fee must be at least 100 stroops",
+	)
+
+	// ErrDestinationNotFound is returned when sending a non-native asset to an account that does not exist
+	ErrDestinationNotFound = NewValidationError(
+		"Destination account does not exist",
+		"Non-native assets cannot be sent to an unfunded account; send at least 1 XLM to create it first",
+	)
+
+	// ErrDestinationNoTrustline is returned when the destination has no trustline for the asset
+	ErrDestinationNoTrustline = NewValidationError(
+		"Destination account has no trustline for this asset",
+		"The recipient must establish a trustline to the asset before it can receive it",
+	)
+
+	// ErrDestinationNotAuthorized is returned when the issuer has not authorized the destination trustline
+	ErrDestinationNotAuthorized = NewValidationError(
+		"Destination trustline is not authorized",
+		"The asset issuer must authorize the recipient's trustline before it can receive the asset",
+	)
+
+	// ErrFederationMemoMismatch is returned when the client memo conflicts with the federation record
+	ErrFederationMemoMismatch = NewValidationError(
+		"Memo conflicts with federation address",
+		"The federation server requires a specific memo for this address; omit memo or send the same value",
+	)
+
+	// ErrMemoRequired is returned when the destination requires a memo (SEP-29) and none was given
+	ErrMemoRequired = NewValidationError(
+		"Destination account requires a memo",
+		"The recipient has set config.memo_required (SEP-29); include a memo identifying the recipient",
+	)
+
+	// ErrCreateAccountAmountTooLow is returned when a payment to a new account would not cover its base reserve
+	ErrCreateAccountAmountTooLow = NewValidationError(
+		"Amount too low to create destination account",
+		"The destination account does not exist yet; at least 1 XLM is required to create it",
+	)
+
+	// ErrInvalidAssetCode is returned when an asset code is not 1-12 alphanumeric characters
+	ErrInvalidAssetCode = NewValidationError(
+		"Invalid asset code",
+		"Asset code must be 1-12 alphanumeric characters and cannot be XLM",
+	)
+
+	// ErrInvalidTrustlineLimit is returned when a trustline limit is not a positive decimal
+	ErrInvalidTrustlineLimit = NewValidationError(
+		"Invalid trustline limit",
+		"Limit must be a positive decimal with at most 7 decimal places",
+	)
+
+	// ErrTrustlineLimitBelowBalance is returned when a new limit would not cover the current balance and liabilities
+	ErrTrustlineLimitBelowBalance = NewValidationError(
+		"Trustline limit below current balance",
+		"The limit must be at least the current balance plus buying liabilities",
+	)
+
+	// ErrTrustlineNotFound is returned when the wallet holds no trustline for the asset
+	ErrTrustlineNotFound = NewNotFoundError("Trustline not found")
+
+	// ErrTrustlineNotEmpty is returned when removing a trustline that still holds a balance
+	ErrTrustlineNotEmpty = NewConflictError(
+		"Trustline balance is not zero",
+		"Send or burn the remaining balance before removing the trustline",
+	)
+
+	// ErrTrustlineHasOffers is returned when removing a trustline that open offers still reference
+	ErrTrustlineHasOffers = NewConflictError(
+		"Trustline has open offers",
+		"Cancel all offers buying or selling the asset before removing the trustline",
+	)
+
+	// ErrTrustlineHasLiabilities is returned when removing a trustline with outstanding liabilities
+	ErrTrustlineHasLiabilities = NewConflictError(
+		"Trustline has outstanding liabilities",
+		"Buying and selling liabilities must be zero before removing the trustline",
+	)
+
+	// ErrAssetNotFound is returned when no issued asset matches the given ID
+	ErrAssetNotFound = NewNotFoundError("Asset not found")
+
+	// ErrAssetAlreadyExists is returned when the asset code is already issued by the issuer wallet
+	ErrAssetAlreadyExists = NewConflictError(
+		"Asset already exists",
+		"The issuer wallet already issues an asset with this code",
+	)
+
+	// ErrInvalidAssetFlags is returned when clawback is requested without revocable authorization
+	ErrInvalidAssetFlags = NewValidationError(
+		"Invalid asset flags",
+		"AUTH_CLAWBACK_ENABLED requires AUTH_REVOCABLE",
+	)
+
+	// ErrAssetWalletNetworkMismatch is returned when issuer and distribution wallets are on different networks
+	ErrAssetWalletNetworkMismatch = NewValidationError(
+		"Wallet network mismatch",
+		"Issuer and distribution wallets must be on the same network",
+	)
+
+	// ErrIssuerLocked is returned for operations that need the issuer's signature after it was locked
+	ErrIssuerLocked = NewConflictError(
+		"Issuer account is locked",
+		"The issuer's master key weight is zero; it can no longer sign transactions",
+	)
+
+	// ErrAssetNotRevocable is returned when revoking authorization without AUTH_REVOCABLE
+	ErrAssetNotRevocable = NewValidationError(
+		"Asset authorization is not revocable",
+		"Set AUTH_REVOCABLE on the issuer before revoking trustline authorization",
+	)
+
+	// ErrClawbackNotEnabled is returned when clawing back an asset without AUTH_CLAWBACK_ENABLED
+	ErrClawbackNotEnabled = NewValidationError(
+		"Clawback is not enabled for this asset",
+		"Only trustlines created after AUTH_CLAWBACK_ENABLED was set can be clawed back",
+	)
+
+	// ErrInvalidOfferType is returned when an offer type is not sell, buy or passive
+	ErrInvalidOfferType = NewValidationError(
+		"Invalid offer type",
+		"Offer type must be one of: sell, buy, passive",
+	)
+
+	// ErrInvalidOfferPrice is returned when an offer price is not a positive rational number
+	ErrInvalidOfferPrice = NewValidationError(
+		"Invalid offer price",
+		"Price must be a positive decimal, expressed in units of the buying asset per unit of the selling asset",
+	)
+
+	// ErrOfferSameAsset is returned when an offer sells and buys the same asset
+	ErrOfferSameAsset = NewValidationError(
+		"Selling and buying assets must differ",
+		"An offer cannot sell and buy the same asset",
+	)
+
+	// ErrOfferNotFound is returned when the wallet has no open offer with the given ID
+	ErrOfferNotFound = NewNotFoundError("Offer not found")
+
+	// ErrInvalidLiquidityPoolID is returned when a liquidity pool ID is not 64 hex characters
+	ErrInvalidLiquidityPoolID = NewValidationError(
+		"Invalid liquidity pool ID",
+		"Liquidity pool ID must be 64 hexadecimal characters",
+	)
+
+	// ErrLiquidityPoolNotFound is returned when no liquidity pool exists for the given ID or assets
+	ErrLiquidityPoolNotFound = NewNotFoundError("Liquidity pool not found")
+
+	// ErrLiquidityPoolSameAsset is returned when both pool assets are the same
+	ErrLiquidityPoolSameAsset = NewValidationError(
+		"Pool assets must differ",
+		"A liquidity pool holds two different assets",
+	)
+
+	// ErrInvalidPriceBounds is returned when deposit price bounds are not positive or min exceeds max
+	ErrInvalidPriceBounds = NewValidationError(
+		"Invalid price bounds",
+		"min_price and max_price must be positive decimals with min_price not greater than max_price",
+	)
+
+	// ErrInvalidWithdrawMinimum is returned when a withdraw minimum is not a non-negative decimal
+	ErrInvalidWithdrawMinimum = NewValidationError(
+		"Invalid withdraw minimum",
+		"min_amount_a and min_amount_b must be non-negative decimals with at most 7 decimal places",
+	)
+
+	// ErrInsufficientPoolShares is returned when withdrawing more shares than the wallet holds
+	ErrInsufficientPoolShares = NewValidationError(
+		"Insufficient pool shares",
+		"The wallet does not hold enough shares of this liquidity pool",
+	)
+
+	// ErrUnsupportedCurrency is returned when a valuation currency is not USD or XLM
+	ErrUnsupportedCurrency = NewValidationError(
+		"Unsupported currency",
+		"Currency must be one of: USD, XLM",
+	)
+
+	// ErrPriceUnavailable is returned when no price source can price an asset
+	ErrPriceUnavailable = NewNotFoundError("Price not available")
+
+	// ErrInvalidClaimant is returned when a claimable balance claimant is not a valid account
+	ErrInvalidClaimant = NewValidationError(
+		"Invalid claimant",
+		"Each claimant needs a valid Stellar public key as destination and a predicate; at most 10 claimants are allowed",
+	)
+
+	// ErrInvalidClaimableBalanceID is returned when a claimable balance ID is malformed
+	ErrInvalidClaimableBalanceID = NewValidationError(
+		"Invalid claimable balance ID",
+		"Claimable balance ID must be 72 hexadecimal characters as returned by Horizon",
+	)
+
+	// ErrClaimableBalanceNotFound is returned when no claimable balance exists for the given ID
+	ErrClaimableBalanceNotFound = NewNotFoundError("Claimable balance not found")
+
+	// ErrNotClaimant is returned when the wallet is not a claimant of the balance
+	ErrNotClaimant = NewValidationError(
+		"Wallet is not a claimant",
+		"Only accounts listed as claimants can claim a claimable balance",
+	)
+
+	// ErrNotBalanceSponsor is returned when reclaiming a balance the wallet did not create
+	ErrNotBalanceSponsor = NewValidationError(
+		"Wallet did not create this claimable balance",
+		"Only the wallet that created and sponsors a claimable balance can reclaim it",
+	)
+
+	// ErrClaimPredicateNotMet is returned when the wallet's claim predicate is not satisfied yet
+	ErrClaimPredicateNotMet = NewConflictError(
+		"Claim conditions not met",
+		"The claimant's predicate does not allow claiming the balance at this time",
+	)
+
+	// ErrInvalidSponsorshipType is returned when a sponsorship type is not account or trustline
+	ErrInvalidSponsorshipType = NewValidationError(
+		"Invalid sponsorship type",
+		"type must be account or trustline; trustline sponsorships require asset_code and asset_issuer",
+	)
+
+	// ErrSponsorshipNotFound is returned when no sponsorship matches the given ID
+	ErrSponsorshipNotFound = NewNotFoundError("Sponsorship not found")
+
+	// ErrSponsorshipExists is returned when the ledger entry already has a tracked sponsor
+	ErrSponsorshipExists = NewConflictError(
+		"Sponsorship already exists",
+		"The ledger entry is already sponsored; transfer the existing sponsorship instead",
+	)
+
+	// ErrSponsorshipNotActive is returned when revoking or transferring an ended sponsorship
+	ErrSponsorshipNotActive = NewConflictError(
+		"Sponsorship is not active",
+		"Only active sponsorships can be revoked or transferred",
+	)
+
+	// ErrSponsorshipWalletMismatch is returned when sponsor and sponsored wallets cannot be paired
+	ErrSponsorshipWalletMismatch = NewValidationError(
+		"Invalid sponsorship wallets",
+		"Sponsor and sponsored wallets must be different wallets on the same network",
+	)
+
+	// ErrAccountAlreadyExists is returned when sponsoring the creation of an account that is already on the ledger
+	ErrAccountAlreadyExists = NewConflictError(
+		"Account already exists",
+		"Account reserves can only be sponsored when the account is created",
+	)
+
+	// ErrTrustlineAlreadyExists is returned when sponsoring a trustline the wallet already holds
+	ErrTrustlineAlreadyExists = NewConflictError(
+		"Trustline already exists",
+		"Trustline reserves can only be sponsored when the trustline is created",
+	)
+
+	// ErrWalletClosed is returned when a closed wallet is asked to sign, receive funding or be configured
+	ErrWalletClosed = NewConflictError(
+		"Wallet is closed",
+		"The wallet's account was merged; closed wallets are kept for audit and cannot be used",
+	)
+
+	// ErrInvalidMergeDestination is returned when a wallet cannot be merged into the destination
+	ErrInvalidMergeDestination = NewValidationError(
+		"Invalid merge destination",
+		"destination must be an existing Stellar account other than the wallet being closed",
+	)
+
+	// ErrWalletIsSponsor is returned when closing a wallet that still sponsors reserves
+	ErrWalletIsSponsor = NewConflictError(
+		"Wallet sponsors other ledger entries",
+		"Revoke or transfer the wallet's sponsorships and claimable balances before closing it",
+	)
+
+	// ErrWalletHasPoolShares is returned when closing a wallet that still holds liquidity pool shares
+	ErrWalletHasPoolShares = NewConflictError(
+		"Wallet holds liquidity pool shares",
+		"Withdraw from liquidity pools before closing the wallet",
+	)
+
+	// ErrTrustlineNotAuthorized is returned when a balance cannot be moved out of an unauthorized trustline
+	ErrTrustlineNotAuthorized = NewConflictError(
+		"Trustline is not authorized",
+		"The issuer has frozen a trustline that still holds a balance",
+	)
+
+	// ErrNoConversionPath is returned when a balance cannot be converted to XLM on the DEX
+	ErrNoConversionPath = NewConflictError(
+		"No conversion path",
+		"The DEX has no path to convert the balance to XLM; send it to the destination instead",
+	)
+
+	// ErrInvalidWebhookURL is returned when a webhook URL is not an absolute https URL
+	ErrInvalidWebhookURL = NewValidationError(
+		"Invalid webhook URL",
+		"url must be an absolute https URL",
+	)
+
+	// ErrInvalidWebhookEventTypes is returned when a webhook subscribes to no or unknown event types
+	ErrInvalidWebhookEventTypes = NewValidationError(
+		"Invalid webhook event types",
+		"event_types must list at least one of wallet.created, payment.received, payment.sent, transaction.failed, trustline.changed",
+	)
+
+	// ErrWebhookNotFound is returned when no webhook endpoint of the user matches the given ID
+	ErrWebhookNotFound = NewNotFoundError("Webhook not found")
+
+	// ErrWebhookDeliveryNotFound is returned when no delivery of the webhook matches the given ID
+	ErrWebhookDeliveryNotFound = NewNotFoundError("Webhook delivery not found")
+
+	// ErrWebhookDeliveryPending is returned when redelivering a delivery that is still being retried
+	ErrWebhookDeliveryPending = NewConflictError(
+		"Webhook delivery is pending",
+		"Only delivered or dead deliveries can be redelivered",
+	)
+
+	// ErrInvalidLastEventID is returned when a stream is resumed from a malformed event ID
+	ErrInvalidLastEventID = NewValidationError(
+		"Invalid Last-Event-ID",
+		"Last-Event-ID must be the id of a previously received event",
+	)
+
+	// ErrInvalidHistoryCursor is returned when a history cursor is not an operation ID
+	ErrInvalidHistoryCursor = NewValidationError(
+		"Invalid cursor",
+		"cursor must be the next_cursor of a previous history page",
+	)
+
+	// ErrBalanceHistoryUnavailable is returned when past balances are requested before the wallet's history is indexed
+	ErrBalanceHistoryUnavailable = NewConflictError(
+		"Balance history unavailable",
+		"the wallet's history is still being indexed, retry once its transaction history reports backfill_complete",
+	)
+
+	// ErrInvalidValidUntil is returned when a requested transaction expiry is out of range
+	ErrInvalidValidUntil = NewValidationError(
+		"Invalid valid_until",
+		"valid_until must be in the future and within the maximum transaction timeout",
+	)
+
+	// ErrInvalidLedgerBounds is returned when min_ledger is greater than max_ledger
+	ErrInvalidLedgerBounds = NewValidationError(
+		"Invalid ledger bounds",
+		"min_ledger must not be greater than max_ledger",
+	)
+
+	// ErrDestinationNotAllowed is returned in allow-list only mode for destinations outside the address book
+	ErrDestinationNotAllowed = &AppError{
+		Type:       ErrorTypeUnauthorized,
+		Message:    "Destination is not in the address book",
+		Detail:     "allow-list only mode is on; add the destination to the wallet's or your address book first",
+		StatusCode: 403,
+	}
+
+	// ErrAddressBookEntryCoolingDown is returned in allow-list only mode for entries still in their cool-down
+	ErrAddressBookEntryCoolingDown = &AppError{
+		Type:       ErrorTypeUnauthorized,
+		Message:    "Address book entry is still in its cool-down period",
+		Detail:     "new entries can be paid once their usable_at has passed",
+		StatusCode: 403,
+	}
+
+	// ErrAddressBookEntryExists is returned when an address book already has an entry with the name
+	ErrAddressBookEntryExists = NewConflictError(
+		"Address book entry already exists",
+		"an entry with this name already exists in the address book",
+	)
+
+	// ErrApprovalNotPending is returned when deciding a request that was already decided, expired or executed
+	ErrApprovalNotPending = NewConflictError(
+		"Approval request is no longer pending",
+		"the request was already approved, rejected or expired",
+	)
+
+	// ErrApprovalExpired is returned when deciding a request whose time ran out
+	ErrApprovalExpired = NewConflictError(
+		"Approval request has expired",
+		"submit the payment again to create a new request",
+	)
+
+	// ErrApprovalAlreadyDecided is returned when an approver decides the same request twice
+	ErrApprovalAlreadyDecided = NewConflictError(
+		"Approval request already decided",
+		"each approver can approve or reject a request once",
+	)
+
+	// ErrApprovalChanged is returned when another decision was saved while this one was being made
+	ErrApprovalChanged = NewConflictError(
+		"Approval request changed",
+		"another approver decided the request at the same time; reload it and retry",
+	)
+
+	// ErrSelfApproval is returned when the user who made a payment tries to decide its request
+	ErrSelfApproval = &AppError{
+		Type:       ErrorTypeUnauthorized,
+		Message:    "Cannot decide your own payment",
+		Detail:     "payments held for approval must be approved by another user",
+		StatusCode: 403,
+	}
+
+	// ErrApprovalRequired is returned for operations other than payments whose amount the approval policy would hold
+	ErrApprovalRequired = &AppError{
+		Type:       ErrorTypeUnauthorized,
+		Message:    "Amount requires approval",
+		Detail:     "only payments can be held for approval; send the amount as a payment or lower it below the approval threshold",
+		StatusCode: 403,
+	}
+
+	// ErrOutflowRestricted is returned for operations the wallet's allow-list, spending limits or approval policy cannot check
+	ErrOutflowRestricted = &AppError{
+		Type:       ErrorTypeUnauthorized,
+		Message:    "Operation not allowed by the wallet's policies",
+		Detail:     "the wallet has an allow-list, spending limit or approval policy that this operation would bypass; remove the policy or use payments",
+		StatusCode: 403,
+	}
 )
 
 // Cryptography-specific errors
@@ -78,6 +499,22 @@ var (
 		"Account not found",
 		nil,
 	)
+
+	// ErrTransactionExpired is returned when a transaction's bounds passed before inclusion.
+	// The operations were not applied and can safely be rebuilt and resubmitted.
+	ErrTransactionExpired = &AppError{
+		Type:       ErrorTypeConflict,
+		Message:    "Transaction expired before it was included in a ledger",
+		Detail:     "Rebuild and resubmit the transaction",
+		StatusCode: 409,
+	}
+
+	// ErrSubmissionPending is returned when Horizon timed out before the outcome was known
+	ErrSubmissionPending = &AppError{
+		Type:       ErrorTypeBlockchain,
+		Message:    "Transaction submission timed out; check the submission status before retrying",
+		StatusCode: 504,
+	}
 )
 
 // Authentication-specific errors
@@ -11,10 +11,11 @@ type ErrorType string
 
 const (
 	// Domain error types
-	ErrorTypeValidation   ErrorType = "VALIDATION_ERROR" // Represents validation failures
-	ErrorTypeNotFound     ErrorType = "NOT_FOUND"        // Represents resource not found errors
-	ErrorTypeUnauthorized ErrorType = "UNAUTHORIZED"     // Represents authentication/authorization failures
-	ErrorTypeConflict     ErrorType = "CONFLICT"         // Represents resource conflict errors
+	ErrorTypeValidation    ErrorType = "VALIDATION_ERROR" // Represents validation failures
+	ErrorTypeNotFound      ErrorType = "NOT_FOUND"        // Represents resource not found errors
+	ErrorTypeUnauthorized  ErrorType = "UNAUTHORIZED"     // Represents authentication/authorization failures
+	ErrorTypeConflict      ErrorType = "CONFLICT"         // Represents resource conflict errors
+	ErrorTypeLimitExceeded ErrorType = "LIMIT_EXCEEDED"   // Represents payments refused by spending limits
 
 	// Technical error types
 	ErrorTypeInternal   ErrorType = "INTERNAL_ERROR"         // Represents unexpected internal errors
@@ -71,6 +72,28 @@ func NewNotFoundError(message string) *AppError {
 	}
 }
 
+// NewConflictError creates a new conflict error with the specified message and detail.
+// It sets the appropriate HTTP status code to 409 Conflict.
+func NewConflictError(message string, detail string) *AppError {
+	return &AppError{
+		Type:       ErrorTypeConflict,
+		Message:    message,
+		Detail:     detail,
+		StatusCode: http.StatusConflict,
+	}
+}
+
+// NewLimitExceededError creates a new spending limit error with the specified detail.
+// It sets the appropriate HTTP status code to 403 Forbidden.
+func NewLimitExceededError(detail string) *AppError {
+	return &AppError{
+		Type:       ErrorTypeLimitExceeded,
+		Message:    "Spending limit exceeded",
+		Detail:     detail,
+		StatusCode: http.StatusForbidden,
+	}
+}
+
 // NewInternalError creates a new internal error with the specified message and underlying error.
 // It sets the appropriate HTTP status code to 500 Internal Server Error.
 func NewInternalError(message string, err error) *AppError {
@@ -99,6 +99,10 @@ func Int32(key string, value int32) Field {
 	return zap.Int32(key, value)
 }
 
+func Int64(key string, value int64) Field {
+	return zap.Int64(key, value)
+}
+
 func Error(err error) Field {
 	if appErr, ok := err.(*errors.AppError); ok {
 		return zap.Object("error", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
@@ -0,0 +1,145 @@
+// Package safehttp provides HTTP clients for requests to hosts chosen by users or read
+// from the network, such as webhook endpoints and stellar.toml home domains. They only
+// connect to public addresses, so such hosts cannot be used to reach internal services.
+package safehttp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// maxRedirects bounds how many redirects a client follows
+const maxRedirects = 5
+
+// blockedPrefixes are ranges that are not publicly routable beyond those the netip
+// predicates cover
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "This" network
+	netip.MustParsePrefix("100.64.0.0/10"),  // Carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // Benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // Reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, may embed private IPv4 addresses
+	netip.MustParsePrefix("64:ff9b:1::/48"), // Local-use NAT64
+	netip.MustParsePrefix("2001:db8::/32"),  // Documentation
+	netip.MustParsePrefix("fec0::/10"),      // Deprecated site-local
+	netip.MustParsePrefix("100::/64"),       // Discard-only
+	netip.MustParsePrefix("2002::/16"),      // 6to4, may embed private IPv4 addresses
+}
+
+// IsPublic reports whether ip is a publicly routable unicast address: not loopback,
+// private, link-local (which includes cloud metadata services), multicast, unspecified
+// or otherwise reserved
+func IsPublic(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() {
+		return false
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckHostname accepts public DNS names only: no IP literals, ports, single-label
+// names such as localhost, or names under reserved suffixes
+func CheckHostname(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	switch {
+	case host == "":
+		return fmt.Errorf("host is required")
+	case strings.ContainsAny(host, ":/?#@[] \\"):
+		return fmt.Errorf("invalid host %q: only a DNS name is allowed", host)
+	case !strings.Contains(host, "."):
+		return fmt.Errorf("invalid host %q: not a public DNS name", host)
+	}
+	if _, err := netip.ParseAddr(host); err == nil {
+		return fmt.Errorf("invalid host %q: IP addresses are not allowed", host)
+	}
+	for _, suffix := range []string{".localhost", ".local", ".internal", ".localdomain", ".home.arpa", ".in-addr.arpa", ".ip6.arpa"} {
+		if strings.HasSuffix(host, suffix) {
+			return fmt.Errorf("invalid host %q: not a public DNS name", host)
+		}
+	}
+	return nil
+}
+
+// CheckURL accepts absolute https URLs whose host is a public DNS name or a public IP
+// address. Ports are allowed; credentials in the URL are not.
+func CheckURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("invalid url %q: https is required", rawURL)
+	}
+	if parsed.User != nil {
+		return fmt.Errorf("invalid url %q: credentials are not allowed", rawURL)
+	}
+
+	host := parsed.Hostname()
+	if ip, err := netip.ParseAddr(host); err == nil {
+		if !IsPublic(ip) {
+			return fmt.Errorf("invalid url %q: %s is not a public address", rawURL, ip)
+		}
+		return nil
+	}
+	return CheckHostname(host)
+}
+
+// NewClient returns a client that only connects to public addresses over https. The
+// check runs on every address a name resolves to when connecting, so it also applies
+// to each redirect and to names that resolve differently on later lookups.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("refusing to connect to %s: %w", address, err)
+			}
+			if !IsPublic(addrPort.Addr()) {
+				return fmt.Errorf("refusing to connect to non-public address %s", addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	transport := &http.Transport{
+		Proxy: nil, // A proxy would be dialed instead of the checked address
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, address)
+		},
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			if req.URL.Scheme != "https" {
+				return fmt.Errorf("refusing to follow redirect to %s: https is required", req.URL.Scheme)
+			}
+			return nil
+		},
+	}
+}